@@ -75,6 +75,19 @@ func (act *AbstractAction) GasFeeCap() *big.Int {
 	return new(big.Int).Set(act.gasFeeCap)
 }
 
+// EffectiveGasPrice returns the gas price paid under the given base fee, which is
+// min(gasFeeCap, baseFee + gasTipCap), or the gas price if base fee is nil
+func (act *AbstractAction) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return act.GasPrice()
+	}
+	tip := new(big.Int).Sub(act.GasFeeCap(), baseFee)
+	if tipCap := act.GasTipCap(); tip.Cmp(tipCap) > 0 {
+		tip = tipCap
+	}
+	return tip.Add(tip, baseFee)
+}
+
 // BasicActionSize returns the basic size of action
 func (act *AbstractAction) BasicActionSize() uint32 {
 	// VersionSizeInBytes + NonceSizeInBytes + GasSizeInBytes
@@ -115,6 +128,9 @@ func (act *AbstractAction) SanityCheck() error {
 	if act.gasFeeCap != nil && act.gasFeeCap.Sign() < 0 {
 		return ErrNegativeValue
 	}
+	if act.gasTipCap != nil && act.gasFeeCap != nil && act.gasTipCap.Cmp(act.gasFeeCap) > 0 {
+		return ErrTipAboveFeeCap
+	}
 	return nil
 }
 
@@ -12,6 +12,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/signer"
 )
 
 type (
@@ -38,7 +40,7 @@ type (
 )
 
 // Sign signs the action using sender's private key
-func Sign(act Envelope, sk crypto.PrivateKey) (*SealedEnvelope, error) {
+func Sign(act Envelope, sk signer.Signer) (*SealedEnvelope, error) {
 	sealed := &SealedEnvelope{
 		Envelope:  act,
 		srcPubkey: sk.PublicKey(),
@@ -119,6 +119,24 @@ func (b *EnvelopeBuilder) SetGasPriceByBytes(buf []byte) *EnvelopeBuilder {
 	return b
 }
 
+// SetGasTipCap sets action's gas tip cap.
+func (b *EnvelopeBuilder) SetGasTipCap(p *big.Int) *EnvelopeBuilder {
+	if p == nil {
+		return b
+	}
+	b.elp.gasTipCap = new(big.Int).Set(p)
+	return b
+}
+
+// SetGasFeeCap sets action's gas fee cap.
+func (b *EnvelopeBuilder) SetGasFeeCap(p *big.Int) *EnvelopeBuilder {
+	if p == nil {
+		return b
+	}
+	b.elp.gasFeeCap = new(big.Int).Set(p)
+	return b
+}
+
 // SetAction sets the action payload for the Envelope Builder is building.
 func (b *EnvelopeBuilder) SetAction(action actionPayload) *EnvelopeBuilder {
 	b.elp.payload = action
@@ -168,6 +186,10 @@ func (b *EnvelopeBuilder) setEnvelopeCommonFields(tx *types.Transaction) {
 	b.elp.nonce = tx.Nonce()
 	b.elp.gasPrice = new(big.Int).Set(tx.GasPrice())
 	b.elp.gasLimit = tx.Gas()
+	if tx.Type() == types.DynamicFeeTxType {
+		b.elp.gasTipCap = new(big.Int).Set(tx.GasTipCap())
+		b.elp.gasFeeCap = new(big.Int).Set(tx.GasFeeCap())
+	}
 }
 
 func getRecipientAddr(addr *common.Address) string {
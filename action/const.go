@@ -30,12 +30,14 @@ var (
 	ErrNilAction          = errors.New("nil action to load proto")
 	ErrInvalidAct         = errors.New("invalid action type")
 	ErrInvalidABI         = errors.New("invalid abi binary data")
+	ErrTipAboveFeeCap     = errors.New("max priority fee per gas higher than max fee per gas")
+	ErrTxTypeNotSupported = errors.New("transaction type not supported")
 )
 
 // LoadErrorDescription loads corresponding description related to the error
 func LoadErrorDescription(err error) string {
 	switch errors.Cause(err) {
-	case ErrOversizedData, ErrTxPoolOverflow, ErrInvalidSender, ErrNonceTooHigh, ErrInsufficientFunds, ErrIntrinsicGas, ErrChainID, ErrNotFound, ErrVotee, ErrAddress, ErrExistedInPool, ErrReplaceUnderpriced, ErrNonceTooLow, ErrUnderpriced, ErrNegativeValue:
+	case ErrOversizedData, ErrTxPoolOverflow, ErrInvalidSender, ErrNonceTooHigh, ErrInsufficientFunds, ErrIntrinsicGas, ErrChainID, ErrNotFound, ErrVotee, ErrAddress, ErrExistedInPool, ErrReplaceUnderpriced, ErrNonceTooLow, ErrUnderpriced, ErrNegativeValue, ErrTipAboveFeeCap:
 		return err.Error()
 	default:
 		return "Unknown"
@@ -24,6 +24,7 @@ type (
 		GasPrice() *big.Int
 		GasTipCap() *big.Int
 		GasFeeCap() *big.Int
+		EffectiveGasPrice(*big.Int) *big.Int
 		Destination() (string, bool)
 		Cost() (*big.Int, error)
 		IntrinsicGas() (uint64, error)
@@ -0,0 +1,249 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	blake2b "github.com/minio/blake2b-simd"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+const (
+	equivocationEvidenceInterfaceABI = `[
+		{
+			"inputs": [
+				{
+					"internalType": "bytes",
+					"name": "vote1",
+					"type": "bytes"
+				},
+				{
+					"internalType": "bytes",
+					"name": "endorsement1",
+					"type": "bytes"
+				},
+				{
+					"internalType": "bytes",
+					"name": "vote2",
+					"type": "bytes"
+				},
+				{
+					"internalType": "bytes",
+					"name": "endorsement2",
+					"type": "bytes"
+				}
+			],
+			"name": "submitEquivocationEvidence",
+			"outputs": [],
+			"stateMutability": "nonpayable",
+			"type": "function"
+		}
+	]`
+)
+
+var (
+	equivocationEvidenceMethod abi.Method
+
+	// ErrInvalidEvidence indicates the evidence does not prove an equivocation
+	ErrInvalidEvidence = errors.New("invalid equivocation evidence")
+)
+
+// EquivocationEvidence proves that a delegate endorsed two different blocks on the same consensus topic with the
+// same timestamp. The timestamp of a consensus vote is determined by the height, round and topic, so an honest
+// delegate never signs two such votes
+type EquivocationEvidence struct {
+	votes        [2]*iotextypes.ConsensusVote
+	endorsements [2]*endorsement.Endorsement
+}
+
+// consensusVoteDoc is the document endorsed by a consensus vote
+type consensusVoteDoc struct {
+	vote *iotextypes.ConsensusVote
+}
+
+func init() {
+	equivocationEvidenceInterface, err := abi.JSON(strings.NewReader(equivocationEvidenceInterfaceABI))
+	if err != nil {
+		panic(err)
+	}
+	var ok bool
+	equivocationEvidenceMethod, ok = equivocationEvidenceInterface.Methods["submitEquivocationEvidence"]
+	if !ok {
+		panic("fail to load the submitEquivocationEvidence method")
+	}
+}
+
+// Hash returns the hash of the consensus vote, which is the same as the one signed by the delegate
+func (doc *consensusVoteDoc) Hash() ([]byte, error) {
+	ser, err := proto.Marshal(doc.vote)
+	if err != nil {
+		return nil, err
+	}
+	h := blake2b.Sum256(ser)
+	return h[:], nil
+}
+
+// NewEquivocationEvidence returns an evidence of two conflicting consensus votes
+func NewEquivocationEvidence(
+	vote1 *iotextypes.ConsensusVote,
+	en1 *endorsement.Endorsement,
+	vote2 *iotextypes.ConsensusVote,
+	en2 *endorsement.Endorsement,
+) *EquivocationEvidence {
+	return &EquivocationEvidence{
+		votes:        [2]*iotextypes.ConsensusVote{vote1, vote2},
+		endorsements: [2]*endorsement.Endorsement{en1, en2},
+	}
+}
+
+// Endorser returns the public key of the delegate who signed the conflicting votes
+func (ev *EquivocationEvidence) Endorser() crypto.PublicKey {
+	return ev.endorsements[0].Endorser()
+}
+
+// Topic returns the consensus topic of the conflicting votes
+func (ev *EquivocationEvidence) Topic() iotextypes.ConsensusVote_Topic {
+	return ev.votes[0].GetTopic()
+}
+
+// Timestamp returns the timestamp of the conflicting votes
+func (ev *EquivocationEvidence) Timestamp() time.Time {
+	return ev.endorsements[0].Timestamp()
+}
+
+// Hash returns the hash identifying the offence, evidences of the same offence share the same hash
+func (ev *EquivocationEvidence) Hash() hash.Hash256 {
+	ts := ev.Timestamp()
+	b := append([]byte{}, ev.Endorser().Bytes()...)
+	b = append(b, byteutil.Uint32ToBytes(uint32(ev.Topic()))...)
+	b = append(b, byteutil.Uint64ToBytes(uint64(ts.Unix()))...)
+	b = append(b, byteutil.Uint32ToBytes(uint32(ts.Nanosecond()))...)
+	return hash.Hash256b(b)
+}
+
+// Verify checks that the two votes are signed by the same endorser, on the same topic and with the same timestamp,
+// but for different blocks
+func (ev *EquivocationEvidence) Verify() error {
+	for i := range ev.votes {
+		if ev.votes[i] == nil || ev.endorsements[i] == nil || ev.endorsements[i].Endorser() == nil {
+			return errors.Wrap(ErrInvalidEvidence, "incomplete evidence")
+		}
+		if len(ev.votes[i].GetBlockHash()) == 0 {
+			return errors.Wrap(ErrInvalidEvidence, "vote without block hash")
+		}
+	}
+	en1, en2 := ev.endorsements[0], ev.endorsements[1]
+	if !bytes.Equal(en1.Endorser().Bytes(), en2.Endorser().Bytes()) {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different endorsers")
+	}
+	if ev.votes[0].GetTopic() != ev.votes[1].GetTopic() {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different topics")
+	}
+	if !en1.Timestamp().Equal(en2.Timestamp()) {
+		return errors.Wrap(ErrInvalidEvidence, "votes of different timestamps")
+	}
+	if bytes.Equal(ev.votes[0].GetBlockHash(), ev.votes[1].GetBlockHash()) {
+		return errors.Wrap(ErrInvalidEvidence, "votes of the same block")
+	}
+	for i := range ev.votes {
+		if !endorsement.VerifyEndorsement(&consensusVoteDoc{ev.votes[i]}, ev.endorsements[i]) {
+			return errors.Wrapf(ErrInvalidEvidence, "invalid signature of vote %d", i+1)
+		}
+	}
+	return nil
+}
+
+// EncodeABIBinary encodes the evidence as the data of an execution to the staking protocol
+func (ev *EquivocationEvidence) EncodeABIBinary() ([]byte, error) {
+	var args []any
+	for i := range ev.votes {
+		vote, err := proto.Marshal(ev.votes[i])
+		if err != nil {
+			return nil, err
+		}
+		enPb, err := ev.endorsements[i].Proto()
+		if err != nil {
+			return nil, err
+		}
+		en, err := proto.Marshal(enPb)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, vote, en)
+	}
+	data, err := equivocationEvidenceMethod.Inputs.Pack(args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(equivocationEvidenceMethod.ID, data...), nil
+}
+
+// IsEquivocationEvidence returns true if the execution submits an equivocation evidence
+func IsEquivocationEvidence(exec *Execution) bool {
+	return exec.Contract() == address.StakingProtocolAddr &&
+		len(exec.Data()) > 4 && bytes.Equal(equivocationEvidenceMethod.ID, exec.Data()[:4])
+}
+
+// NewEquivocationEvidenceFromABIBinary parses the execution data and creates an evidence
+func NewEquivocationEvidenceFromABIBinary(data []byte) (*EquivocationEvidence, error) {
+	var (
+		paramsMap = map[string]any{}
+		ev        EquivocationEvidence
+	)
+	// sanity check
+	if len(data) <= 4 || !bytes.Equal(equivocationEvidenceMethod.ID, data[:4]) {
+		return nil, errDecodeFailure
+	}
+	if err := equivocationEvidenceMethod.Inputs.UnpackIntoMap(paramsMap, data[4:]); err != nil {
+		return nil, err
+	}
+	for i, name := range []string{"1", "2"} {
+		voteBytes, ok := paramsMap["vote"+name].([]byte)
+		if !ok {
+			return nil, errDecodeFailure
+		}
+		enBytes, ok := paramsMap["endorsement"+name].([]byte)
+		if !ok {
+			return nil, errDecodeFailure
+		}
+		vote := &iotextypes.ConsensusVote{}
+		if err := proto.Unmarshal(voteBytes, vote); err != nil {
+			return nil, err
+		}
+		enPb := &iotextypes.Endorsement{}
+		if err := proto.Unmarshal(enBytes, enPb); err != nil {
+			return nil, err
+		}
+		en := &endorsement.Endorsement{}
+		if err := en.LoadProto(enPb); err != nil {
+			return nil, err
+		}
+		ev.votes[i], ev.endorsements[i] = vote, en
+	}
+	return &ev, nil
+}
+
+// NewEquivocationEvidenceExecution returns an execution submitting the evidence to the staking protocol
+func NewEquivocationEvidenceExecution(nonce, gasLimit uint64, gasPrice *big.Int, ev *EquivocationEvidence) (*Execution, error) {
+	data, err := ev.EncodeABIBinary()
+	if err != nil {
+		return nil, err
+	}
+	return NewExecution(address.StakingProtocolAddr, nonce, big.NewInt(0), gasLimit, gasPrice, data)
+}
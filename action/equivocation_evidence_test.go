@@ -0,0 +1,78 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+func TestEquivocationEvidence(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		sk    = identityset.PrivateKey(1)
+		ts    = time.Unix(1700000000, 500).UTC()
+		vote1 = &iotextypes.ConsensusVote{BlockHash: []byte("block1"), Topic: iotextypes.ConsensusVote_LOCK}
+		vote2 = &iotextypes.ConsensusVote{BlockHash: []byte("block2"), Topic: iotextypes.ConsensusVote_LOCK}
+	)
+	endorse := func(vote *iotextypes.ConsensusVote, ts time.Time) *endorsement.Endorsement {
+		en, err := endorsement.Endorse(sk, &consensusVoteDoc{vote}, ts)
+		require.NoError(err)
+		return en
+	}
+	en1, en2 := endorse(vote1, ts), endorse(vote2, ts)
+	ev := NewEquivocationEvidence(vote1, en1, vote2, en2)
+	require.NoError(ev.Verify())
+	require.Equal(sk.PublicKey().Bytes(), ev.Endorser().Bytes())
+	require.Equal(iotextypes.ConsensusVote_LOCK, ev.Topic())
+	require.True(ts.Equal(ev.Timestamp()))
+	// the hash is the same for evidences of the same offence
+	require.Equal(ev.Hash(), NewEquivocationEvidence(vote2, en2, vote1, en1).Hash())
+
+	exec, err := NewEquivocationEvidenceExecution(1, 100000, big.NewInt(1), ev)
+	require.NoError(err)
+	require.Equal(address.StakingProtocolAddr, exec.Contract())
+	require.True(IsEquivocationEvidence(exec))
+	decoded, err := NewEquivocationEvidenceFromABIBinary(exec.Data())
+	require.NoError(err)
+	require.NoError(decoded.Verify())
+	require.Equal(ev.Hash(), decoded.Hash())
+	_, err = NewEquivocationEvidenceFromABIBinary(exec.Data()[:4])
+	require.Equal(errDecodeFailure, err)
+	tsf, err := NewExecution(address.RewardingProtocol, 1, big.NewInt(0), 100000, big.NewInt(1), exec.Data())
+	require.NoError(err)
+	require.False(IsEquivocationEvidence(tsf))
+
+	for _, c := range []struct {
+		name string
+		ev   *EquivocationEvidence
+	}{
+		{"same block", NewEquivocationEvidence(vote1, en1, vote1, en1)},
+		{"different topics", NewEquivocationEvidence(vote1, en1,
+			&iotextypes.ConsensusVote{BlockHash: []byte("block2"), Topic: iotextypes.ConsensusVote_COMMIT}, en2)},
+		{"different timestamps", NewEquivocationEvidence(vote1, en1, vote2, endorse(vote2, ts.Add(time.Second)))},
+		{"different endorsers", NewEquivocationEvidence(vote1, en1, vote2,
+			endorsement.NewEndorsement(ts, identityset.PrivateKey(2).PublicKey(), en2.Signature()))},
+		{"invalid signature", NewEquivocationEvidence(vote1, en1, vote2, en1)},
+		{"empty block hash", NewEquivocationEvidence(vote1, en1,
+			&iotextypes.ConsensusVote{Topic: iotextypes.ConsensusVote_LOCK}, en2)},
+		{"incomplete", NewEquivocationEvidence(vote1, en1, nil, nil)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(ErrInvalidEvidence, errors.Cause(c.ev.Verify()))
+		})
+	}
+}
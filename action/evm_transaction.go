@@ -26,6 +26,9 @@ type (
 		Nonce() uint64
 		GasLimit() uint64
 		GasPrice() *big.Int
+		GasTipCap() *big.Int
+		GasFeeCap() *big.Int
+		EffectiveGasPrice(*big.Int) *big.Int
 		Amount() *big.Int
 		To() *common.Address
 		Data() []byte
@@ -56,6 +59,18 @@ func (tx *EvmTransaction) GasPrice() *big.Int {
 	return tx.inner.GasPrice()
 }
 
+func (tx *EvmTransaction) GasTipCap() *big.Int {
+	return tx.inner.GasTipCap()
+}
+
+func (tx *EvmTransaction) GasFeeCap() *big.Int {
+	return tx.inner.GasFeeCap()
+}
+
+func (tx *EvmTransaction) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return tx.inner.EffectiveGasPrice(baseFee)
+}
+
 func (tx *EvmTransaction) Value() *big.Int {
 	return tx.inner.Amount()
 }
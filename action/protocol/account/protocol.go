@@ -32,7 +32,7 @@ type Protocol struct {
 }
 
 // DepositGas deposits gas to some pool
-type DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) (*action.TransactionLog, error)
+type DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int, opts ...protocol.DepositOption) ([]*action.TransactionLog, error)
 
 // NewProtocol instantiates the protocol of account
 func NewProtocol(depositGas DepositGas) *Protocol {
@@ -133,7 +133,7 @@ func createAccount(sm protocol.StateManager, encodedAddr string, init *big.Int,
 	return err
 }
 
-// CreateGenesisStates initializes the protocol by setting the initial balances to some addresses
+// CreateGenesisStates initializes the protocol by setting the initial balances and nonces to some addresses
 func (p *Protocol) CreateGenesisStates(ctx context.Context, sm protocol.StateManager) error {
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	g := genesis.MustExtractGenesisContext(ctx)
@@ -156,6 +156,17 @@ func (p *Protocol) CreateGenesisStates(ctx context.Context, sm protocol.StateMan
 			return err
 		}
 	}
+	// the code and storage of the alloc accounts are created by the execution protocol
+	addrs, accts := g.Allocs()
+	for i, addr := range addrs {
+		allocOpts := opts
+		if accts[i].Nonce > 0 {
+			allocOpts = append(allocOpts[:len(opts):len(opts)], state.PendingNonceOption(accts[i].Nonce))
+		}
+		if err := createAccount(sm, addr.String(), accts[i].Balance(), allocOpts...); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -50,14 +50,14 @@ func (p *Protocol) handleTransfer(ctx context.Context, tsf *action.Transfer, sm
 		)
 	}
 
-	var depositLog *action.TransactionLog
+	var depositLog []*action.TransactionLog
 	if !fCtx.FixDoubleChargeGas {
 		// charge sender gas
 		if err := sender.SubBalance(gasFee); err != nil {
 			return nil, errors.Wrapf(err, "failed to charge the gas for sender %s", actionCtx.Caller.String())
 		}
 		if p.depositGas != nil {
-			depositLog, err = p.depositGas(ctx, sm, gasFee)
+			depositLog, err = p.depositGas(ctx, sm, gasFee, protocol.BurnBaseFeeOption(ctx, actionCtx.IntrinsicGas))
 			if err != nil {
 				return nil, err
 			}
@@ -91,7 +91,7 @@ func (p *Protocol) handleTransfer(ctx context.Context, tsf *action.Transfer, sm
 		}
 		if fCtx.FixDoubleChargeGas {
 			if p.depositGas != nil {
-				depositLog, err = p.depositGas(ctx, sm, gasFee)
+				depositLog, err = p.depositGas(ctx, sm, gasFee, protocol.BurnBaseFeeOption(ctx, actionCtx.IntrinsicGas))
 				if err != nil {
 					return nil, err
 				}
@@ -104,7 +104,7 @@ func (p *Protocol) handleTransfer(ctx context.Context, tsf *action.Transfer, sm
 			GasConsumed:     actionCtx.IntrinsicGas,
 			ContractAddress: p.addr.String(),
 		}
-		receipt.AddTransactionLogs(depositLog)
+		receipt.AddTransactionLogs(depositLog...)
 		return receipt, nil
 	}
 
@@ -133,7 +133,7 @@ func (p *Protocol) handleTransfer(ctx context.Context, tsf *action.Transfer, sm
 
 	if fCtx.FixDoubleChargeGas {
 		if p.depositGas != nil {
-			depositLog, err = p.depositGas(ctx, sm, gasFee)
+			depositLog, err = p.depositGas(ctx, sm, gasFee, protocol.BurnBaseFeeOption(ctx, actionCtx.IntrinsicGas))
 			if err != nil {
 				return nil, err
 			}
@@ -152,7 +152,8 @@ func (p *Protocol) handleTransfer(ctx context.Context, tsf *action.Transfer, sm
 		Sender:    actionCtx.Caller.String(),
 		Recipient: tsf.Recipient(),
 		Amount:    tsf.Amount(),
-	}, depositLog)
+	})
+	receipt.AddTransactionLogs(depositLog...)
 
 	return receipt, nil
 }
@@ -36,8 +36,10 @@ type (
 	// TipInfo contains the tip block information
 	TipInfo struct {
 		Height    uint64
+		GasUsed   uint64
 		Hash      hash.Hash256
 		Timestamp time.Time
+		BaseFee   *big.Int
 	}
 
 	// BlockchainCtx provides blockchain auxiliary information.
@@ -60,6 +62,8 @@ type (
 		GasLimit uint64
 		// Producer is the address of whom composes the block containing this action
 		Producer address.Address
+		// BaseFee is the EIP-1559 base fee of the block, nil before Vanuatu
+		BaseFee *big.Int
 	}
 
 	// ActionCtx provides action auxiliary information.
@@ -121,6 +125,8 @@ type (
 		RefactorFreshAccountConversion          bool
 		SuicideTxLogMismatchPanic               bool
 		PanicUnrecoverableError                 bool
+		EnableDynamicFeeTx                      bool
+		EnableEquivocationSlashing              bool
 	}
 
 	// FeatureWithHeightCtx provides feature check functions.
@@ -267,6 +273,8 @@ func WithFeatureCtx(ctx context.Context) context.Context {
 			RefactorFreshAccountConversion:          g.IsTsunami(height),
 			SuicideTxLogMismatchPanic:               g.IsToBeEnabled(height),
 			PanicUnrecoverableError:                 g.IsToBeEnabled(height),
+			EnableDynamicFeeTx:                      g.IsVanuatu(height),
+			EnableEquivocationSlashing:              g.IsToBeEnabled(height),
 		},
 	)
 }
@@ -0,0 +1,96 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+)
+
+const (
+	// InitialBaseFee is the base fee of the first block at Vanuatu height, which equals the minimum gas price
+	InitialBaseFee = 1000000000000
+	// MinBaseFee is the floor of the base fee
+	MinBaseFee = InitialBaseFee
+	// DefaultBaseFeeChangeDenominator bounds the amount the base fee can change between blocks
+	DefaultBaseFeeChangeDenominator = 8
+	// DefaultElasticityMultiplier bounds the maximum gas limit a block may have against the gas target
+	DefaultElasticityMultiplier = 2
+)
+
+type (
+	// DepositOption is the option to deposit gas fee
+	DepositOption func(*DepositOptionCfg)
+
+	// DepositOptionCfg is the config of depositing gas fee
+	DepositOptionCfg struct {
+		// BurnAmount is the portion of the gas fee to be burnt instead of deposited
+		BurnAmount *big.Int
+	}
+)
+
+// NewDepositOptionCfg creates the deposit config from options
+func NewDepositOptionCfg(opts ...DepositOption) *DepositOptionCfg {
+	cfg := &DepositOptionCfg{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// BurnBaseFeeOption burns the base fee portion of the gas fee for the given gas, if base fee is enabled in the block
+func BurnBaseFeeOption(ctx context.Context, gas uint64) DepositOption {
+	return func(cfg *DepositOptionCfg) {
+		blkCtx, ok := GetBlockCtx(ctx)
+		if !ok || blkCtx.BaseFee == nil {
+			return
+		}
+		cfg.BurnAmount = new(big.Int).Mul(blkCtx.BaseFee, new(big.Int).SetUint64(gas))
+	}
+}
+
+// CalcBaseFee calculates the base fee of the block following the parent block, nil is returned before Vanuatu
+func CalcBaseFee(g genesis.Blockchain, parent *TipInfo) *big.Int {
+	if !g.IsVanuatu(parent.Height + 1) {
+		return nil
+	}
+	// the first block of Vanuatu uses the initial base fee
+	if !g.IsVanuatu(parent.Height) || parent.BaseFee == nil {
+		return big.NewInt(InitialBaseFee)
+	}
+
+	var (
+		parentGasTarget = g.BlockGasLimitByHeight(parent.Height) / DefaultElasticityMultiplier
+		baseFee         = new(big.Int).Set(parent.BaseFee)
+	)
+	switch {
+	case parent.GasUsed == parentGasTarget:
+		return baseFee
+	case parent.GasUsed > parentGasTarget:
+		// baseFee + max(1, baseFee * (gasUsed - gasTarget) / gasTarget / denominator)
+		delta := new(big.Int).SetUint64(parent.GasUsed - parentGasTarget)
+		delta.Mul(delta, parent.BaseFee)
+		delta.Div(delta, new(big.Int).SetUint64(parentGasTarget))
+		delta.Div(delta, big.NewInt(DefaultBaseFeeChangeDenominator))
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		return baseFee.Add(baseFee, delta)
+	default:
+		// max(minBaseFee, baseFee - baseFee * (gasTarget - gasUsed) / gasTarget / denominator)
+		delta := new(big.Int).SetUint64(parentGasTarget - parent.GasUsed)
+		delta.Mul(delta, parent.BaseFee)
+		delta.Div(delta, new(big.Int).SetUint64(parentGasTarget))
+		delta.Div(delta, big.NewInt(DefaultBaseFeeChangeDenominator))
+		baseFee.Sub(baseFee, delta)
+		if baseFee.Cmp(big.NewInt(MinBaseFee)) < 0 {
+			baseFee.SetUint64(MinBaseFee)
+		}
+		return baseFee
+	}
+}
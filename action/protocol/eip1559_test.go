@@ -0,0 +1,54 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package protocol
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+)
+
+func TestCalcBaseFee(t *testing.T) {
+	require := require.New(t)
+	g := genesis.Default.Blockchain
+	g.VanuatuBlockHeight = 10
+	target := g.BlockGasLimitByHeight(10) / DefaultElasticityMultiplier
+
+	// before Vanuatu
+	require.Nil(CalcBaseFee(g, &TipInfo{Height: 8}))
+	// first block of Vanuatu
+	require.Equal(big.NewInt(InitialBaseFee), CalcBaseFee(g, &TipInfo{Height: 9}))
+
+	for _, v := range []struct {
+		gasUsed uint64
+		baseFee int64
+		expect  int64
+	}{
+		{target, InitialBaseFee * 2, InitialBaseFee * 2},
+		{target * 2, InitialBaseFee * 2, InitialBaseFee * 2 * 9 / 8},
+		{target * 3 / 2, InitialBaseFee * 2, InitialBaseFee * 2 * 17 / 16},
+		{0, InitialBaseFee * 2, InitialBaseFee * 2 * 7 / 8},
+		{0, InitialBaseFee, MinBaseFee},
+	} {
+		require.Equal(big.NewInt(v.expect), CalcBaseFee(g, &TipInfo{
+			Height:  10,
+			GasUsed: v.gasUsed,
+			BaseFee: big.NewInt(v.baseFee),
+		}))
+	}
+}
+
+func TestBurnBaseFeeOption(t *testing.T) {
+	require := require.New(t)
+	ctx := WithBlockCtx(context.Background(), BlockCtx{})
+	require.Nil(NewDepositOptionCfg(BurnBaseFeeOption(ctx, 100)).BurnAmount)
+	ctx = WithBlockCtx(ctx, BlockCtx{BaseFee: big.NewInt(3)})
+	require.Equal(big.NewInt(300), NewDepositOptionCfg(BurnBaseFeeOption(ctx, 100)).BurnAmount)
+}
@@ -9,6 +9,8 @@ import (
 type (
 	helperContextKey struct{}
 
+	simulationOverrideContextKey struct{}
+
 	// HelperContext is the context for EVM helper
 	HelperContext struct {
 		GetBlockHash   GetBlockHash
@@ -32,3 +34,17 @@ func mustGetHelperCtx(ctx context.Context) HelperContext {
 	}
 	return hc
 }
+
+// WithSimulationOverrideCtx returns a new context with the overlay applied to simulated executions
+func WithSimulationOverrideCtx(ctx context.Context, o *SimulationOverride) context.Context {
+	if o == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, simulationOverrideContextKey{}, o)
+}
+
+// GetSimulationOverrideCtx returns the overlay applied to simulated executions
+func GetSimulationOverrideCtx(ctx context.Context) (*SimulationOverride, bool) {
+	o, ok := ctx.Value(simulationOverrideContextKey{}).(*SimulationOverride)
+	return o, ok
+}
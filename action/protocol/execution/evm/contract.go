@@ -53,6 +53,13 @@ type (
 		sm         protocol.StateManager
 		trie       trie.Trie // storage trie of the contract
 	}
+
+	// StorageSlotProof is the value and the merkle proof of a slot in the storage trie of a contract
+	StorageSlotProof struct {
+		Key   hash.Hash256
+		Value []byte // nil if the slot doesn't exist
+		Proof [][]byte
+	}
 )
 
 func (c *contract) Iterator() (trie.Iterator, error) {
@@ -189,10 +196,7 @@ func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateMan
 	options := []mptrie.Option{
 		mptrie.KVStoreOption(protocol.NewKVStoreForTrieWithStateManager(ContractKVNameSpace, sm)),
 		mptrie.KeyLengthOption(len(hash.Hash256{})),
-		mptrie.HashFuncOption(func(data []byte) []byte {
-			h := hash.Hash256b(append(addr[:], data...))
-			return h[:]
-		}),
+		mptrie.HashFuncOption(StorageHashFunc(addr)),
 	}
 	if account.Root != hash.ZeroHash256 {
 		options = append(options, mptrie.RootHashOption(account.Root[:]))
@@ -211,3 +215,57 @@ func newContract(addr hash.Hash160, account *state.Account, sm protocol.StateMan
 	c.trie = tr
 	return c, nil
 }
+
+// StorageHashFunc returns the hash func of the storage trie of the contract, which is needed to verify storage proofs
+func StorageHashFunc(addr hash.Hash160) mptrie.HashFunc {
+	return func(data []byte) []byte {
+		h := hash.Hash256b(append(addr[:], data...))
+		return h[:]
+	}
+}
+
+// StorageProof returns the root hash of the storage trie of the contract, and the merkle proofs of the slots in it
+func StorageProof(sr protocol.StateReader, addr hash.Hash160, root hash.Hash256, keys []hash.Hash256) ([]byte, []*StorageSlotProof, error) {
+	options := []mptrie.Option{
+		mptrie.KVStoreOption(protocol.NewKVStoreForTrieWithStateReader(ContractKVNameSpace, sr)),
+		mptrie.KeyLengthOption(len(hash.Hash256{})),
+		mptrie.HashFuncOption(StorageHashFunc(addr)),
+	}
+	if root != hash.ZeroHash256 {
+		options = append(options, mptrie.RootHashOption(root[:]))
+	}
+	tr, err := mptrie.New(options...)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to create storage trie of contract %x", addr)
+	}
+	if err := tr.Start(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	defer tr.Stop(context.Background())
+
+	rootHash, err := tr.RootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+	proofs := make([]*StorageSlotProof, 0, len(keys))
+	for _, key := range keys {
+		proof, err := tr.Proof(key[:])
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := tr.Get(key[:])
+		switch errors.Cause(err) {
+		case nil:
+		case trie.ErrNotExist:
+			value = nil
+		default:
+			return nil, nil, err
+		}
+		proofs = append(proofs, &StorageSlotProof{
+			Key:   key,
+			Value: value,
+			Proof: proof,
+		})
+	}
+	return rootHash, proofs, nil
+}
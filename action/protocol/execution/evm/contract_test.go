@@ -19,6 +19,8 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
@@ -238,3 +240,45 @@ func TestSnapshot(t *testing.T) {
 		testfunc(true)
 	})
 }
+
+func TestStorageProof(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	sm, err := initMockStateManager(ctrl)
+	require.NoError(err)
+	addr := hash.BytesToHash160(identityset.Address(28).Bytes())
+
+	// empty storage
+	rootHash, proofs, err := StorageProof(sm, addr, hash.ZeroHash256, []hash.Hash256{_k1b})
+	require.NoError(err)
+	require.Len(proofs, 1)
+	require.Nil(proofs[0].Value)
+	_, err = mptrie.VerifyProof(StorageHashFunc(addr), rootHash, _k1b[:], proofs[0].Proof)
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+	s, err := state.NewAccount()
+	require.NoError(err)
+	c, err := newContract(addr, s, sm, false)
+	require.NoError(err)
+	require.NoError(c.SetState(_k1b, _v1b[:]))
+	require.NoError(c.SetState(_k2b, _v2b[:]))
+	require.NoError(c.Commit())
+	root := c.SelfState().Root
+	rootHash, proofs, err = StorageProof(sm, addr, root, []hash.Hash256{_k1b, _k2b, _k3b})
+	require.NoError(err)
+	require.Equal(root[:], rootHash)
+	require.Len(proofs, 3)
+	for i, v := range [][]byte{_v1b[:], _v2b[:], nil} {
+		require.Equal(v, proofs[i].Value)
+		value, err := mptrie.VerifyProof(StorageHashFunc(addr), rootHash, proofs[i].Key[:], proofs[i].Proof)
+		if v == nil {
+			require.Equal(trie.ErrNotExist, errors.Cause(err))
+			continue
+		}
+		require.NoError(err)
+		require.Equal(v, value)
+	}
+	// proof doesn't verify with the hash func of another contract
+	_, err = mptrie.VerifyProof(StorageHashFunc(hash.Hash160b([]byte("random"))), rootHash, _k1b[:], proofs[0].Proof)
+	require.Equal(mptrie.ErrInvalidProof, errors.Cause(err))
+}
@@ -56,7 +56,7 @@ type (
 	GetBlockTime func(uint64) (time.Time, error)
 
 	// DepositGasWithSGD deposits gas with Sharing of Gas-fee with DApps
-	DepositGasWithSGD func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int) (*action.TransactionLog, error)
+	DepositGasWithSGD func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int, ...protocol.DepositOption) ([]*action.TransactionLog, error)
 
 	// SGDRegistry is the interface for handling Sharing of Gas-fee with DApps
 	SGDRegistry interface {
@@ -105,6 +105,18 @@ type (
 	}
 )
 
+// tipPrice returns the gas price paid above the base fee
+func (ps *Params) tipPrice() *big.Int {
+	if ps.blkCtx.BaseFee == nil {
+		return ps.txCtx.GasPrice
+	}
+	tip := new(big.Int).Sub(ps.txCtx.GasPrice, ps.blkCtx.BaseFee)
+	if tip.Sign() < 0 {
+		return new(big.Int)
+	}
+	return tip
+}
+
 // newParams creates a new context for use in the EVM.
 func newParams(
 	ctx context.Context,
@@ -170,6 +182,9 @@ func newParams(
 		Difficulty:  new(big.Int).SetUint64(uint64(50)),
 		BaseFee:     new(big.Int),
 	}
+	if blkCtx.BaseFee != nil {
+		context.BaseFee = new(big.Int).Set(blkCtx.BaseFee)
+	}
 	if g.IsSumatra(blkCtx.BlockHeight) {
 		// Random opcode (EIP-4399) is not supported
 		context.Random = &common.Hash{}
@@ -187,7 +202,7 @@ func newParams(
 		context,
 		vm.TxContext{
 			Origin:   executorAddr,
-			GasPrice: execution.GasPrice(),
+			GasPrice: execution.EffectiveGasPrice(blkCtx.BaseFee),
 		},
 		execution.Nonce(),
 		execution.Value(),
@@ -254,8 +269,9 @@ func ExecuteContract(
 
 	receipt.Status = uint64(statusCode)
 	var (
-		depositLog, burnLog *action.TransactionLog
-		consumedGas         = depositGas - remainingGas
+		depositLog  []*action.TransactionLog
+		burnLog     *action.TransactionLog
+		consumedGas = depositGas - remainingGas
 	)
 	if ps.featureCtx.FixDoubleChargeGas {
 		// Refund all deposit and, actual gas fee will be subtracted when depositing gas fee to the rewarding protocol
@@ -288,12 +304,13 @@ func ExecuteContract(
 			}
 		}
 		if sharedGas > 0 {
+			// only the priority fee is shared, the base fee portion is burnt
 			sharedGasFee = big.NewInt(int64(sharedGas))
-			sharedGasFee.Mul(sharedGasFee, ps.txCtx.GasPrice)
+			sharedGasFee.Mul(sharedGasFee, ps.tipPrice())
 		}
 		totalGasFee = new(big.Int).Mul(new(big.Int).SetUint64(consumedGas), ps.txCtx.GasPrice)
 		if ps.helperCtx.DepositGasFunc != nil {
-			depositLog, err = ps.helperCtx.DepositGasFunc(ctx, sm, receiver, totalGasFee, sharedGasFee)
+			depositLog, err = ps.helperCtx.DepositGasFunc(ctx, sm, receiver, totalGasFee, sharedGasFee, protocol.BurnBaseFeeOption(ctx, consumedGas))
 			if err != nil {
 				return nil, nil, err
 			}
@@ -304,7 +321,7 @@ func ExecuteContract(
 	if err := stateDB.CommitContracts(); err != nil {
 		return nil, nil, errors.Wrap(err, "failed to commit contracts to underlying db")
 	}
-	receipt.AddLogs(stateDB.Logs()...).AddTransactionLogs(depositLog, burnLog)
+	receipt.AddLogs(stateDB.Logs()...).AddTransactionLogs(depositLog...).AddTransactionLogs(burnLog)
 	if receipt.Status == uint64(iotextypes.ReceiptStatus_Success) ||
 		ps.featureCtx.AddOutOfGasToTransactionLog && receipt.Status == uint64(iotextypes.ReceiptStatus_ErrCodeStoreOutOfGas) {
 		receipt.AddTransactionLogs(stateDB.TransactionLogs()...)
@@ -657,17 +674,23 @@ func SimulateExecution(
 	if err != nil {
 		return nil, nil, err
 	}
-	ctx = protocol.WithBlockCtx(
-		ctx,
-		protocol.BlockCtx{
-			BlockHeight:    bcCtx.Tip.Height + 1,
-			BlockTimeStamp: bcCtx.Tip.Timestamp.Add(g.BlockInterval),
-			GasLimit:       g.BlockGasLimitByHeight(bcCtx.Tip.Height + 1),
-			Producer:       zeroAddr,
-		},
-	)
-
-	ctx = protocol.WithFeatureCtx(ctx)
+	blkCtx := protocol.BlockCtx{
+		BlockHeight:    bcCtx.Tip.Height + 1,
+		BlockTimeStamp: bcCtx.Tip.Timestamp.Add(g.BlockInterval),
+		GasLimit:       g.BlockGasLimitByHeight(bcCtx.Tip.Height + 1),
+		Producer:       zeroAddr,
+		BaseFee:        protocol.CalcBaseFee(g.Blockchain, &bcCtx.Tip),
+	}
+	override, hasOverride := GetSimulationOverrideCtx(ctx)
+	if hasOverride {
+		override.Block.Apply(&blkCtx)
+	}
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, blkCtx))
+	if hasOverride && len(override.State) > 0 {
+		if err := override.State.Apply(ctx, sm); err != nil {
+			return nil, nil, err
+		}
+	}
 	return ExecuteContract(
 		ctx,
 		sm,
@@ -0,0 +1,155 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account/accountpb"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+type (
+	// AccountOverride overrides the state of an account in a simulated execution, nil fields are not overridden
+	AccountOverride struct {
+		Balance *big.Int
+		// Nonce is the nonce of the next transaction sent by the account
+		Nonce *uint64
+		Code  []byte
+		// State replaces the whole storage of the account, while StateDiff only replaces the given slots
+		State     map[common.Hash]common.Hash
+		StateDiff map[common.Hash]common.Hash
+	}
+
+	// StateOverride is the set of account overrides of a simulated execution
+	StateOverride map[common.Address]*AccountOverride
+
+	// BlockOverride overrides the context of the block in which the execution is simulated
+	BlockOverride struct {
+		Number   *uint64
+		Time     *uint64
+		Coinbase address.Address
+		GasLimit *uint64
+	}
+
+	// SimulationOverride is the overlay applied to a simulated execution. The state override only changes the working
+	// set of the simulation, which is never committed
+	SimulationOverride struct {
+		State StateOverride
+		Block *BlockOverride
+	}
+)
+
+// Apply applies the state override to the state manager
+func (o StateOverride) Apply(ctx context.Context, sm protocol.StateManager) error {
+	addrs := make([]common.Address, 0, len(o))
+	for addr, acct := range o {
+		if acct == nil {
+			continue
+		}
+		if acct.State != nil && acct.StateDiff != nil {
+			return errors.Errorf("both state and stateDiff are overridden for account %s", addr.Hex())
+		}
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+
+	var opts []state.AccountCreationOption
+	if protocol.MustGetFeatureCtx(ctx).CreateLegacyNonceAccount {
+		opts = append(opts, state.LegacyNonceAccountTypeOption())
+	}
+	// account fields are overridden first, so that the contracts below are loaded from the overridden accounts
+	for _, addr := range addrs {
+		acct := o[addr]
+		if acct.Balance == nil && acct.Nonce == nil && acct.State == nil {
+			continue
+		}
+		ioAddr, err := address.FromBytes(addr.Bytes())
+		if err != nil {
+			return err
+		}
+		s, err := accountutil.LoadOrCreateAccount(sm, ioAddr, opts...)
+		if err != nil {
+			return err
+		}
+		if acct.Balance != nil {
+			if acct.Balance.Sign() < 0 {
+				return errors.Wrapf(state.ErrInvalidAmount, "balance of account %s", addr.Hex())
+			}
+			s.Balance = new(big.Int).Set(acct.Balance)
+		}
+		if acct.Nonce != nil {
+			// a zero-nonce account expects the nonce as its next nonce
+			pb := s.ToProto()
+			pb.Type = accountpb.AccountType_ZERO_NONCE
+			pb.Nonce = *acct.Nonce
+			s.FromProto(pb)
+		}
+		if acct.State != nil {
+			s.Root = hash.ZeroHash256
+		}
+		if err := accountutil.StoreAccount(sm, ioAddr, s); err != nil {
+			return err
+		}
+	}
+
+	stateDB, err := prepareStateDB(ctx, sm)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		acct := o[addr]
+		if acct.Code != nil {
+			stateDB.SetCode(addr, acct.Code)
+		}
+		storage := acct.State
+		if storage == nil {
+			storage = acct.StateDiff
+		}
+		keys := make([]common.Hash, 0, len(storage))
+		for k := range storage {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+		for _, k := range keys {
+			stateDB.SetState(addr, k, storage[k])
+		}
+	}
+	if err := stateDB.Error(); err != nil {
+		return errors.Wrap(err, "failed to override state")
+	}
+	return stateDB.CommitContracts()
+}
+
+// Apply applies the block override to the block context
+func (o *BlockOverride) Apply(blkCtx *protocol.BlockCtx) {
+	if o == nil {
+		return
+	}
+	if o.Number != nil {
+		blkCtx.BlockHeight = *o.Number
+	}
+	if o.Time != nil {
+		blkCtx.BlockTimeStamp = time.Unix(int64(*o.Time), 0)
+	}
+	if o.Coinbase != nil {
+		blkCtx.Producer = o.Coinbase
+	}
+	if o.GasLimit != nil {
+		blkCtx.GasLimit = *o.GasLimit
+	}
+}
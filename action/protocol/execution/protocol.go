@@ -8,6 +8,7 @@ package execution
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
@@ -16,6 +17,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -106,6 +108,31 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action, _ protocol.S
 	return nil
 }
 
+// CreateGenesisStates deploys the contract code and storage of the genesis alloc, on top of the accounts created by
+// the account protocol
+func (p *Protocol) CreateGenesisStates(ctx context.Context, sm protocol.StateManager) error {
+	g := genesis.MustExtractGenesisContext(ctx)
+	addrs, accts := g.Allocs()
+	override := make(evm.StateOverride)
+	for i, addr := range addrs {
+		code, storage := accts[i].Code(), accts[i].Storage()
+		if len(code) == 0 && len(storage) == 0 {
+			continue
+		}
+		override[common.BytesToAddress(addr.Bytes())] = &evm.AccountOverride{
+			Code:      code,
+			StateDiff: storage,
+		}
+	}
+	if len(override) == 0 {
+		return nil
+	}
+	if err := override.Apply(protocol.WithActionCtx(ctx, protocol.ActionCtx{}), sm); err != nil {
+		return errors.Wrap(err, "failed to deploy genesis alloc")
+	}
+	return nil
+}
+
 // ReadState read the state on blockchain via protocol
 func (p *Protocol) ReadState(context.Context, protocol.StateReader, []byte, ...[]byte) ([]byte, uint64, error) {
 	return nil, uint64(0), protocol.ErrUnimplemented
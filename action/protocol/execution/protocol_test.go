@@ -668,6 +668,69 @@ func TestProtocol_Validate(t *testing.T) {
 	}
 }
 
+func TestProtocol_CreateGenesisStates(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	var (
+		contract = identityset.Address(30)
+		owner    = identityset.Address(31)
+		// returns sload(0)
+		code = "0x60005460005260206000f3"
+	)
+	cfg.Genesis.AllocMap = map[string]*genesis.AllocAccount{
+		common.BytesToAddress(contract.Bytes()).Hex(): {
+			BalanceStr: "0x64",
+			Nonce:      1,
+			CodeStr:    code,
+			StorageMap: map[string]string{"0x00": "0x2a"},
+		},
+		owner.String(): {
+			BalanceStr: "1000",
+			Nonce:      5,
+		},
+	}
+	registry := protocol.NewRegistry()
+	require.NoError(account.NewProtocol(rewarding.DepositGas).Register(registry))
+	require.NoError(NewProtocol(func(uint64) (hash.Hash256, error) {
+		return hash.ZeroHash256, nil
+	}, rewarding.DepositGasWithSGD, nil, getBlockTimeForTest).Register(registry))
+	sf, err := factory.NewStateDB(factory.GenerateConfig(cfg.Chain, cfg.Genesis), db.NewMemKVStore(), factory.RegistryStateDBOption(registry))
+	require.NoError(err)
+	ctx = genesis.WithGenesisContext(protocol.WithRegistry(ctx, registry), cfg.Genesis)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	c, err := readCode(sf, contract.Bytes())
+	require.NoError(err)
+	require.Equal(common.FromHex(code), c)
+	readCtx := protocol.WithFeatureCtx(protocol.WithBlockCtx(protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{}), protocol.BlockCtx{}))
+	v, err := sf.ReadContractStorage(readCtx, contract, make([]byte, 32))
+	require.NoError(err)
+	require.Equal(common.BigToHash(big.NewInt(42)).Bytes(), v)
+	for _, v := range []struct {
+		addr    address.Address
+		balance int64
+		nonce   uint64
+	}{
+		{contract, 100, 1},
+		{owner, 1000, 5},
+	} {
+		acct, err := accountutil.LoadAccount(sf, v.addr)
+		require.NoError(err)
+		require.Equal(big.NewInt(v.balance), acct.Balance)
+		require.Equal(v.nonce, acct.PendingNonce())
+	}
+
+	// the alloc overlapping the init balances is rejected
+	cfg.Genesis.InitBalanceMap = map[string]string{owner.String(): "1"}
+	sf, err = factory.NewStateDB(factory.GenerateConfig(cfg.Chain, cfg.Genesis), db.NewMemKVStore(), factory.RegistryStateDBOption(registry))
+	require.NoError(err)
+	require.Error(sf.Start(genesis.WithGenesisContext(ctx, cfg.Genesis)))
+}
+
 func TestProtocol_Handle(t *testing.T) {
 	testEVM := func(t *testing.T) {
 		log.S().Info("Test EVM")
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/state"
@@ -36,6 +37,13 @@ func NewGenericValidator(sr StateReader, accountState AccountState) *GenericVali
 
 // Validate validates a generic action
 func (v *GenericValidator) Validate(ctx context.Context, selp *action.SealedEnvelope) error {
+	// typed transactions are only accepted after the fork
+	if featureCtx, ok := GetFeatureCtx(ctx); ok && !featureCtx.EnableDynamicFeeTx {
+		switch iotextypes.Encoding(selp.Encoding()) {
+		case iotextypes.Encoding_ETHEREUM_ACCESSLIST, iotextypes.Encoding_ETHEREUM_DYNAMICFEE:
+			return errors.Wrapf(action.ErrTxTypeNotSupported, "encoding %s", iotextypes.Encoding(selp.Encoding()))
+		}
+	}
 	intrinsicGas, err := selp.IntrinsicGas()
 	if err != nil {
 		return err
@@ -7,14 +7,17 @@ package protocol
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"encoding/hex"
 	"math/big"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
@@ -170,6 +173,36 @@ func TestActionProtoAndGenericValidator(t *testing.T) {
 		require.NoError(err)
 		require.Error(valid.Validate(ctx, selp))
 	})
+	t.Run("typed tx before fork", func(t *testing.T) {
+		sk, ok := identityset.PrivateKey(28).EcdsaPrivateKey().(*ecdsa.PrivateKey)
+		require.True(ok)
+		tx, err := types.SignNewTx(sk, types.NewLondonSigner(big.NewInt(int64(_evmNetworkID))), &types.DynamicFeeTx{
+			ChainID:   big.NewInt(int64(_evmNetworkID)),
+			Nonce:     3,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(10),
+			Gas:       100000,
+			Data:      data,
+		})
+		require.NoError(err)
+		encoding, sig, pubkey, err := action.ExtractTypeSigPubkey(tx)
+		require.NoError(err)
+		require.Equal(iotextypes.Encoding_ETHEREUM_DYNAMICFEE, encoding)
+		elp, err := (&action.EnvelopeBuilder{}).BuildExecution(tx)
+		require.NoError(err)
+		selp, err := (&action.Deserializer{}).SetEvmNetworkID(_evmNetworkID).ActionToSealedEnvelope(&iotextypes.Action{
+			Core:         elp.Proto(),
+			SenderPubKey: pubkey.Bytes(),
+			Signature:    sig,
+			Encoding:     encoding,
+		})
+		require.NoError(err)
+		require.ErrorIs(valid.Validate(ctx, selp), action.ErrTxTypeNotSupported)
+
+		g := genesis.Default
+		g.VanuatuBlockHeight = 1
+		require.NoError(valid.Validate(WithFeatureCtx(genesis.WithGenesisContext(ctx, g)), selp))
+	})
 	t.Run("wrong signature", func(t *testing.T) {
 		unsignedTsf, err := action.NewTransfer(uint64(1), big.NewInt(1), caller.String(), []byte{}, uint64(100000), big.NewInt(0))
 		require.NoError(err)
@@ -135,7 +135,7 @@ func (cc *consortiumCommittee) CreateGenesisStates(ctx context.Context, sm proto
 			return hash.ZeroHash256, nil
 		},
 		GetBlockTime: getBlockTime,
-		DepositGasFunc: func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int) (*action.TransactionLog, error) {
+		DepositGasFunc: func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int, ...protocol.DepositOption) ([]*action.TransactionLog, error) {
 			return nil, nil
 		},
 		Sgd: nil,
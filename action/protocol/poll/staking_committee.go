@@ -143,7 +143,7 @@ func (sc *stakingCommittee) CreateGenesisStates(ctx context.Context, sm protocol
 			// make sure the returned timestamp is after the current block time so that evm upgrades based on timestamp (Shanghai and onwards) are disabled
 			return blkCtx.BlockTimeStamp.Add(5 * time.Second), nil
 		},
-		DepositGasFunc: func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int) (*action.TransactionLog, error) {
+		DepositGasFunc: func(context.Context, protocol.StateManager, address.Address, *big.Int, *big.Int, ...protocol.DepositOption) ([]*action.TransactionLog, error) {
 			return nil, nil
 		},
 		Sgd: nil,
@@ -65,16 +65,20 @@ func (p *Protocol) Deposit(
 	transactionLogType iotextypes.TransactionLogType,
 ) (*action.TransactionLog, error) {
 	// fallback to regular case by setting sgdAmount = nil
-	return p.deposit(ctx, sm, nil, amount, nil, transactionLogType)
+	tLogs, err := p.deposit(ctx, sm, nil, amount, nil, nil, transactionLogType)
+	if err != nil {
+		return nil, err
+	}
+	return tLogs[0], nil
 }
 
 func (p *Protocol) deposit(
 	ctx context.Context,
 	sm protocol.StateManager,
 	receiver address.Address,
-	amount, sgdAmount *big.Int,
+	amount, sgdAmount, burnAmount *big.Int,
 	transactionLogType iotextypes.TransactionLogType,
-) (*action.TransactionLog, error) {
+) ([]*action.TransactionLog, error) {
 	actionCtx := protocol.MustGetActionCtx(ctx)
 	accountCreationOpts := []state.AccountCreationOption{}
 	if protocol.MustGetFeatureCtx(ctx).CreateLegacyNonceAccount {
@@ -91,6 +95,20 @@ func (p *Protocol) deposit(
 	if err := accountutil.StoreAccount(sm, actionCtx.Caller, acc); err != nil {
 		return nil, err
 	}
+	// the burnt portion is removed from circulation, only the rest goes to fund
+	var burnLog *action.TransactionLog
+	if !isZero(burnAmount) {
+		if burnAmount.Cmp(amount) > 0 {
+			burnAmount = amount
+		}
+		amount = new(big.Int).Sub(amount, burnAmount)
+		burnLog = &action.TransactionLog{
+			Type:      transactionLogType,
+			Sender:    actionCtx.Caller.String(),
+			Recipient: "", // burned
+			Amount:    burnAmount,
+		}
+	}
 	// Add balance to fund
 	f := fund{}
 	if _, err := p.state(ctx, sm, _fundKey, &f); err != nil {
@@ -111,12 +129,16 @@ func (p *Protocol) deposit(
 	if err := p.putState(ctx, sm, _fundKey, &f); err != nil {
 		return nil, err
 	}
-	return &action.TransactionLog{
+	tLogs := []*action.TransactionLog{{
 		Type:      transactionLogType,
 		Sender:    actionCtx.Caller.String(),
 		Recipient: address.RewardingPoolAddr,
 		Amount:    amount,
-	}, nil
+	}}
+	if burnLog != nil {
+		tLogs = append(tLogs, burnLog)
+	}
+	return tLogs, nil
 }
 
 // TotalBalance returns the total balance of the rewarding fund
@@ -146,7 +168,7 @@ func (p *Protocol) AvailableBalance(
 }
 
 // DepositGas deposits gas into the rewarding fund
-func DepositGas(ctx context.Context, sm protocol.StateManager, amount *big.Int) (*action.TransactionLog, error) {
+func DepositGas(ctx context.Context, sm protocol.StateManager, amount *big.Int, opts ...protocol.DepositOption) ([]*action.TransactionLog, error) {
 	// If the gas fee is 0, return immediately
 	if amount.Cmp(big.NewInt(0)) == 0 {
 		return nil, nil
@@ -165,15 +187,17 @@ func DepositGas(ctx context.Context, sm protocol.StateManager, amount *big.Int)
 	if rp == nil {
 		return nil, nil
 	}
-	return rp.Deposit(ctx, sm, amount, iotextypes.TransactionLogType_GAS_FEE)
+	cfg := protocol.NewDepositOptionCfg(opts...)
+	return rp.deposit(ctx, sm, nil, amount, nil, cfg.BurnAmount, iotextypes.TransactionLogType_GAS_FEE)
 }
 
 // DepositGasWithSGD deposits gas into the rewarding fund with Sharing of Gas-fee with DApps
 func DepositGasWithSGD(ctx context.Context, sm protocol.StateManager, sgdReceiver address.Address, totalAmount, sgdAmount *big.Int,
-) (*action.TransactionLog, error) {
+	opts ...protocol.DepositOption,
+) ([]*action.TransactionLog, error) {
 	if isZero(sgdAmount) {
 		// fallback to regular case if SGD amount is zero
-		return DepositGas(ctx, sm, totalAmount)
+		return DepositGas(ctx, sm, totalAmount, opts...)
 	}
 	if sgdReceiver == nil {
 		// a valid SGD amount but no valid receiver address
@@ -193,7 +217,8 @@ func DepositGasWithSGD(ctx context.Context, sm protocol.StateManager, sgdReceive
 	if rp == nil {
 		return nil, nil
 	}
-	return rp.deposit(ctx, sm, sgdReceiver, totalAmount, sgdAmount, iotextypes.TransactionLogType_GAS_FEE)
+	cfg := protocol.NewDepositOptionCfg(opts...)
+	return rp.deposit(ctx, sm, sgdReceiver, totalAmount, sgdAmount, cfg.BurnAmount, iotextypes.TransactionLogType_GAS_FEE)
 }
 
 func isZero(a *big.Int) bool {
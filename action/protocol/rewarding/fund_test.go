@@ -56,3 +56,28 @@ func TestDepositNegativeGasFee(t *testing.T) {
 		require.Error(t, err)
 	}, false)
 }
+
+func TestDepositGasBurnBaseFee(t *testing.T) {
+	testProtocol(t, func(t *testing.T, ctx context.Context, sm protocol.StateManager, p *Protocol) {
+		blkCtx := protocol.MustGetBlockCtx(ctx)
+		blkCtx.BaseFee = big.NewInt(3)
+		ctx = protocol.WithBlockCtx(ctx, blkCtx)
+		actionCtx := protocol.MustGetActionCtx(ctx)
+
+		// pay 10 for 2 gas, 2 * 3 is burnt and the rest goes to the fund
+		tLogs, err := DepositGas(ctx, sm, big.NewInt(10), protocol.BurnBaseFeeOption(ctx, 2))
+		require.NoError(t, err)
+		require.Len(t, tLogs, 2)
+		require.Equal(t, address.RewardingPoolAddr, tLogs[0].Recipient)
+		require.Equal(t, big.NewInt(4), tLogs[0].Amount)
+		require.Empty(t, tLogs[1].Recipient)
+		require.Equal(t, big.NewInt(6), tLogs[1].Amount)
+
+		totalBalance, _, err := p.TotalBalance(ctx, sm)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(4), totalBalance)
+		acc, err := accountutil.LoadAccount(sm, actionCtx.Caller)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(990), acc.Balance)
+	}, false)
+}
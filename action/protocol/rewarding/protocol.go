@@ -418,13 +418,11 @@ func (p *Protocol) settleAction(
 	skipUpdateForSystemAction := protocol.MustGetFeatureCtx(ctx).FixGasAndNonceUpdate
 	if !isSystemAction || !skipUpdateForSystemAction {
 		gasFee := big.NewInt(0).Mul(actionCtx.GasPrice, big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
-		depositLog, err := DepositGas(ctx, sm, gasFee)
+		depositLogs, err := DepositGas(ctx, sm, gasFee, protocol.BurnBaseFeeOption(ctx, actionCtx.IntrinsicGas))
 		if err != nil {
 			return nil, err
 		}
-		if depositLog != nil {
-			tLogs = append(tLogs, depositLog)
-		}
+		tLogs = append(tLogs, depositLogs...)
 		if err := p.increaseNonce(
 			ctx,
 			sm,
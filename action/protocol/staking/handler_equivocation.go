@@ -0,0 +1,157 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	handleEquivocationEvidence = "equivocationEvidence"
+)
+
+func (p *Protocol) validateEquivocationEvidence(ctx context.Context, act *action.Execution) error {
+	if act.Amount().Sign() != 0 {
+		return errors.Wrap(action.ErrInvalidAmount, "equivocation evidence cannot carry amount")
+	}
+	ev, err := action.NewEquivocationEvidenceFromABIBinary(act.Data())
+	if err != nil {
+		return errors.Wrap(action.ErrInvalidEvidence, err.Error())
+	}
+	return ev.Verify()
+}
+
+// handleEquivocationEvidence slashes the candidate whose operator signed conflicting consensus votes. A portion of
+// the self-stake bucket is burned, and the bucket is released from self-staking, which takes the candidate out of
+// the active candidates until the owner activates a new self-stake bucket
+func (p *Protocol) handleEquivocationEvidence(ctx context.Context, act *action.Execution, csm CandidateStateManager,
+) (*receiptLog, []*action.TransactionLog, error) {
+	blkCtx := protocol.MustGetBlockCtx(ctx)
+	featureCtx := protocol.MustGetFeatureCtx(ctx)
+	log := newReceiptLog(p.addr.String(), handleEquivocationEvidence, featureCtx.NewStakingReceiptFormat)
+
+	ev, err := action.NewEquivocationEvidenceFromABIBinary(act.Data())
+	if err == nil {
+		err = ev.Verify()
+	}
+	if err != nil {
+		return log, nil, &handleError{
+			err:           err,
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	if blkCtx.BlockTimeStamp.Sub(ev.Timestamp()) > p.config.EquivocationEvidenceMaxAge {
+		return log, nil, &handleError{
+			err:           errors.Wrapf(action.ErrInvalidEvidence, "evidence at %s is expired", ev.Timestamp()),
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	evHash := ev.Hash()
+	slashed, err := isEquivocationSlashed(csm.SR(), evHash)
+	if err != nil {
+		return log, nil, err
+	}
+	if slashed {
+		return log, nil, &handleError{
+			err:           errors.Wrapf(action.ErrInvalidEvidence, "equivocation %x has been slashed", evHash),
+			failureStatus: iotextypes.ReceiptStatus_Failure,
+		}
+	}
+	cand := csm.GetByOperator(ev.Endorser().Address())
+	if cand == nil {
+		return log, nil, errCandNotExist
+	}
+	log.AddTopics(evHash[:], cand.Owner.Bytes())
+	if err := putEquivocationSlashed(csm.SM(), evHash, blkCtx.BlockHeight); err != nil {
+		return log, nil, errors.Wrapf(err, "failed to record equivocation %x", evHash)
+	}
+	if !cand.isSelfStakeBucketSettled() {
+		// nothing to slash
+		return log, nil, nil
+	}
+
+	bucket, rErr := p.fetchBucket(csm, cand.SelfStakeBucketIdx)
+	if rErr != nil {
+		return log, nil, rErr
+	}
+	slashAmount := new(big.Int).Mul(bucket.StakedAmount, new(big.Int).SetUint64(p.config.EquivocationSlashRate))
+	slashAmount.Div(slashAmount, big.NewInt(100))
+	if err := cand.SubVote(p.calculateVoteWeight(bucket, true)); err != nil {
+		return log, nil, &handleError{
+			err:           errors.Wrapf(err, "failed to subtract vote for candidate %s", cand.Owner.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrNotEnoughBalance,
+		}
+	}
+	bucket.StakedAmount.Sub(bucket.StakedAmount, slashAmount)
+	if err := csm.updateBucket(bucket.Index, bucket); err != nil {
+		return log, nil, errors.Wrapf(err, "failed to update bucket %d", bucket.Index)
+	}
+	// the slashed bucket becomes a normal vote bucket of the candidate
+	if err := cand.AddVote(p.calculateVoteWeight(bucket, false)); err != nil {
+		return log, nil, &handleError{
+			err:           errors.Wrapf(err, "failed to add vote for candidate %s", cand.Owner.String()),
+			failureStatus: iotextypes.ReceiptStatus_ErrInvalidBucketAmount,
+		}
+	}
+	cand.SelfStakeBucketIdx = candidateNoSelfStakeBucketIndex
+	cand.SelfStake = big.NewInt(0)
+	if err := csm.Upsert(cand); err != nil {
+		return log, nil, csmErrorToHandleError(cand.Owner.String(), err)
+	}
+	if err := csm.CreditBucketPool(slashAmount); err != nil {
+		return log, nil, &handleError{
+			err:           errors.Wrapf(err, "failed to update staking bucket pool %s", err.Error()),
+			failureStatus: iotextypes.ReceiptStatus_ErrWriteAccount,
+		}
+	}
+	log.SetData(slashAmount.Bytes())
+
+	return log, []*action.TransactionLog{
+		{
+			Type:      iotextypes.TransactionLogType_WITHDRAW_BUCKET,
+			Sender:    address.StakingBucketPoolAddr,
+			Recipient: "", // burned
+			Amount:    slashAmount,
+		},
+	}, nil
+}
+
+func equivocationKey(evHash hash.Hash256) []byte {
+	key := []byte{_equivocation}
+	return append(key, evHash[:]...)
+}
+
+func isEquivocationSlashed(sr protocol.StateReader, evHash hash.Hash256) (bool, error) {
+	var height protocol.SerializableBytes
+	_, err := sr.State(&height, protocol.NamespaceOption(_stakingNameSpace), protocol.KeyOption(equivocationKey(evHash)))
+	switch errors.Cause(err) {
+	case nil:
+		return true, nil
+	case state.ErrStateNotExist:
+		return false, nil
+	default:
+		return false, errors.Wrapf(err, "failed to read equivocation %x", evHash)
+	}
+}
+
+func putEquivocationSlashed(sm protocol.StateManager, evHash hash.Hash256, height uint64) error {
+	_, err := sm.PutState(
+		protocol.SerializableBytes(byteutil.Uint64ToBytesBigEndian(height)),
+		protocol.NamespaceOption(_stakingNameSpace),
+		protocol.KeyOption(equivocationKey(evHash)))
+	return err
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package staking
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	blake2b "github.com/minio/blake2b-simd"
+	"github.com/mohae/deepcopy"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/endorsement"
+	"github.com/iotexproject/iotex-core/test/identityset"
+)
+
+type testVoteDoc struct {
+	vote *iotextypes.ConsensusVote
+}
+
+func (doc *testVoteDoc) Hash() ([]byte, error) {
+	ser, err := proto.Marshal(doc.vote)
+	if err != nil {
+		return nil, err
+	}
+	h := blake2b.Sum256(ser)
+	return h[:], nil
+}
+
+func newTestEquivocationEvidence(r *require.Assertions, sk1, sk2 crypto.PrivateKey, ts time.Time) *action.EquivocationEvidence {
+	vote1 := &iotextypes.ConsensusVote{BlockHash: []byte("block1"), Topic: iotextypes.ConsensusVote_COMMIT}
+	vote2 := &iotextypes.ConsensusVote{BlockHash: []byte("block2"), Topic: iotextypes.ConsensusVote_COMMIT}
+	en1, err := endorsement.Endorse(sk1, &testVoteDoc{vote1}, ts)
+	r.NoError(err)
+	en2, err := endorsement.Endorse(sk2, &testVoteDoc{vote2}, ts)
+	r.NoError(err)
+	return action.NewEquivocationEvidence(vote1, en1, vote2, en2)
+}
+
+func TestProtocol_HandleEquivocationEvidence(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	sm, p, buckets, _ := initTestState(t, ctrl, []*bucketConfig{
+		{identityset.Address(1), identityset.Address(1), "1200000000000000000000000", 30, true, true, nil, 0},
+	}, []*candidateConfig{
+		{identityset.Address(1), identityset.Address(7), identityset.Address(1), "test1"},
+	})
+	caller := identityset.Address(2)
+	require.NoError(setupAccount(sm, caller, 1000))
+
+	handle := func(ev *action.EquivocationEvidence, nonce uint64, enabled bool) (*action.Receipt, error) {
+		act, err := action.NewEquivocationEvidenceExecution(nonce, 1000000, big.NewInt(1000), ev)
+		require.NoError(err)
+		require.True(action.IsEquivocationEvidence(act))
+		intrinsicGas, err := act.IntrinsicGas()
+		require.NoError(err)
+		ctx := protocol.WithActionCtx(context.Background(), protocol.ActionCtx{
+			Caller:       caller,
+			GasPrice:     big.NewInt(1000),
+			IntrinsicGas: intrinsicGas,
+			Nonce:        nonce,
+		})
+		ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+			BlockHeight:    1,
+			BlockTimeStamp: timeBlock,
+			GasLimit:       1000000,
+		})
+		cfg := deepcopy.Copy(genesis.Default).(genesis.Genesis)
+		cfg.TsunamiBlockHeight = 1
+		if enabled {
+			cfg.ToBeEnabledBlockHeight = 1
+		}
+		ctx = genesis.WithGenesisContext(ctx, cfg)
+		ctx = protocol.WithFeatureCtx(protocol.WithFeatureWithHeightCtx(ctx))
+		if err := p.Validate(ctx, act, sm); err != nil {
+			return nil, err
+		}
+		return p.Handle(ctx, act, sm)
+	}
+
+	operatorSk := identityset.PrivateKey(7)
+	ev := newTestEquivocationEvidence(require, operatorSk, operatorSk, timeBlock.Add(-time.Hour))
+	// not handled before the feature is enabled
+	r, err := handle(ev, 1, false)
+	require.NoError(err)
+	require.Nil(r)
+
+	// votes signed by different endorsers
+	_, err = handle(newTestEquivocationEvidence(require, operatorSk, identityset.PrivateKey(8), timeBlock), 1, true)
+	require.Equal(action.ErrInvalidEvidence, errors.Cause(err))
+
+	// expired evidence
+	r, err = handle(newTestEquivocationEvidence(require, operatorSk, operatorSk, timeBlock.Add(-p.config.EquivocationEvidenceMaxAge-time.Second)), 1, true)
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_Failure, r.Status)
+
+	// the endorser is not a candidate
+	sk := identityset.PrivateKey(9)
+	r, err = handle(newTestEquivocationEvidence(require, sk, sk, timeBlock), 2, true)
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_ErrCandidateNotExist, r.Status)
+
+	r, err = handle(ev, 3, true)
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_Success, r.Status)
+	slashed, _ := new(big.Int).SetString("120000000000000000000000", 10)
+	tLogs := r.TransactionLogs()
+	require.Equal(address.StakingBucketPoolAddr, tLogs[len(tLogs)-1].Sender)
+	require.Empty(tLogs[len(tLogs)-1].Recipient)
+	require.Equal(slashed, tLogs[len(tLogs)-1].Amount)
+
+	// the candidate is forced to exit with the slashed bucket as a normal vote
+	csm, err := NewCandidateStateManager(sm, false)
+	require.NoError(err)
+	cand := csm.GetByOwner(identityset.Address(1))
+	require.Equal(uint64(candidateNoSelfStakeBucketIndex), cand.SelfStakeBucketIdx)
+	require.Zero(cand.SelfStake.Sign())
+	bucket, err := csm.getBucket(buckets[0].Index)
+	require.NoError(err)
+	require.Equal("1080000000000000000000000", bucket.StakedAmount.String())
+	require.Equal(p.calculateVoteWeight(bucket, false), cand.Votes)
+
+	// the same equivocation cannot be slashed twice
+	r, err = handle(ev, 4, true)
+	require.NoError(err)
+	require.EqualValues(iotextypes.ReceiptStatus_Failure, r.Status)
+}
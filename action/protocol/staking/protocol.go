@@ -52,6 +52,7 @@ const (
 	_voterIndex
 	_candIndex
 	_endorsement
+	_equivocation
 )
 
 // Errors
@@ -94,10 +95,12 @@ type (
 		BootstrapCandidates              []genesis.BootstrapCandidate
 		PersistStakingPatchBlock         uint64
 		EndorsementWithdrawWaitingBlocks uint64
+		EquivocationSlashRate            uint64
+		EquivocationEvidenceMaxAge       time.Duration
 	}
 
 	// DepositGas deposits gas to some pool
-	DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int) (*action.TransactionLog, error)
+	DepositGas func(ctx context.Context, sm protocol.StateManager, amount *big.Int, opts ...protocol.DepositOption) ([]*action.TransactionLog, error)
 )
 
 // FindProtocol return a registered protocol from registry
@@ -162,6 +165,8 @@ func NewProtocol(
 			BootstrapCandidates:              cfg.Staking.BootstrapCandidates,
 			PersistStakingPatchBlock:         cfg.PersistStakingPatchBlock,
 			EndorsementWithdrawWaitingBlocks: cfg.Staking.EndorsementWithdrawWaitingBlocks,
+			EquivocationSlashRate:            cfg.Staking.EquivocationSlashRate,
+			EquivocationEvidenceMaxAge:       cfg.Staking.EquivocationEvidenceMaxAge,
 		},
 		depositGas:             depositGas,
 		candBucketsIndexer:     candBucketsIndexer,
@@ -425,6 +430,11 @@ func (p *Protocol) handle(ctx context.Context, act action.Action, csm CandidateS
 		rLog, tLogs, err = p.handleCandidateActivate(ctx, act, csm)
 	case *action.CandidateEndorsement:
 		rLog, tLogs, err = p.handleCandidateEndorsement(ctx, act, csm)
+	case *action.Execution:
+		if !protocol.MustGetFeatureCtx(ctx).EnableEquivocationSlashing || !action.IsEquivocationEvidence(act) {
+			return nil, nil
+		}
+		rLog, tLogs, err = p.handleEquivocationEvidence(ctx, act, csm)
 	default:
 		return nil, nil
 	}
@@ -473,6 +483,10 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action, sr protocol.
 		return p.validateCandidateActivate(ctx, act)
 	case *action.CandidateEndorsement:
 		return p.validateCandidateEndorsement(ctx, act)
+	case *action.Execution:
+		if protocol.MustGetFeatureCtx(ctx).EnableEquivocationSlashing && action.IsEquivocationEvidence(act) {
+			return p.validateEquivocationEvidence(ctx, act)
+		}
 	}
 	return nil
 }
@@ -653,7 +667,7 @@ func (p *Protocol) settleAction(
 	actionCtx := protocol.MustGetActionCtx(ctx)
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	gasFee := big.NewInt(0).Mul(actionCtx.GasPrice, big.NewInt(0).SetUint64(actionCtx.IntrinsicGas))
-	depositLog, err := p.depositGas(ctx, sm, gasFee)
+	depositLog, err := p.depositGas(ctx, sm, gasFee, protocol.BurnBaseFeeOption(ctx, actionCtx.IntrinsicGas))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to deposit gas")
 	}
@@ -678,7 +692,7 @@ func (p *Protocol) settleAction(
 		GasConsumed:     actionCtx.IntrinsicGas,
 		ContractAddress: p.addr.String(),
 	}
-	r.AddLogs(logs...).AddTransactionLogs(depositLog).AddTransactionLogs(tLogs...)
+	r.AddLogs(logs...).AddTransactionLogs(depositLog...).AddTransactionLogs(tLogs...)
 	return &r, nil
 }
 
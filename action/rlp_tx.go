@@ -50,8 +50,10 @@ func NewEthSigner(txType iotextypes.Encoding, chainID uint32) (types.Signer, err
 	case iotextypes.Encoding_IOTEX_PROTOBUF, iotextypes.Encoding_ETHEREUM_UNPROTECTED:
 		// native tx use same signature format as that of Homestead (for pre-EIP155 unprotected tx)
 		return types.HomesteadSigner{}, nil
-	case iotextypes.Encoding_ETHEREUM_EIP155:
+	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_ACCESSLIST:
 		return types.NewEIP2930Signer(big.NewInt(int64(chainID))), nil
+	case iotextypes.Encoding_ETHEREUM_DYNAMICFEE:
+		return types.NewLondonSigner(big.NewInt(int64(chainID))), nil
 	default:
 		return nil, ErrInvalidAct
 	}
@@ -80,7 +82,7 @@ func DecodeEtherTx(rawData string) (*types.Transaction, error) {
 func ExtractTypeSigPubkey(tx *types.Transaction) (iotextypes.Encoding, []byte, crypto.PublicKey, error) {
 	var (
 		encoding iotextypes.Encoding
-		signer   = types.NewEIP2930Signer(tx.ChainId()) // by default assume latest signer
+		signer   = types.NewLondonSigner(tx.ChainId()) // by default assume latest signer
 		V, R, S  = tx.RawSignatureValues()
 	)
 	// extract correct V value
@@ -96,6 +98,11 @@ func ExtractTypeSigPubkey(tx *types.Transaction) (iotextypes.Encoding, []byte, c
 			encoding = iotextypes.Encoding_ETHEREUM_UNPROTECTED
 			signer = types.HomesteadSigner{}
 		}
+	case types.AccessListTxType:
+		// typed tx has V = 0 or 1
+		encoding = iotextypes.Encoding_ETHEREUM_ACCESSLIST
+	case types.DynamicFeeTxType:
+		encoding = iotextypes.Encoding_ETHEREUM_DYNAMICFEE
 	default:
 		return encoding, nil, nil, ErrNotSupported
 	}
@@ -114,9 +121,45 @@ func ExtractTypeSigPubkey(tx *types.Transaction) (iotextypes.Encoding, []byte, c
 	copy(sig[32-len(r):32], r)
 	copy(sig[64-len(s):64], s)
 	sig[64] = byte(V.Uint64())
+	if tx.Type() != types.LegacyTxType {
+		// typed tx carries the raw recovery id, convert to the 27/28 format
+		sig[64] += 27
+	}
 
 	// recover public key
 	rawHash := signer.Hash(tx)
 	pubkey, err = crypto.RecoverPubkey(rawHash[:], sig)
 	return encoding, sig, pubkey, err
 }
+
+// toTypedTx converts the tx generated from the action payload into the tx type
+// matching the encoding, using the fee caps carried in the envelope
+func toTypedTx(tx *types.Transaction, encoding iotextypes.Encoding, chainID uint32, elp Envelope) *types.Transaction {
+	switch encoding {
+	case iotextypes.Encoding_ETHEREUM_ACCESSLIST:
+		return types.NewTx(&types.AccessListTx{
+			ChainID:    big.NewInt(int64(chainID)),
+			Nonce:      tx.Nonce(),
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	case iotextypes.Encoding_ETHEREUM_DYNAMICFEE:
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    big.NewInt(int64(chainID)),
+			Nonce:      tx.Nonce(),
+			GasTipCap:  elp.GasTipCap(),
+			GasFeeCap:  elp.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	default:
+		return tx
+	}
+}
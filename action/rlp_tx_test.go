@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
@@ -371,22 +372,97 @@ var (
 
 func TestNewEthSignerError(t *testing.T) {
 	require := require.New(t)
-	singer, err := NewEthSigner(iotextypes.Encoding_ETHEREUM_ACCESSLIST, 1)
+	singer, err := NewEthSigner(iotextypes.Encoding(5), 1)
 	require.ErrorIs(err, ErrInvalidAct)
 	require.Nil(singer)
 
-	tx := types.NewTx(&types.DynamicFeeTx{
-		To:        nil,
-		Nonce:     4,
-		Value:     big.NewInt(4),
-		Gas:       4,
-		GasTipCap: big.NewInt(44),
-		GasFeeCap: big.NewInt(1045),
+	tx := types.NewTx(&types.BlobTx{
+		Nonce: 4,
+		Gas:   4,
 	})
 	_, _, _, err = ExtractTypeSigPubkey(tx)
 	require.ErrorIs(err, ErrNotSupported)
 }
 
+func TestTypedTxDecodeVerify(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		sk, _   = ethcrypto.HexToECDSA("b7255a24f68b8a24b7a0a4cf1e5e4eb0a5e9e1c25b1e56dbb3b3b1f3a0c1e8a9")
+		to      = common.HexToAddress("0xa0Ee7A142d267C1f36714E4a8F75612F20a79720")
+		chainID = big.NewInt(int64(_evmNetworkID))
+		list    = types.AccessList{
+			{Address: to, StorageKeys: []common.Hash{common.HexToHash("0x01")}},
+		}
+	)
+	for _, v := range []struct {
+		txdata   types.TxData
+		signer   types.Signer
+		encoding iotextypes.Encoding
+	}{
+		{
+			&types.AccessListTx{ChainID: chainID, Nonce: 1, GasPrice: big.NewInt(1000000000000), Gas: 30000, To: &to, Value: big.NewInt(100), AccessList: list},
+			types.NewEIP2930Signer(chainID),
+			iotextypes.Encoding_ETHEREUM_ACCESSLIST,
+		},
+		{
+			&types.DynamicFeeTx{ChainID: chainID, Nonce: 2, GasTipCap: big.NewInt(1000000000), GasFeeCap: big.NewInt(1000000000000), Gas: 30000, To: &to, Value: big.NewInt(100), Data: _signByte},
+			types.NewLondonSigner(chainID),
+			iotextypes.Encoding_ETHEREUM_DYNAMICFEE,
+		},
+		{
+			&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1000000000), GasFeeCap: big.NewInt(1000000000000), Gas: 30000, To: nil, Value: new(big.Int), Data: _signByte, AccessList: list},
+			types.NewLondonSigner(chainID),
+			iotextypes.Encoding_ETHEREUM_DYNAMICFEE,
+		},
+	} {
+		signedTx, err := types.SignNewTx(sk, v.signer, v.txdata)
+		require.NoError(err)
+		raw, err := signedTx.MarshalBinary()
+		require.NoError(err)
+
+		// decode received typed tx
+		tx, err := DecodeEtherTx(hex.EncodeToString(raw))
+		require.NoError(err)
+		encoding, sig, pubkey, err := ExtractTypeSigPubkey(tx)
+		require.NoError(err)
+		require.Equal(v.encoding, encoding)
+		require.True(27 == sig[64] || 28 == sig[64])
+		require.Equal(ethcrypto.PubkeyToAddress(sk.PublicKey).Bytes(), pubkey.Address().Bytes())
+
+		// convert to our Execution and send on wire
+		elp, err := (&EnvelopeBuilder{}).BuildExecution(tx)
+		require.NoError(err)
+		require.Equal(tx.GasTipCap(), elp.GasTipCap())
+		require.Equal(tx.GasFeeCap(), elp.GasFeeCap())
+		pb := &iotextypes.Action{
+			Core:         elp.Proto(),
+			SenderPubKey: pubkey.Bytes(),
+			Signature:    sig,
+			Encoding:     encoding,
+		}
+		bs, err := proto.Marshal(pb)
+		require.NoError(err)
+		require.NoError(proto.Unmarshal(bs, pb))
+		selp, err := (&Deserializer{}).SetEvmNetworkID(_evmNetworkID).ActionToSealedEnvelope(pb)
+		require.NoError(err)
+		require.EqualValues(encoding, selp.Encoding())
+		require.Equal(len(tx.AccessList()), len(selp.Action().(*Execution).AccessList()))
+
+		// hash and signature must match the original tx
+		h, err := selp.Hash()
+		require.NoError(err)
+		require.Equal(tx.Hash().Bytes(), h[:])
+		rawHash, err := selp.envelopeHash()
+		require.NoError(err)
+		require.Equal(v.signer.Hash(tx).Bytes(), rawHash[:])
+		require.NoError(selp.VerifySignature())
+		ethTx, err := selp.ToEthTx()
+		require.NoError(err)
+		require.Equal(tx.Type(), ethTx.Type())
+	}
+}
+
 func TestEthTxDecodeVerify(t *testing.T) {
 	require := require.New(t)
 
@@ -3,6 +3,7 @@ package action
 import (
 	"encoding/hex"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
@@ -30,12 +31,9 @@ type SealedEnvelope struct {
 // an all-0 return value means the transaction is invalid
 func (sealed *SealedEnvelope) envelopeHash() (hash.Hash256, error) {
 	switch sealed.encoding {
-	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_UNPROTECTED:
-		act, ok := sealed.Action().(EthCompatibleAction)
-		if !ok {
-			return hash.ZeroHash256, ErrInvalidAct
-		}
-		tx, err := act.ToEthTx(sealed.evmNetworkID)
+	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_UNPROTECTED,
+		iotextypes.Encoding_ETHEREUM_ACCESSLIST, iotextypes.Encoding_ETHEREUM_DYNAMICFEE:
+		tx, err := sealed.ToEthTx()
 		if err != nil {
 			return hash.ZeroHash256, err
 		}
@@ -66,12 +64,9 @@ func (sealed *SealedEnvelope) Hash() (hash.Hash256, error) {
 
 func (sealed *SealedEnvelope) calcHash() (hash.Hash256, error) {
 	switch sealed.encoding {
-	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_UNPROTECTED:
-		act, ok := sealed.Action().(EthCompatibleAction)
-		if !ok {
-			return hash.ZeroHash256, ErrInvalidAct
-		}
-		tx, err := act.ToEthTx(sealed.evmNetworkID)
+	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_UNPROTECTED,
+		iotextypes.Encoding_ETHEREUM_ACCESSLIST, iotextypes.Encoding_ETHEREUM_DYNAMICFEE:
+		tx, err := sealed.ToEthTx()
 		if err != nil {
 			return hash.ZeroHash256, err
 		}
@@ -87,6 +82,23 @@ func (sealed *SealedEnvelope) calcHash() (hash.Hash256, error) {
 	}
 }
 
+// ToEthTx converts the sealed envelope to the unsigned eth tx of its encoding type
+func (sealed *SealedEnvelope) ToEthTx() (*types.Transaction, error) {
+	return toEthTx(sealed.Envelope, sealed.encoding, sealed.evmNetworkID)
+}
+
+func toEthTx(elp Envelope, encoding iotextypes.Encoding, evmNetworkID uint32) (*types.Transaction, error) {
+	act, ok := elp.Action().(EthCompatibleAction)
+	if !ok {
+		return nil, ErrInvalidAct
+	}
+	tx, err := act.ToEthTx(evmNetworkID)
+	if err != nil {
+		return nil, err
+	}
+	return toTypedTx(tx, encoding, evmNetworkID, elp), nil
+}
+
 // SrcPubkey returns the source public key
 func (sealed *SealedEnvelope) SrcPubkey() crypto.PublicKey { return sealed.srcPubkey }
 
@@ -144,13 +156,10 @@ func (sealed *SealedEnvelope) loadProto(pbAct *iotextypes.Action, evmID uint32)
 	}
 	encoding := pbAct.GetEncoding()
 	switch encoding {
-	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_UNPROTECTED:
+	case iotextypes.Encoding_ETHEREUM_EIP155, iotextypes.Encoding_ETHEREUM_UNPROTECTED,
+		iotextypes.Encoding_ETHEREUM_ACCESSLIST, iotextypes.Encoding_ETHEREUM_DYNAMICFEE:
 		// verify action type can support RLP-encoding
-		act, ok := elp.Action().(EthCompatibleAction)
-		if !ok {
-			return ErrInvalidAct
-		}
-		tx, err := act.ToEthTx(evmID)
+		tx, err := toEthTx(elp, encoding, evmID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// SimulatedAction is an action run in a simulation, which is either a signed action or an unsigned call on behalf
+// of a caller
+type SimulatedAction struct {
+	caller address.Address
+	elp    Envelope
+	selp   *SealedEnvelope
+}
+
+// NewSimulatedCall creates an unsigned call on behalf of the caller, its nonce is set to the pending nonce of the
+// caller when it's run
+func NewSimulatedCall(caller address.Address, elp Envelope) *SimulatedAction {
+	return &SimulatedAction{
+		caller: caller,
+		elp:    elp,
+	}
+}
+
+// NewSimulatedSealedAction creates a signed action
+func NewSimulatedSealedAction(selp *SealedEnvelope) *SimulatedAction {
+	return &SimulatedAction{
+		caller: selp.SenderAddress(),
+		elp:    selp.Envelope,
+		selp:   selp,
+	}
+}
+
+// Caller returns the caller of the action
+func (act *SimulatedAction) Caller() address.Address {
+	return act.caller
+}
+
+// Envelope returns the envelope of the action
+func (act *SimulatedAction) Envelope() Envelope {
+	return act.elp
+}
+
+// SealedEnvelope returns the signed action, nil is returned for an unsigned call
+func (act *SimulatedAction) SealedEnvelope() *SealedEnvelope {
+	return act.selp
+}
+
+// Hash returns the hash of the action, the hash of an unsigned call is derived from its envelope
+func (act *SimulatedAction) Hash() (hash.Hash256, error) {
+	if act.selp != nil {
+		return act.selp.Hash()
+	}
+	return hash.Hash256b(byteutil.Must(proto.Marshal(act.elp.Proto()))), nil
+}
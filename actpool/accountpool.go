@@ -64,12 +64,16 @@ func (ap *accountPool) PutAction(
 ) error {
 	account, ok := ap.accounts[addr]
 	if !ok {
+		opts := []ActQueueOption{WithTimeOut(expiry)}
+		if actpool != nil {
+			opts = append(opts, WithPriceBump(actpool.cfg.PriceBump))
+		}
 		queue := NewActQueue(
 			actpool,
 			addr,
 			pendingNonce,
 			confirmedBalance,
-			WithTimeOut(expiry),
+			opts...,
 		)
 		if err := queue.Put(act); err != nil {
 			return err
@@ -100,6 +104,18 @@ func (ap *accountPool) PopPeek() *action.SealedEnvelope {
 	return act
 }
 
+// PopActionWithLargestNonce pops the action with largest nonce of the account
+func (ap *accountPool) PopActionWithLargestNonce(addr string) *action.SealedEnvelope {
+	account, ok := ap.accounts[addr]
+	if !ok {
+		return nil
+	}
+	act := account.actQueue.PopActionWithLargestNonce()
+	heap.Fix(&ap.priorityQueue, account.index)
+
+	return act
+}
+
 func (ap *accountPool) Range(callback func(addr string, acct ActQueue)) {
 	for addr, account := range ap.accounts {
 		callback(addr, account.actQueue)
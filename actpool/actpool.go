@@ -8,9 +8,11 @@ package actpool
 import (
 	"context"
 	"encoding/hex"
+	"math/big"
 	"sort"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -26,6 +28,7 @@ import (
 	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
 	"github.com/iotexproject/iotex-core/pkg/tracer"
@@ -51,6 +54,7 @@ func init() {
 
 // ActPool is the interface of actpool
 type ActPool interface {
+	lifecycle.StartStopper
 	action.SealedEnvelopeValidator
 	// Reset resets actpool state
 	Reset()
@@ -64,6 +68,8 @@ type ActPool interface {
 	GetUnconfirmedActs(addr string) []*action.SealedEnvelope
 	// GetActionByHash returns the pending action in pool given action's hash
 	GetActionByHash(hash hash.Hash256) (*action.SealedEnvelope, error)
+	// ActionsBySender returns the pending and queued actions in pool, keyed by sender
+	ActionsBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope)
 	// GetSize returns the act pool size
 	GetSize() uint64
 	// GetCapacity returns the act pool capacity
@@ -101,8 +107,25 @@ type actPool struct {
 	actionEnvelopeValidators []action.SealedEnvelopeValidator
 	timerFactory             *prometheustimer.TimerFactory
 	senderBlackList          map[string]bool
+	localAccounts            map[string]bool
 	jobQueue                 []chan workerJob
 	worker                   []*queueWorker
+	baseFee                  atomic.Pointer[big.Int] // base fee of the next block, nil before Vanuatu
+	journal                  *actJournal
+	journalDone              chan struct{}
+	journalWG                sync.WaitGroup
+}
+
+// EnableJournal enables the on-disk journal of actpool, which is replayed on start
+func EnableJournal(cfg JournalConfig, evmNetworkID uint32) Option {
+	return func(ap *actPool) error {
+		if cfg.Path == "" {
+			return errors.New("actpool journal path is empty")
+		}
+		ap.cfg.Journal = cfg
+		ap.journal = newActJournal(cfg.Path, evmNetworkID)
+		return nil
+	}
 }
 
 // NewActPool constructs a new actpool
@@ -116,12 +139,18 @@ func NewActPool(g genesis.Genesis, sf protocol.StateReader, cfg Config, opts ...
 		senderBlackList[bannedSender] = true
 	}
 
+	localAccounts := make(map[string]bool)
+	for _, local := range cfg.Locals {
+		localAccounts[local] = true
+	}
+
 	actsMap, _ := ttl.NewCache()
 	ap := &actPool{
 		cfg:             cfg,
 		g:               g,
 		sf:              sf,
 		senderBlackList: senderBlackList,
+		localAccounts:   localAccounts,
 		accountDesActs:  &destinationMap{acts: make(map[string]map[hash.Hash256]*action.SealedEnvelope)},
 		allActions:      actsMap,
 		jobQueue:        make([]chan workerJob, _numWorker),
@@ -153,6 +182,72 @@ func NewActPool(g genesis.Genesis, sf protocol.StateReader, cfg Config, opts ...
 	return ap, nil
 }
 
+// Start replays the journal if it is enabled, and starts compacting it periodically
+func (ap *actPool) Start(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	// the actions which would have expired in the pool are not replayed
+	acts, err := ap.journal.load(ap.cfg.ActionExpiry)
+	if err != nil {
+		return err
+	}
+	if err := ap.journal.open(); err != nil {
+		return err
+	}
+	// actions of the same sender have to be added in the order of nonce
+	sort.Stable(SortedActions(acts))
+	var replayed int
+	for _, act := range acts {
+		// actions become stale if they are committed or the sender's balance is not enough any more
+		if err := ap.Add(ctx, act); err != nil {
+			actHash, _ := act.Hash()
+			log.L().Debug("Dropped action from actpool journal.", log.Hex("hash", actHash[:]), zap.Error(err))
+			continue
+		}
+		replayed++
+	}
+	log.L().Info("Replayed actpool journal.", zap.Int("loaded", len(acts)), zap.Int("replayed", replayed))
+	if err := ap.compactJournal(); err != nil {
+		return err
+	}
+
+	ap.journalDone = make(chan struct{})
+	if ap.cfg.Journal.CompactInterval > 0 {
+		ap.journalWG.Add(1)
+		go func() {
+			defer ap.journalWG.Done()
+			ticker := time.NewTicker(ap.cfg.Journal.CompactInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ap.journalDone:
+					return
+				case <-ticker.C:
+					if err := ap.compactJournal(); err != nil {
+						log.L().Error("Failed to compact actpool journal.", zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Stop compacts and closes the journal if it is enabled
+func (ap *actPool) Stop(ctx context.Context) error {
+	if ap.journal == nil || ap.journalDone == nil {
+		return nil
+	}
+	close(ap.journalDone)
+	ap.journalWG.Wait()
+	ap.journalDone = nil
+	if err := ap.compactJournal(); err != nil {
+		log.L().Error("Failed to compact actpool journal.", zap.Error(err))
+	}
+	return ap.journal.close()
+}
+
 func (ap *actPool) AddActionEnvelopeValidators(fs ...action.SealedEnvelopeValidator) {
 	ap.actionEnvelopeValidators = append(ap.actionEnvelopeValidators, fs...)
 }
@@ -184,7 +279,12 @@ func (ap *actPool) reset() {
 	wg.Wait()
 }
 
-func (ap *actPool) ReceiveBlock(*block.Block) error {
+func (ap *actPool) ReceiveBlock(blk *block.Block) error {
+	ap.baseFee.Store(protocol.CalcBaseFee(ap.g.Blockchain, &protocol.TipInfo{
+		Height:  blk.Height(),
+		GasUsed: blk.GasUsed(),
+		BaseFee: blk.BaseFee(),
+	}))
 	ap.reset()
 	return nil
 }
@@ -291,6 +391,16 @@ func (ap *actPool) checkSelpWithoutState(ctx context.Context, selp *action.Seale
 			zap.String("gasPrice", selp.GasPrice().String()))
 		return action.ErrUnderpriced
 	}
+	// Reject action if the gas fee cap cannot cover the base fee of next block
+	if baseFee := ap.baseFee.Load(); baseFee != nil && selp.GasFeeCap().Cmp(baseFee) < 0 {
+		_actpoolMtc.WithLabelValues("gasFeeCapLowerThanBaseFee").Inc()
+		actHash, _ := selp.Hash()
+		log.L().Debug("action rejected due to gas fee cap lower than base fee",
+			zap.String("actionHash", hex.EncodeToString(actHash[:])),
+			zap.String("gasFeeCap", selp.GasFeeCap().String()),
+			zap.String("baseFee", baseFee.String()))
+		return action.ErrUnderpriced
+	}
 
 	if _, ok := ap.senderBlackList[selp.SenderAddress().String()]; ok {
 		_actpoolMtc.WithLabelValues("blacklisted").Inc()
@@ -350,6 +460,35 @@ func (ap *actPool) GetActionByHash(hash hash.Hash256) (*action.SealedEnvelope, e
 	return act.(*action.SealedEnvelope), nil
 }
 
+// ActionsBySender returns the pending and queued actions in pool, keyed by sender
+func (ap *actPool) ActionsBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope) {
+	var (
+		wg                sync.WaitGroup
+		pendingFromWorker = make([]map[string][]*action.SealedEnvelope, _numWorker)
+		queuedFromWorker  = make([]map[string][]*action.SealedEnvelope, _numWorker)
+	)
+	for i := range ap.worker {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pendingFromWorker[i], queuedFromWorker[i] = ap.worker[i].ActionsBySender()
+		}(i)
+	}
+	wg.Wait()
+
+	pending := make(map[string][]*action.SealedEnvelope)
+	queued := make(map[string][]*action.SealedEnvelope)
+	for i := range ap.worker {
+		for sender, acts := range pendingFromWorker[i] {
+			pending[sender] = acts
+		}
+		for sender, acts := range queuedFromWorker[i] {
+			queued[sender] = acts
+		}
+	}
+	return pending, queued
+}
+
 // GetSize returns the act pool size
 func (ap *actPool) GetSize() uint64 {
 	return uint64(ap.allActions.Count())
@@ -421,17 +560,72 @@ func (ap *actPool) removeInvalidActs(acts []*action.SealedEnvelope) {
 		}
 		log.L().Debug("Removed invalidated action.", log.Hex("hash", hash[:]))
 		ap.allActions.Delete(hash)
+		ap.journalRemove(hash)
 		intrinsicGas, _ := act.IntrinsicGas()
 		atomic.AddUint64(&ap.gasInPool, ^uint64(intrinsicGas-1))
 		ap.accountDesActs.delete(act)
 	}
 }
 
+// evictLowestPriced removes the action of lowest gas price from non-local senders, which is always the one of largest
+// nonce from its sender so that no nonce gap is left. Nothing is evicted if all actions in pool are local.
+func (ap *actPool) evictLowestPriced() *action.SealedEnvelope {
+	var (
+		candidate *action.SealedEnvelope
+		owner     *queueWorker
+	)
+	for _, worker := range ap.worker {
+		if lowest := worker.lowestPricedAction(); lowest != nil && (candidate == nil || lowest.GasPrice().Cmp(candidate.GasPrice()) < 0) {
+			candidate, owner = lowest, worker
+		}
+	}
+	if candidate == nil {
+		return nil
+	}
+	if !owner.popAction(candidate) {
+		// the queue of candidate has changed in the meantime
+		return nil
+	}
+	ap.removeInvalidActs([]*action.SealedEnvelope{candidate})
+	return candidate
+}
+
+func (ap *actPool) journalInsert(act *action.SealedEnvelope) {
+	if ap.journal == nil {
+		return
+	}
+	if err := ap.journal.insert(act); err != nil && err != errJournalClosed {
+		log.L().Warn("Failed to write action into actpool journal.", zap.Error(err))
+	}
+}
+
+func (ap *actPool) journalRemove(hash hash.Hash256) {
+	if ap.journal == nil {
+		return
+	}
+	if err := ap.journal.remove(hash); err != nil && err != errJournalClosed {
+		log.L().Warn("Failed to remove action from actpool journal.", zap.Error(err))
+	}
+}
+
+// compactJournal rewrites the journal with the actions in pool
+func (ap *actPool) compactJournal() error {
+	return ap.journal.rotate(func() []*action.SealedEnvelope {
+		acts := make([]*action.SealedEnvelope, 0, ap.allActions.Count())
+		ap.allActions.Range(func(_, value interface{}) error {
+			acts = append(acts, value.(*action.SealedEnvelope))
+			return nil
+		})
+		return acts
+	})
+}
+
 func (ap *actPool) context(ctx context.Context) context.Context {
 	height, _ := ap.sf.Height()
 	return protocol.WithFeatureCtx(protocol.WithBlockCtx(
 		genesis.WithGenesisContext(ctx, ap.g), protocol.BlockCtx{
 			BlockHeight: height + 1,
+			BaseFee:     ap.baseFee.Load(),
 		}))
 }
 
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -208,10 +209,10 @@ func TestActPool_AddActs(t *testing.T) {
 		ap2.allActions.Set(nTsfHash, nTsf)
 	}
 	require.Equal(uint64(ap2.allActions.Count()), apConfig.MaxNumActsPerPool)
-	// Tx Pool is full, but replacement happens
-	require.Error(action.ErrTxPoolOverflow, ap2.Add(ctx, tsf1))
+	// Tx Pool is full, and the new action of lowest gas price is evicted
+	require.Equal(action.ErrTxPoolOverflow, errors.Cause(ap2.Add(ctx, tsf1)))
 	require.Equal(uint64(ap2.allActions.Count()), apConfig.MaxNumActsPerPool)
-	require.NoError(ap2.Add(ctx, tsf4))
+	require.Equal(action.ErrTxPoolOverflow, errors.Cause(ap2.Add(ctx, tsf4)))
 	require.Equal(uint64(ap2.allActions.Count()), apConfig.MaxNumActsPerPool)
 
 	Ap3, err := NewActPool(genesis.Default, sf, apConfig)
@@ -970,6 +971,128 @@ func TestActPool_GetActionByHash(t *testing.T) {
 	require.Equal(tsf2, act)
 }
 
+func TestActPool_ActionsBySender(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	require := require.New(t)
+
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		require.NoError(acct.AddBalance(big.NewInt(100)))
+		return 0, nil
+	}).AnyTimes()
+	sf.EXPECT().Height().Return(uint64(1), nil).AnyTimes()
+	Ap, err := NewActPool(genesis.Default, sf, getActPoolCfg())
+	require.NoError(err)
+	pending, queued := Ap.ActionsBySender()
+	require.Empty(pending)
+	require.Empty(queued)
+
+	ctx := genesis.WithGenesisContext(context.Background(), genesis.Default)
+	var acts []*action.SealedEnvelope
+	for _, v := range []struct {
+		addr   string
+		priKey crypto.PrivateKey
+		nonce  uint64
+	}{
+		{_addr1, _priKey1, 4},
+		{_addr1, _priKey1, 2},
+		{_addr1, _priKey1, 1},
+		{_addr2, _priKey2, 2},
+	} {
+		tsf, err := action.SignedTransfer(_addr3, v.priKey, v.nonce, big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+		require.NoError(err)
+		require.NoError(Ap.Add(ctx, tsf))
+		acts = append(acts, tsf)
+	}
+	pending, queued = Ap.ActionsBySender()
+	require.Equal(map[string][]*action.SealedEnvelope{
+		_addr1: {acts[2], acts[1]},
+	}, pending)
+	require.Equal(map[string][]*action.SealedEnvelope{
+		_addr1: {acts[0]},
+		_addr2: {acts[3]},
+	}, queued)
+}
+
+func TestActPool_ReplaceAndEvict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	require := require.New(t)
+
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		require.NoError(acct.AddBalance(big.NewInt(maxBalance)))
+		return 0, nil
+	}).AnyTimes()
+	sf.EXPECT().Height().Return(uint64(1), nil).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 3
+	apConfig.PriceBump = 10
+	apConfig.Locals = []string{_addr3}
+	Ap, err := NewActPool(genesis.Default, sf, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+
+	ctx := genesis.WithGenesisContext(context.Background(), genesis.Default)
+	newTsf := func(priKey crypto.PrivateKey, gasPrice int64) *action.SealedEnvelope {
+		tsf, err := action.SignedTransfer(_addr6, priKey, 1, big.NewInt(10), nil, uint64(100000), big.NewInt(gasPrice))
+		require.NoError(err)
+		return tsf
+	}
+	requireInPool := func(act *action.SealedEnvelope, inPool bool) {
+		h, err := act.Hash()
+		require.NoError(err)
+		_, err = ap.GetActionByHash(h)
+		if inPool {
+			require.NoError(err)
+		} else {
+			require.Equal(action.ErrNotFound, errors.Cause(err))
+		}
+	}
+
+	// replace by fee
+	tsf1 := newTsf(_priKey1, 10)
+	require.NoError(ap.Add(ctx, tsf1))
+	gasSize := ap.GetGasSize()
+	tsf1SamePrice, err := action.SignedTransfer(_addr6, _priKey1, 1, big.NewInt(20), nil, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(action.ErrReplaceUnderpriced, errors.Cause(ap.Add(ctx, tsf1SamePrice)))
+	tsf1Replace := newTsf(_priKey1, 11)
+	require.NoError(ap.Add(ctx, tsf1Replace))
+	require.Equal(uint64(1), ap.GetSize())
+	require.Equal(gasSize, ap.GetGasSize())
+	requireInPool(tsf1, false)
+	requireInPool(tsf1Replace, true)
+
+	// fill up the pool
+	tsf2 := newTsf(_priKey2, 5)
+	tsf3 := newTsf(_priKey3, 1)
+	require.NoError(ap.Add(ctx, tsf2))
+	require.NoError(ap.Add(ctx, tsf3))
+	require.Equal(uint64(3), ap.GetSize())
+
+	// the action of lowest gas price is evicted, except for the local one
+	tsf4 := newTsf(_priKey4, 20)
+	require.NoError(ap.Add(ctx, tsf4))
+	require.Equal(uint64(3), ap.GetSize())
+	requireInPool(tsf2, false)
+	requireInPool(tsf3, true)
+	requireInPool(tsf4, true)
+
+	// the new action is rejected if it has the lowest gas price
+	tsf5 := newTsf(_priKey5, 2)
+	require.Equal(action.ErrTxPoolOverflow, errors.Cause(ap.Add(ctx, tsf5)))
+	require.Equal(uint64(3), ap.GetSize())
+	requireInPool(tsf5, false)
+	pNonce, err := ap.GetPendingNonce(_addr5)
+	require.NoError(err)
+	require.Equal(uint64(1), pNonce)
+}
+
 func TestActPool_GetCapacity(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	require := require.New(t)
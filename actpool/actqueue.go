@@ -36,6 +36,8 @@ type ActQueue interface {
 	PendingActs(context.Context) []*action.SealedEnvelope
 	AllActs() []*action.SealedEnvelope
 	PopActionWithLargestNonce() *action.SealedEnvelope
+	PeekActionWithLargestNonce() *action.SealedEnvelope
+	ActionByNonce(uint64) (*action.SealedEnvelope, bool)
 	Reset()
 }
 
@@ -58,7 +60,9 @@ type actQueue struct {
 	accountBalance *big.Int
 	clock          clock.Clock
 	ttl            time.Duration
-	mu             sync.RWMutex
+	// Minimum percentage of gas price bump to replace an action of the same nonce
+	priceBump uint64
+	mu        sync.RWMutex
 }
 
 // NewActQueue create a new action queue
@@ -102,8 +106,8 @@ func (q *actQueue) Put(act *action.SealedEnvelope) error {
 	}
 
 	if actInPool, exist := q.items[nonce]; exist {
-		// act of higher gas price can cut in line
-		if nonce < q.pendingNonce && act.GasPrice().Cmp(actInPool.GasPrice()) != 1 {
+		// act of sufficiently higher gas price can cut in line
+		if !q.canReplace(actInPool, act) {
 			return action.ErrReplaceUnderpriced
 		}
 		// update action in q.items and q.index
@@ -127,6 +131,25 @@ func (q *actQueue) Put(act *action.SealedEnvelope) error {
 	return nil
 }
 
+// canReplace returns true if both gas fee cap and gas tip cap of act are higher than those of old by at least
+// priceBump percent
+func (q *actQueue) canReplace(old, act *action.SealedEnvelope) bool {
+	for _, prices := range [][2]*big.Int{
+		{old.GasFeeCap(), act.GasFeeCap()},
+		{old.GasTipCap(), act.GasTipCap()},
+	} {
+		if prices[1].Cmp(prices[0]) <= 0 {
+			return false
+		}
+		threshold := new(big.Int).Mul(prices[0], new(big.Int).SetUint64(100+q.priceBump))
+		threshold.Div(threshold, big.NewInt(100))
+		if prices[1].Cmp(threshold) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
 func (q *actQueue) getPendingBalanceAtNonce(nonce uint64) *big.Int {
 	if nonce > q.pendingNonce {
 		return q.getPendingBalanceAtNonce(q.pendingNonce)
@@ -340,3 +363,21 @@ func (q *actQueue) PopActionWithLargestNonce() *action.SealedEnvelope {
 
 	return item
 }
+
+// PeekActionWithLargestNonce returns the action with largest nonce without removing it
+func (q *actQueue) PeekActionWithLargestNonce() *action.SealedEnvelope {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if len(q.descQueue) == 0 {
+		return nil
+	}
+	return q.items[q.descQueue[0].nonce]
+}
+
+// ActionByNonce returns the action of the given nonce in queue
+func (q *actQueue) ActionByNonce(nonce uint64) (*action.SealedEnvelope, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	act, ok := q.items[nonce]
+	return act, ok
+}
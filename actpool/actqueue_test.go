@@ -84,6 +84,47 @@ func TestActQueuePut(t *testing.T) {
 	require.NoError(q.Put(tsf4))
 }
 
+func TestActQueueReplace(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "", 1, big.NewInt(maxBalance), WithPriceBump(10)).(*actQueue)
+	newTsf := func(nonce uint64, gasPrice int64) *action.SealedEnvelope {
+		tsf, err := action.SignedTransfer(_addr2, _priKey1, nonce, big.NewInt(100), nil, uint64(0), big.NewInt(gasPrice))
+		require.NoError(err)
+		return tsf
+	}
+	newDynamicFeeTsf := func(nonce uint64, tipCap, feeCap int64) *action.SealedEnvelope {
+		tsf, err := action.NewTransfer(nonce, big.NewInt(100), _addr2, nil, uint64(0), nil)
+		require.NoError(err)
+		elp := (&action.EnvelopeBuilder{}).SetNonce(nonce).SetGasTipCap(big.NewInt(tipCap)).
+			SetGasFeeCap(big.NewInt(feeCap)).SetAction(tsf).Build()
+		selp, err := action.Sign(elp, _priKey1)
+		require.NoError(err)
+		return selp
+	}
+	// pending and queued actions share the same replacement rule
+	for _, nonce := range []uint64{1, 3} {
+		require.NoError(q.Put(newTsf(nonce, 100)))
+		require.Equal(action.ErrReplaceUnderpriced, q.Put(newTsf(nonce, 100)))
+		require.Equal(action.ErrReplaceUnderpriced, q.Put(newTsf(nonce, 109)))
+		tsf := newTsf(nonce, 110)
+		require.NoError(q.Put(tsf))
+		act, ok := q.ActionByNonce(nonce)
+		require.True(ok)
+		require.Equal(tsf, act)
+	}
+	require.Equal(uint64(2), q.PendingNonce())
+	require.Equal(uint64(3), q.PeekActionWithLargestNonce().Nonce())
+
+	// both gas tip cap and gas fee cap have to be bumped
+	require.NoError(q.Put(newDynamicFeeTsf(2, 100, 200)))
+	require.Equal(action.ErrReplaceUnderpriced, q.Put(newDynamicFeeTsf(2, 100, 300)))
+	require.Equal(action.ErrReplaceUnderpriced, q.Put(newDynamicFeeTsf(2, 200, 200)))
+	require.NoError(q.Put(newDynamicFeeTsf(2, 110, 220)))
+	require.Equal(uint64(4), q.PendingNonce())
+	_, ok := q.ActionByNonce(4)
+	require.False(ok)
+}
+
 func TestActQueueFilterNonce(t *testing.T) {
 	require := require.New(t)
 	q := NewActQueue(nil, "", 1, big.NewInt(maxBalance)).(*actQueue)
@@ -18,6 +18,11 @@ var (
 		ActionExpiry:       10 * time.Minute,
 		MinGasPriceStr:     big.NewInt(unit.Qev).String(),
 		BlackList:          []string{},
+		PriceBump:          10,
+		Locals:             []string{},
+		Journal: JournalConfig{
+			CompactInterval: 10 * time.Minute,
+		},
 	}
 )
 
@@ -37,6 +42,20 @@ type Config struct {
 	MinGasPriceStr string `yaml:"minGasPrice"`
 	// BlackList lists the account address that are banned from initiating actions
 	BlackList []string `yaml:"blackList"`
+	// PriceBump is the minimum percentage of gas price bump for an action to replace the one of the same nonce
+	PriceBump uint64 `yaml:"priceBump"`
+	// Locals lists the account addresses whose actions are not evicted for lower gas price when the pool is full
+	Locals []string `yaml:"locals"`
+	// Journal is the config of the on-disk journal of actions in pool
+	Journal JournalConfig `yaml:"journal"`
+}
+
+// JournalConfig is the config of actpool journal
+type JournalConfig struct {
+	// Path is the file path of the journal, journal is disabled if it is empty
+	Path string `yaml:"path"`
+	// CompactInterval defines how often the journal is rewritten with the actions in pool
+	CompactInterval time.Duration `yaml:"compactInterval"`
 }
 
 // MinGasPrice returns the minimal gas price threshold
@@ -0,0 +1,334 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	_journalInsert byte = iota + 1
+	_journalRemove
+
+	// _maxJournalRecordSize caps the payload size of a record, to guard against a corrupted length
+	_maxJournalRecordSize = 1 << 24
+)
+
+var errJournalClosed = errors.New("actpool journal is closed")
+
+// actJournal is an append-only file which records the actions accepted into and removed from actpool,
+// so that the pending actions survive node restarts
+//
+// each record is encoded as: type (1 byte) | payload length (4 bytes, big endian) | payload
+// the payload of an insert record is the insert time (8 bytes, unix nano, big endian) followed by the serialized
+// action, and that of a remove record is the action hash
+type actJournal struct {
+	mu           sync.Mutex
+	path         string
+	file         *os.File
+	deserializer *action.Deserializer
+	// inserted is the insert time of the actions in journal, which is kept when the journal is rotated
+	inserted map[hash.Hash256]time.Time
+	now      func() time.Time
+}
+
+func newActJournal(path string, evmNetworkID uint32) *actJournal {
+	return &actJournal{
+		path:         path,
+		deserializer: (&action.Deserializer{}).SetEvmNetworkID(evmNetworkID),
+		inserted:     make(map[hash.Hash256]time.Time),
+		now:          time.Now,
+	}
+}
+
+// load returns the actions which are inserted but not removed, in the order of insertion, the actions inserted
+// longer than expiry ago are skipped, 0 means never expire
+func (j *actJournal) load(expiry time.Duration) ([]*action.SealedEnvelope, error) {
+	f, err := os.Open(j.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to open actpool journal")
+	}
+	defer f.Close()
+
+	var (
+		r     = bufio.NewReader(f)
+		acts  []*action.SealedEnvelope
+		times []time.Time
+		index = make(map[hash.Hash256]int)
+	)
+	for {
+		typ, payload, err := readJournalRecord(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// the tail of journal is broken if the node crashed when writing it
+			log.L().Warn("Stop loading actpool journal at a broken record.", zap.Error(err))
+			break
+		}
+		switch typ {
+		case _journalInsert:
+			if len(payload) < 8 {
+				log.L().Warn("Invalid insert record in actpool journal.", zap.Int("size", len(payload)))
+				continue
+			}
+			pb := &iotextypes.Action{}
+			if err := proto.Unmarshal(payload[8:], pb); err != nil {
+				log.L().Warn("Failed to unmarshal action in actpool journal.", zap.Error(err))
+				continue
+			}
+			selp, err := j.deserializer.ActionToSealedEnvelope(pb)
+			if err != nil {
+				log.L().Warn("Failed to deserialize action in actpool journal.", zap.Error(err))
+				continue
+			}
+			h, err := selp.Hash()
+			if err != nil {
+				continue
+			}
+			if _, ok := index[h]; ok {
+				continue
+			}
+			index[h] = len(acts)
+			acts = append(acts, selp)
+			times = append(times, time.Unix(0, int64(binary.BigEndian.Uint64(payload[:8]))))
+		case _journalRemove:
+			h := hash.BytesToHash256(payload)
+			if i, ok := index[h]; ok {
+				acts[i] = nil
+				delete(index, h)
+			}
+		default:
+			log.L().Warn("Unknown record type in actpool journal.", zap.Uint8("type", typ))
+		}
+	}
+
+	var (
+		ret      = make([]*action.SealedEnvelope, 0, len(index))
+		inserted = make(map[hash.Hash256]time.Time, len(index))
+		now      = j.now()
+		expired  int
+	)
+	for i, act := range acts {
+		if act == nil {
+			continue
+		}
+		if expiry > 0 && now.Sub(times[i]) > expiry {
+			expired++
+			continue
+		}
+		h, _ := act.Hash()
+		inserted[h] = times[i]
+		ret = append(ret, act)
+	}
+	if expired > 0 {
+		log.L().Info("Skipped expired actions in actpool journal.", zap.Int("expired", expired))
+	}
+	j.mu.Lock()
+	j.inserted = inserted
+	j.mu.Unlock()
+	return ret, nil
+}
+
+// open opens the journal file for appending records
+func (j *actJournal) open() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.file != nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(j.path), 0755); err != nil {
+		return errors.Wrap(err, "failed to create actpool journal directory")
+	}
+	f, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open actpool journal")
+	}
+	j.file = f
+	return nil
+}
+
+// insert appends an insert record of the action, the action in journal keeps the time it was first inserted, so
+// that replaying it does not extend its expiry
+func (j *actJournal) insert(act *action.SealedEnvelope) error {
+	h, err := act.Hash()
+	if err != nil {
+		return err
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.file == nil {
+		return errJournalClosed
+	}
+	ts, ok := j.inserted[h]
+	if !ok {
+		ts = j.now()
+	}
+	payload, err := encodeJournalInsert(act, ts)
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(encodeJournalRecord(_journalInsert, payload)); err != nil {
+		return err
+	}
+	j.inserted[h] = ts
+	return nil
+}
+
+// remove appends a remove record of the action hash
+func (j *actJournal) remove(h hash.Hash256) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if err := j.write(_journalRemove, h[:]); err != nil {
+		return err
+	}
+	delete(j.inserted, h)
+	return nil
+}
+
+func (j *actJournal) write(typ byte, payload []byte) error {
+	if j.file == nil {
+		return errJournalClosed
+	}
+	_, err := j.file.Write(encodeJournalRecord(typ, payload))
+	return err
+}
+
+// rotate rewrites the journal with the actions returned by snapshot
+//
+// snapshot is called with the journal locked, so the records written afterwards always apply on top of it
+func (j *actJournal) rotate(snapshot func() []*action.SealedEnvelope) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.file == nil {
+		return errJournalClosed
+	}
+	tmpPath := j.path + ".new"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to create new actpool journal")
+	}
+	var (
+		w        = bufio.NewWriter(tmp)
+		acts     = snapshot()
+		inserted = make(map[hash.Hash256]time.Time, len(acts))
+	)
+	for _, act := range acts {
+		h, err := act.Hash()
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		ts, ok := j.inserted[h]
+		if !ok {
+			ts = j.now()
+		}
+		data, err := encodeJournalInsert(act, ts)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		if _, err := w.Write(encodeJournalRecord(_journalInsert, data)); err != nil {
+			tmp.Close()
+			return err
+		}
+		inserted[h] = ts
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return errors.Wrap(err, "failed to replace actpool journal")
+	}
+	j.inserted = inserted
+	if err := j.file.Close(); err != nil {
+		log.L().Warn("Failed to close old actpool journal.", zap.Error(err))
+	}
+	j.file, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		j.file = nil
+		return errors.Wrap(err, "failed to reopen actpool journal")
+	}
+	return nil
+}
+
+func (j *actJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.file == nil {
+		return nil
+	}
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+func encodeJournalInsert(act *action.SealedEnvelope, ts time.Time) ([]byte, error) {
+	data, err := proto.Marshal(act.Proto())
+	if err != nil {
+		return nil, err
+	}
+	payload := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint64(payload[:8], uint64(ts.UnixNano()))
+	copy(payload[8:], data)
+	return payload, nil
+}
+
+func encodeJournalRecord(typ byte, payload []byte) []byte {
+	buf := make([]byte, 5+len(payload))
+	buf[0] = typ
+	binary.BigEndian.PutUint32(buf[1:5], uint32(len(payload)))
+	copy(buf[5:], payload)
+	return buf
+}
+
+func readJournalRecord(r io.Reader) (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return 0, nil, io.EOF
+		}
+		return 0, nil, errors.Wrap(err, "failed to read record header")
+	}
+	size := binary.BigEndian.Uint32(header[1:5])
+	if size > _maxJournalRecordSize {
+		return 0, nil, errors.Errorf("record size %d exceeds limit", size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, errors.Wrap(err, "failed to read record payload")
+	}
+	return header[0], payload, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+)
+
+func TestActJournal(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(t.TempDir(), "actpool.journal")
+	j := newActJournal(path, 0)
+
+	// load an absent journal
+	acts, err := j.load(0)
+	require.NoError(err)
+	require.Empty(acts)
+	require.Equal(errJournalClosed, j.write(_journalRemove, nil))
+
+	var tsfs []*action.SealedEnvelope
+	for i := uint64(1); i <= 3; i++ {
+		tsf, err := action.SignedTransfer(_addr1, _priKey1, i, big.NewInt(10), nil, 100000, big.NewInt(0))
+		require.NoError(err)
+		tsfs = append(tsfs, tsf)
+	}
+	require.NoError(j.open())
+	for _, tsf := range tsfs {
+		require.NoError(j.insert(tsf))
+	}
+	h, err := tsfs[1].Hash()
+	require.NoError(err)
+	require.NoError(j.remove(h))
+	// duplicated insert is ignored
+	require.NoError(j.insert(tsfs[0]))
+	require.NoError(j.close())
+
+	checkActs := func(expected []*action.SealedEnvelope) {
+		acts, err := j.load(0)
+		require.NoError(err)
+		require.Len(acts, len(expected))
+		for i := range expected {
+			h1, _ := expected[i].Hash()
+			h2, _ := acts[i].Hash()
+			require.Equal(h1, h2)
+		}
+	}
+	checkActs([]*action.SealedEnvelope{tsfs[0], tsfs[2]})
+
+	// a broken tail is skipped
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(err)
+	_, err = f.Write(encodeJournalRecord(_journalInsert, []byte("broken"))[:8])
+	require.NoError(err)
+	require.NoError(f.Close())
+	checkActs([]*action.SealedEnvelope{tsfs[0], tsfs[2]})
+
+	// rotate rewrites the journal with the snapshot
+	require.NoError(j.open())
+	require.NoError(j.rotate(func() []*action.SealedEnvelope {
+		return tsfs[1:2]
+	}))
+	require.NoError(j.insert(tsfs[2]))
+	require.NoError(j.close())
+	checkActs(tsfs[1:])
+	require.Equal(errJournalClosed, j.rotate(nil))
+}
+
+func TestActJournalExpiry(t *testing.T) {
+	require := require.New(t)
+	j := newActJournal(filepath.Join(t.TempDir(), "actpool.journal"), 0)
+	now := time.Unix(1700000000, 0)
+	j.now = func() time.Time { return now }
+
+	var tsfs []*action.SealedEnvelope
+	for i := uint64(1); i <= 2; i++ {
+		tsf, err := action.SignedTransfer(_addr1, _priKey1, i, big.NewInt(10), nil, 100000, big.NewInt(0))
+		require.NoError(err)
+		tsfs = append(tsfs, tsf)
+	}
+	require.NoError(j.open())
+	require.NoError(j.insert(tsfs[0]))
+	now = now.Add(5 * time.Minute)
+	require.NoError(j.insert(tsfs[1]))
+	// the insert time is kept by a duplicated insert and rotation
+	now = now.Add(time.Minute)
+	require.NoError(j.insert(tsfs[0]))
+	require.NoError(j.rotate(func() []*action.SealedEnvelope {
+		return tsfs
+	}))
+	require.NoError(j.close())
+
+	load := func(expiry time.Duration) int {
+		acts, err := j.load(expiry)
+		require.NoError(err)
+		return len(acts)
+	}
+	require.Equal(2, load(0))
+	require.Equal(2, load(10*time.Minute))
+	// the first action was inserted 6 minutes ago, and the second 1 minute ago
+	require.Equal(1, load(3*time.Minute))
+	require.Equal(0, load(30*time.Second))
+}
+
+func TestActPool_Journal(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	// committed indicates whether the action of nonce 1 from _addr1 is committed
+	committed := false
+	sf := mock_chainmanager.NewMockStateReader(ctrl)
+	sf.EXPECT().State(gomock.Any(), gomock.Any()).DoAndReturn(func(account interface{}, opts ...protocol.StateOption) (uint64, error) {
+		acct, ok := account.(*state.Account)
+		require.True(ok)
+		cfg := &protocol.StateConfig{}
+		for _, opt := range opts {
+			opt(cfg)
+		}
+		require.NoError(acct.AddBalance(big.NewInt(100)))
+		if committed && bytes.Equal(cfg.Key, identityset.Address(28).Bytes()) {
+			require.NoError(acct.SetPendingNonce(2))
+		}
+		return 0, nil
+	}).AnyTimes()
+	sf.EXPECT().Height().Return(uint64(1), nil).AnyTimes()
+
+	cfg := getActPoolCfg()
+	cfg.Journal.Path = filepath.Join(t.TempDir(), "actpool.journal")
+	newPool := func() *actPool {
+		ap, err := NewActPool(genesis.Default, sf, cfg, EnableJournal(cfg.Journal, 0))
+		require.NoError(err)
+		ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(sf, accountutil.AccountState))
+		return ap.(*actPool)
+	}
+	ctx := genesis.WithGenesisContext(context.Background(), genesis.Default)
+
+	ap := newPool()
+	require.NoError(ap.Start(ctx))
+	var hashes [][32]byte
+	for _, tsf := range []struct {
+		addr  string
+		nonce uint64
+	}{
+		{_addr1, 1}, {_addr1, 2}, {_addr1, 3}, {_addr2, 1},
+	} {
+		priKey := _priKey1
+		if tsf.addr == _addr2 {
+			priKey = _priKey2
+		}
+		selp, err := action.SignedTransfer(tsf.addr, priKey, tsf.nonce, big.NewInt(10), nil, 100000, big.NewInt(0))
+		require.NoError(err)
+		require.NoError(ap.Add(ctx, selp))
+		h, err := selp.Hash()
+		require.NoError(err)
+		hashes = append(hashes, h)
+	}
+	// removed action is not replayed
+	ap.DeleteAction(identityset.Address(29))
+	require.Equal(uint64(3), ap.GetSize())
+	require.NoError(ap.Stop(ctx))
+
+	// the first action of _addr1 is committed when the node is down
+	committed = true
+	ap = newPool()
+	require.NoError(ap.Start(ctx))
+	defer func() {
+		require.NoError(ap.Stop(ctx))
+	}()
+	require.Equal(uint64(2), ap.GetSize())
+	for i, h := range hashes {
+		_, err := ap.GetActionByHash(h)
+		if i == 1 || i == 2 {
+			require.NoError(err)
+		} else {
+			require.Error(err)
+		}
+	}
+	pNonce, err := ap.GetPendingNonce(_addr1)
+	require.NoError(err)
+	require.Equal(uint64(4), pNonce)
+
+	// actions added after restart are journaled as well
+	selp, err := action.SignedTransfer(_addr1, _priKey1, 4, big.NewInt(10), nil, 100000, big.NewInt(0))
+	require.NoError(err)
+	require.NoError(ap.Add(ctx, selp))
+	acts, err := ap.journal.load(0)
+	require.NoError(err)
+	require.Len(acts, 3)
+}
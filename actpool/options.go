@@ -33,3 +33,12 @@ func WithTimeOut(ttl time.Duration) interface{ ActQueueOption } {
 }
 
 func (o *ttlOption) SetActQueueOption(aq *actQueue) { aq.ttl = o.ttl }
+
+type priceBumpOption struct{ percent uint64 }
+
+// WithPriceBump returns an option to overwrite the minimum price bump to replace an action.
+func WithPriceBump(percent uint64) interface{ ActQueueOption } {
+	return &priceBumpOption{percent}
+}
+
+func (o *priceBumpOption) SetActQueueOption(aq *actQueue) { aq.priceBump = o.percent }
@@ -100,11 +100,13 @@ func (worker *queueWorker) Handle(job workerJob) error {
 	if err := worker.checkSelpWithState(act, nonce, balance); err != nil {
 		return err
 	}
-	if err := worker.putAction(sender, act, nonce, balance); err != nil {
+	replaced, err := worker.putAction(sender, act, nonce, balance)
+	if err != nil {
 		return err
 	}
 
 	worker.ap.allActions.Set(actHash, act)
+	worker.ap.journalInsert(act)
 
 	if desAddress, ok := act.Destination(); ok && !strings.EqualFold(sender, desAddress) {
 		if err := worker.ap.accountDesActs.addAction(act); err != nil {
@@ -114,22 +116,24 @@ func (worker *queueWorker) Handle(job workerJob) error {
 
 	atomic.AddUint64(&worker.ap.gasInPool, intrinsicGas)
 
-	worker.mu.Lock()
-	defer worker.mu.Unlock()
-	if replace {
-		// TODO: early return if sender is the account to pop and nonce is larger than largest in the queue
-		actToReplace := worker.accountActs.PopPeek()
-		if actToReplace == nil {
-			log.L().Warn("UNEXPECTED ERROR: action pool is full, but no action to drop")
-			return nil
-		}
-		worker.ap.removeInvalidActs([]*action.SealedEnvelope{actToReplace})
-		if actToReplace.SenderAddress().String() == sender && actToReplace.Nonce() == nonce {
-			err = action.ErrTxPoolOverflow
-			_actpoolMtc.WithLabelValues("overMaxNumActsPerPool").Inc()
+	if replaced != nil {
+		_actpoolMtc.WithLabelValues("replacedByFee").Inc()
+		worker.ap.removeInvalidActs([]*action.SealedEnvelope{replaced})
+	} else if replace {
+		// the pool is full, make room by evicting the action of lowest gas price, which may be the new one
+		evicted := worker.ap.evictLowestPriced()
+		if evicted != nil {
+			if evictedHash, _ := evicted.Hash(); evictedHash == actHash {
+				err = action.ErrTxPoolOverflow
+				_actpoolMtc.WithLabelValues("overMaxNumActsPerPool").Inc()
+			} else {
+				_actpoolMtc.WithLabelValues("evictedLowestPrice").Inc()
+			}
 		}
 	}
 
+	worker.mu.Lock()
+	defer worker.mu.Unlock()
 	worker.removeEmptyAccounts()
 
 	return err
@@ -189,8 +193,13 @@ func (worker *queueWorker) checkSelpWithState(act *action.SealedEnvelope, pendin
 	return nil
 }
 
-func (worker *queueWorker) putAction(sender string, act *action.SealedEnvelope, pendingNonce uint64, confirmedBalance *big.Int) error {
+// putAction puts the action into the queue of sender, and returns the action of the same nonce replaced by it
+func (worker *queueWorker) putAction(sender string, act *action.SealedEnvelope, pendingNonce uint64, confirmedBalance *big.Int) (*action.SealedEnvelope, error) {
 	worker.mu.Lock()
+	var replaced *action.SealedEnvelope
+	if queue := worker.accountActs.Account(sender); queue != nil {
+		replaced, _ = queue.ActionByNonce(act.Nonce())
+	}
 	err := worker.accountActs.PutAction(
 		sender,
 		worker.ap,
@@ -202,14 +211,54 @@ func (worker *queueWorker) putAction(sender string, act *action.SealedEnvelope,
 	worker.mu.Unlock()
 	if err != nil {
 		actHash, _ := act.Hash()
-		_actpoolMtc.WithLabelValues("failedPutActQueue").Inc()
+		if errors.Is(err, action.ErrReplaceUnderpriced) {
+			_actpoolMtc.WithLabelValues("replaceUnderpriced").Inc()
+		} else {
+			_actpoolMtc.WithLabelValues("failedPutActQueue").Inc()
+		}
 		log.L().Debug("failed put action into ActQueue",
 			zap.String("actionHash", hex.EncodeToString(actHash[:])),
 			zap.Error(err))
-		return err
+		return nil, err
 	}
 
-	return nil
+	return replaced, nil
+}
+
+// lowestPricedAction returns the action of the lowest gas price among the actions of largest nonce of non-local senders
+func (worker *queueWorker) lowestPricedAction() *action.SealedEnvelope {
+	worker.mu.RLock()
+	defer worker.mu.RUnlock()
+	var ret *action.SealedEnvelope
+	worker.accountActs.Range(func(from string, queue ActQueue) {
+		if worker.ap.localAccounts[from] {
+			return
+		}
+		act := queue.PeekActionWithLargestNonce()
+		if act == nil {
+			return
+		}
+		if ret == nil || act.GasPrice().Cmp(ret.GasPrice()) < 0 {
+			ret = act
+		}
+	})
+	return ret
+}
+
+// popAction pops the action if it is still the one of largest nonce from its sender
+func (worker *queueWorker) popAction(act *action.SealedEnvelope) bool {
+	sender := act.SenderAddress().String()
+	worker.mu.Lock()
+	defer worker.mu.Unlock()
+	queue := worker.accountActs.Account(sender)
+	if queue == nil || queue.PeekActionWithLargestNonce() != act {
+		return false
+	}
+	worker.accountActs.PopActionWithLargestNonce(sender)
+	if queue.Empty() {
+		worker.emptyAccounts.Set(sender, struct{}{})
+	}
+	return true
 }
 
 func (worker *queueWorker) removeEmptyAccounts() {
@@ -247,7 +296,8 @@ func (worker *queueWorker) Reset(ctx context.Context) {
 		// Remove all actions that are committed to new block
 		acts := queue.UpdateAccountState(pendingNonce, confirmedState.Balance)
 		acts2 := queue.UpdateQueue()
-		worker.ap.removeInvalidActs(append(acts, acts2...))
+		acts3 := evictUnderpricedActs(queue, protocol.MustGetBlockCtx(ctx).BaseFee)
+		worker.ap.removeInvalidActs(append(append(acts, acts2...), acts3...))
 		// Delete the queue entry if it becomes empty
 		if queue.Empty() {
 			worker.emptyAccounts.Set(from, struct{}{})
@@ -255,6 +305,34 @@ func (worker *queueWorker) Reset(ctx context.Context) {
 	})
 }
 
+// evictUnderpricedActs removes the first action whose gas fee cap is lower than the base fee,
+// together with all actions of larger nonce which cannot be executed until it is replaced
+func evictUnderpricedActs(queue ActQueue, baseFee *big.Int) []*action.SealedEnvelope {
+	if baseFee == nil {
+		return nil
+	}
+	var underpriced *action.SealedEnvelope
+	for _, act := range queue.AllActs() {
+		if act.GasFeeCap().Cmp(baseFee) < 0 {
+			underpriced = act
+			break
+		}
+	}
+	if underpriced == nil {
+		return nil
+	}
+	var evicted []*action.SealedEnvelope
+	for !queue.Empty() {
+		act := queue.PopActionWithLargestNonce()
+		evicted = append(evicted, act)
+		if act.Nonce() <= underpriced.Nonce() {
+			break
+		}
+	}
+	_actpoolMtc.WithLabelValues("underpricedEvicted").Add(float64(len(evicted)))
+	return evicted
+}
+
 // PendingActions returns all accepted actions
 func (worker *queueWorker) PendingActions(ctx context.Context) []*pendingActions {
 	actionArr := make([]*pendingActions, 0)
@@ -294,6 +372,36 @@ func (worker *queueWorker) AllActions(sender address.Address) ([]*action.SealedE
 	return nil, false
 }
 
+// ActionsBySender returns the nonce-sorted actions of each sender, split into pending ones, which are of consecutive
+// nonces and executable with the sender's balance, and queued ones, which are blocked by a nonce gap or insufficient
+// balance
+func (worker *queueWorker) ActionsBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope) {
+	worker.mu.RLock()
+	defer worker.mu.RUnlock()
+	pending := make(map[string][]*action.SealedEnvelope)
+	queued := make(map[string][]*action.SealedEnvelope)
+	worker.accountActs.Range(func(from string, queue ActQueue) {
+		acts := queue.AllActs()
+		if len(acts) == 0 {
+			return
+		}
+		sort.Slice(acts, func(i, j int) bool {
+			return acts[i].Nonce() < acts[j].Nonce()
+		})
+		pendingNonce := queue.PendingNonce()
+		idx := sort.Search(len(acts), func(i int) bool {
+			return acts[i].Nonce() >= pendingNonce
+		})
+		if idx > 0 {
+			pending[from] = acts[:idx]
+		}
+		if idx < len(acts) {
+			queued[from] = acts[idx:]
+		}
+	})
+	return pending, queued
+}
+
 // PendingNonce returns the pending nonce of sender
 func (worker *queueWorker) PendingNonce(sender address.Address) (uint64, bool) {
 	worker.mu.RLock()
@@ -0,0 +1,339 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/server/itx/nodestats"
+)
+
+const (
+	// APIKeyHeader is the http header, or the grpc metadata key, carrying the API key
+	APIKeyHeader = "X-Api-Key"
+	// APIKeyPathPrefix is the path prefix of the endpoint carrying the API key, e.g., https://host/key/<key>
+	APIKeyPathPrefix = "/key/"
+
+	_anonymousClient = "anonymous"
+	_unknownClient   = "unknown"
+
+	// _anonymousIPCacheSize is the max number of remote IPs whose anonymous quota is tracked
+	_anonymousIPCacheSize = 10000
+
+	// _healthService is never checked, so that the liveness probes are not throttled
+	_healthService = "grpc.health.v1.Health"
+)
+
+var (
+	// _reflectionServices are checked only if AuthConfig.GuardReflection is set
+	_reflectionServices = map[string]bool{
+		"grpc.reflection.v1alpha.ServerReflection": true,
+		"grpc.reflection.v1.ServerReflection":      true,
+	}
+)
+
+type (
+	// APIQuota is the quota and method lists applied to a client
+	APIQuota struct {
+		// RateLimit is the number of tokens refilled per second, 0 means unlimited
+		RateLimit float64 `yaml:"rateLimit"`
+		// Burst is the maximum number of tokens a client can consume at once
+		Burst int `yaml:"burst"`
+		// AllowMethods lists the methods the client may call, empty means all methods
+		AllowMethods []string `yaml:"allowMethods"`
+		// DenyMethods lists the methods the client may not call
+		DenyMethods []string `yaml:"denyMethods"`
+	}
+
+	// APIKeyConfig is the config of a client API key
+	APIKeyConfig struct {
+		Key string `yaml:"key"`
+		// Name identifies the client in stats and metrics, so that the key is not exposed
+		Name     string `yaml:"name"`
+		APIQuota `yaml:",inline"`
+	}
+
+	// AuthConfig is the config of API keys and quotas, shared by the grpc, web3 and websocket APIs
+	AuthConfig struct {
+		// RequireAPIKey rejects the requests without an API key
+		RequireAPIKey bool           `yaml:"requireAPIKey"`
+		Keys          []APIKeyConfig `yaml:"keys"`
+		// Anonymous is the quota of the requests without an API key, applied per remote IP. Behind a reverse proxy
+		// all requests come from the proxy's IP, and share one quota
+		Anonymous APIQuota `yaml:"anonymous"`
+		// MethodWeights is the number of tokens consumed by a call of the method, 1 by default
+		MethodWeights map[string]int `yaml:"methodWeights"`
+		// GuardReflection checks the grpc reflection service like the other methods, it is exempted by default
+		GuardReflection bool `yaml:"guardReflection"`
+	}
+
+	// APIGuard authenticates API callers, and enforces their quotas and method lists
+	APIGuard struct {
+		clients         map[string]*apiClient
+		anonymousQuota  APIQuota
+		anonymous       *apiClient
+		anonymousByIP   cache.LRUCache
+		anonymousMutex  sync.Mutex
+		requireID       bool
+		weights         map[string]int
+		guardReflection bool
+		stats           *nodestats.APILocalStats
+	}
+
+	apiClient struct {
+		name    string
+		limiter *rate.Limiter
+		allow   map[string]bool
+		deny    map[string]bool
+	}
+
+	apiKeyContextKey struct{}
+
+	remoteIPContextKey struct{}
+)
+
+var (
+	_apiGuardMtc = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "iotex_api_client_metrics",
+		Help: "api calls per client.",
+	}, []string{"client", "result"})
+)
+
+func init() {
+	prometheus.MustRegister(_apiGuardMtc)
+}
+
+// NewAPIGuard creates an API guard, it returns nil if the config neither requires API keys nor sets any quota
+func NewAPIGuard(cfg AuthConfig, stats *nodestats.APILocalStats) *APIGuard {
+	if !cfg.RequireAPIKey && len(cfg.Keys) == 0 && cfg.Anonymous.isUnlimited() {
+		return nil
+	}
+	g := &APIGuard{
+		clients:         make(map[string]*apiClient, len(cfg.Keys)),
+		anonymousQuota:  cfg.Anonymous,
+		anonymous:       newAPIClient(_anonymousClient, cfg.Anonymous),
+		anonymousByIP:   cache.NewThreadSafeLruCache(_anonymousIPCacheSize),
+		requireID:       cfg.RequireAPIKey,
+		weights:         cfg.MethodWeights,
+		guardReflection: cfg.GuardReflection,
+		stats:           stats,
+	}
+	for _, k := range cfg.Keys {
+		name := k.Name
+		if name == "" {
+			name = maskAPIKey(k.Key)
+		}
+		g.clients[k.Key] = newAPIClient(name, k.APIQuota)
+	}
+	return g
+}
+
+func (q *APIQuota) isUnlimited() bool {
+	return q.RateLimit == 0 && len(q.AllowMethods) == 0 && len(q.DenyMethods) == 0
+}
+
+func newAPIClient(name string, q APIQuota) *apiClient {
+	c := &apiClient{
+		name:    name,
+		limiter: rate.NewLimiter(rate.Inf, 0),
+		allow:   make(map[string]bool, len(q.AllowMethods)),
+		deny:    make(map[string]bool, len(q.DenyMethods)),
+	}
+	if q.RateLimit > 0 {
+		c.limiter = rate.NewLimiter(rate.Limit(q.RateLimit), q.Burst)
+	}
+	for _, m := range q.AllowMethods {
+		c.allow[m] = true
+	}
+	for _, m := range q.DenyMethods {
+		c.deny[m] = true
+	}
+	return c
+}
+
+func maskAPIKey(key string) string {
+	if len(key) <= 4 {
+		return "key-****"
+	}
+	return "key-" + key[:4] + "****"
+}
+
+// WithAPIKey adds the API key of the caller into the context
+func WithAPIKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, apiKeyContextKey{}, key)
+}
+
+// WithRemoteIP adds the IP of the caller into the context, the anonymous quota is applied per IP
+func WithRemoteIP(ctx context.Context, ip string) context.Context {
+	if ip == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteIPContextKey{}, ip)
+}
+
+// withRequestCaller adds the API key and the remote IP of the http request into its context
+func withRequestCaller(req *http.Request) context.Context {
+	return WithRemoteIP(WithAPIKey(req.Context(), apiKeyFromRequest(req)), remoteIP(req.RemoteAddr))
+}
+
+func remoteIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func apiKeyFromRequest(req *http.Request) string {
+	if key := req.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	// only the path explicitly carrying the key is taken, other paths do not authenticate the caller
+	if key, ok := strings.CutPrefix(req.URL.Path, APIKeyPathPrefix); ok && !strings.Contains(key, "/") {
+		return key
+	}
+	return ""
+}
+
+// Check authenticates the caller in the context, and checks whether it may call the method now
+func (g *APIGuard) Check(ctx context.Context, method string) error {
+	if g == nil {
+		return nil
+	}
+	key, _ := ctx.Value(apiKeyContextKey{}).(string)
+	ip, _ := ctx.Value(remoteIPContextKey{}).(string)
+	client, err := g.client(key, ip)
+	if err != nil {
+		g.report(_unknownClient, "unauthenticated")
+		return err
+	}
+	if (len(client.allow) > 0 && !client.allow[method]) || client.deny[method] {
+		g.report(client.name, "denied")
+		return status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+	weight, ok := g.weights[method]
+	if !ok {
+		weight = 1
+	}
+	if !client.limiter.AllowN(time.Now(), weight) {
+		g.report(client.name, "throttled")
+		return status.Errorf(codes.ResourceExhausted, "quota exceeded for method %s", method)
+	}
+	g.report(client.name, "allowed")
+	return nil
+}
+
+func (g *APIGuard) client(key, ip string) (*apiClient, error) {
+	if key == "" {
+		if g.requireID {
+			return nil, status.Error(codes.Unauthenticated, "missing api key")
+		}
+		return g.anonymousClient(ip), nil
+	}
+	client, ok := g.clients[key]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	}
+	return client, nil
+}
+
+// anonymousClient returns the anonymous client of the remote IP, the callers of unknown IP share one client
+func (g *APIGuard) anonymousClient(ip string) *apiClient {
+	if ip == "" {
+		return g.anonymous
+	}
+	g.anonymousMutex.Lock()
+	defer g.anonymousMutex.Unlock()
+	if c, ok := g.anonymousByIP.Get(ip); ok {
+		return c.(*apiClient)
+	}
+	c := newAPIClient(_anonymousClient, g.anonymousQuota)
+	g.anonymousByIP.Add(ip, c)
+	return c
+}
+
+func (g *APIGuard) report(client, result string) {
+	_apiGuardMtc.WithLabelValues(client, result).Inc()
+	if g.stats != nil {
+		g.stats.ReportClientCall(client, result)
+	}
+}
+
+func grpcMethodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// grpcExempted returns whether the full method is not checked
+func (g *APIGuard) grpcExempted(fullMethod string) bool {
+	service := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	return service == _healthService || (!g.guardReflection && _reflectionServices[service])
+}
+
+// grpcCaller adds the API key in the metadata and the peer IP into the context
+func grpcCaller(ctx context.Context) context.Context {
+	ctx = WithAPIKey(ctx, grpcAPIKey(ctx))
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ctx = WithRemoteIP(ctx, remoteIP(p.Addr.String()))
+	}
+	return ctx
+}
+
+func grpcAPIKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if keys := md.Get(APIKeyHeader); len(keys) > 0 {
+		return keys[0]
+	}
+	return ""
+}
+
+// UnaryServerInterceptor returns the grpc interceptor checking unary calls
+func (g *APIGuard) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if g.grpcExempted(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if err := g.Check(grpcCaller(ctx), grpcMethodName(info.FullMethod)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the grpc interceptor checking stream calls
+func (g *APIGuard) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if g.grpcExempted(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		if err := g.Check(grpcCaller(ss.Context()), grpcMethodName(info.FullMethod)); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
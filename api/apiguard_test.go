@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/server/itx/nodestats"
+	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
+)
+
+func TestAPIGuard(t *testing.T) {
+	require := require.New(t)
+	require.Nil(NewAPIGuard(DefaultConfig.Auth, nil))
+	var nilGuard *APIGuard
+	require.NoError(nilGuard.Check(context.Background(), "eth_call"))
+
+	stats := nodestats.NewAPILocalStats()
+	g := NewAPIGuard(AuthConfig{
+		Keys: []APIKeyConfig{
+			{Key: "alice-key", Name: "alice", APIQuota: APIQuota{RateLimit: 0.001, Burst: 10}},
+			{Key: "bob-key", APIQuota: APIQuota{AllowMethods: []string{"eth_call", "ReadContract"}}},
+			{Key: "carol-key", Name: "carol", APIQuota: APIQuota{DenyMethods: []string{"eth_getLogs"}}},
+		},
+		Anonymous:     APIQuota{RateLimit: 0.001, Burst: 1},
+		MethodWeights: map[string]int{"eth_getLogs": 5},
+	}, stats)
+	code := func(err error) codes.Code {
+		return status.Code(err)
+	}
+
+	t.Run("Anonymous", func(t *testing.T) {
+		ctx := context.Background()
+		require.NoError(g.Check(ctx, "eth_call"))
+		require.Equal(codes.ResourceExhausted, code(g.Check(ctx, "eth_call")))
+	})
+	t.Run("AnonymousPerIP", func(t *testing.T) {
+		// every remote IP has its own anonymous quota
+		alice, bob := WithRemoteIP(context.Background(), "1.1.1.1"), WithRemoteIP(context.Background(), "2.2.2.2")
+		require.NoError(g.Check(alice, "eth_call"))
+		require.Equal(codes.ResourceExhausted, code(g.Check(alice, "eth_call")))
+		require.NoError(g.Check(bob, "eth_call"))
+		require.Equal(codes.ResourceExhausted, code(g.Check(bob, "eth_call")))
+	})
+	t.Run("UnknownKey", func(t *testing.T) {
+		require.Equal(codes.Unauthenticated, code(g.Check(WithAPIKey(context.Background(), "eve-key"), "eth_call")))
+	})
+	t.Run("MethodWeights", func(t *testing.T) {
+		ctx := WithAPIKey(context.Background(), "alice-key")
+		require.NoError(g.Check(ctx, "eth_getLogs"))
+		require.NoError(g.Check(ctx, "eth_getLogs"))
+		require.Equal(codes.ResourceExhausted, code(g.Check(ctx, "eth_getLogs")))
+	})
+	t.Run("AllowMethods", func(t *testing.T) {
+		ctx := WithAPIKey(context.Background(), "bob-key")
+		require.NoError(g.Check(ctx, "eth_call"))
+		require.Equal(codes.PermissionDenied, code(g.Check(ctx, "eth_getLogs")))
+	})
+	t.Run("DenyMethods", func(t *testing.T) {
+		ctx := WithAPIKey(context.Background(), "carol-key")
+		require.NoError(g.Check(ctx, "eth_call"))
+		require.Equal(codes.PermissionDenied, code(g.Check(ctx, "eth_getLogs")))
+	})
+	t.Run("RequireAPIKey", func(t *testing.T) {
+		g := NewAPIGuard(AuthConfig{RequireAPIKey: true}, nil)
+		require.Equal(codes.Unauthenticated, code(g.Check(context.Background(), "eth_call")))
+	})
+
+	report := stats.BuildReport()
+	require.Contains(report, "alice")
+	require.Contains(report, "key-bob-****")
+	require.Contains(report, "anonymous")
+	require.Contains(report, "unknown")
+}
+
+func TestRemoteIP(t *testing.T) {
+	require := require.New(t)
+	require.Equal("1.2.3.4", remoteIP("1.2.3.4:5678"))
+	require.Equal("::1", remoteIP("[::1]:5678"))
+	require.Equal("1.2.3.4", remoteIP("1.2.3.4"))
+	req := httptest.NewRequest(http.MethodPost, "http://url.com/", nil)
+	req.RemoteAddr = "1.2.3.4:5678"
+	require.Equal("1.2.3.4", withRequestCaller(req).Value(remoteIPContextKey{}))
+}
+
+func TestAPIKeyFromRequest(t *testing.T) {
+	require := require.New(t)
+	req := httptest.NewRequest(http.MethodPost, "http://url.com/key/path-key", nil)
+	require.Equal("path-key", apiKeyFromRequest(req))
+	req.Header.Set(APIKeyHeader, "header-key")
+	require.Equal("header-key", apiKeyFromRequest(req))
+	for _, url := range []string{
+		"http://url.com/",
+		"http://url.com/path-key",
+		"http://url.com/key/",
+		"http://url.com/key/path-key/more",
+		"http://url.com/other/path-key",
+	} {
+		req = httptest.NewRequest(http.MethodPost, url, nil)
+		require.Empty(apiKeyFromRequest(req), url)
+	}
+}
+
+func TestAPIGuardGRPCInterceptor(t *testing.T) {
+	require := require.New(t)
+	g := NewAPIGuard(AuthConfig{
+		Keys: []APIKeyConfig{
+			{Key: "alice-key", APIQuota: APIQuota{AllowMethods: []string{"GetAccount"}}},
+		},
+		RequireAPIKey: true,
+	}, nil)
+	interceptor := g.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/iotexapi.APIService/GetAccount"}
+	_, err := interceptor(context.Background(), nil, info, handler)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(APIKeyHeader, "alice-key"))
+	res, err := interceptor(ctx, nil, info, handler)
+	require.NoError(err)
+	require.Equal("ok", res)
+	info.FullMethod = "/iotexapi.APIService/ReadContract"
+	_, err = interceptor(ctx, nil, info, handler)
+	require.Equal(codes.PermissionDenied, status.Code(err))
+
+	// the health service is exempted, and the reflection unless it is guarded
+	for _, m := range []string{
+		"/grpc.health.v1.Health/Check",
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo",
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo",
+	} {
+		info.FullMethod = m
+		res, err = interceptor(context.Background(), nil, info, handler)
+		require.NoError(err, m)
+		require.Equal("ok", res)
+	}
+	streamHandler := func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	}
+	streamInterceptor := g.StreamServerInterceptor()
+	require.NoError(streamInterceptor(nil, &fakeServerStream{ctx: context.Background()},
+		&grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, streamHandler))
+	require.Equal(codes.Unauthenticated, status.Code(streamInterceptor(nil, &fakeServerStream{ctx: context.Background()},
+		&grpc.StreamServerInfo{FullMethod: "/iotexapi.APIService/StreamBlocks"}, streamHandler)))
+	g = NewAPIGuard(AuthConfig{RequireAPIKey: true, GuardReflection: true}, nil)
+	info.FullMethod = "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"
+	_, err = g.UnaryServerInterceptor()(context.Background(), nil, info, handler)
+	require.Equal(codes.Unauthenticated, status.Code(err))
+
+	// the anonymous quota is applied per peer IP
+	g = NewAPIGuard(AuthConfig{Anonymous: APIQuota{RateLimit: 0.001, Burst: 1}}, nil)
+	interceptor = g.UnaryServerInterceptor()
+	info.FullMethod = "/iotexapi.APIService/GetAccount"
+	peerCtx := func(addr string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 4689}})
+	}
+	_, err = interceptor(peerCtx("1.1.1.1"), nil, info, handler)
+	require.NoError(err)
+	_, err = interceptor(peerCtx("1.1.1.1"), nil, info, handler)
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	_, err = interceptor(peerCtx("2.2.2.2"), nil, info, handler)
+	require.NoError(err)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAPIGuardWeb3(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	core.EXPECT().Track(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+	core.EXPECT().EVMNetworkID().Return(uint32(4689)).AnyTimes()
+	g := NewAPIGuard(AuthConfig{
+		Keys: []APIKeyConfig{
+			{Key: "alice-key", APIQuota: APIQuota{AllowMethods: []string{"eth_chainId"}}},
+		},
+		RequireAPIKey: true,
+	}, nil)
+	svr := newHTTPHandler(NewWeb3Handler(core, "", _defaultBatchRequestLimit, WithAPIGuard(g)))
+	post := func(url string) string {
+		req := httptest.NewRequest(http.MethodPost, url, strings.NewReader(`{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`))
+		resp := httptest.NewRecorder()
+		svr.ServeHTTP(resp, req)
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(err)
+		return string(body)
+	}
+	require.Contains(post("http://url.com"), "missing api key")
+	require.Contains(post("http://url.com/alice-key"), "missing api key")
+	require.Contains(post("http://url.com/key/alice-key"), `"result":"0x1251"`)
+}
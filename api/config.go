@@ -25,6 +25,14 @@ type Config struct {
 	BatchRequestLimit int `yaml:"batchRequestLimit"`
 	// WebsocketRateLimit is the maximum number of messages per second per client.
 	WebsocketRateLimit int `yaml:"websocketRateLimit"`
+	// BundleSizeLimit is the maximum number of actions simulated in a bundle, e.g., by eth_callBundle
+	BundleSizeLimit int `yaml:"bundleSizeLimit"`
+	// BundleGasCap is the maximum total gas limit of the actions simulated in a bundle
+	BundleGasCap uint64 `yaml:"bundleGasCap"`
+	// EnableDebugAPI enables the debug_* trace methods, tracing a block requires the archive mode
+	EnableDebugAPI bool `yaml:"enableDebugAPI"`
+	// Auth is the config of API keys, quotas and method lists
+	Auth AuthConfig `yaml:"auth"`
 }
 
 // DefaultConfig is the default config
@@ -38,4 +46,14 @@ var DefaultConfig = Config{
 	RangeQueryLimit:    1000,
 	BatchRequestLimit:  _defaultBatchRequestLimit,
 	WebsocketRateLimit: 5,
+	BundleSizeLimit:    100,
+	BundleGasCap:       250000000,
+	Auth: AuthConfig{
+		Keys: []APIKeyConfig{},
+		Anonymous: APIQuota{
+			AllowMethods: []string{},
+			DenyMethods:  []string{},
+		},
+		MethodWeights: map[string]int{},
+	},
 }
@@ -12,9 +12,13 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"reflect"
+	"sort"
 	"strconv"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 
@@ -73,6 +77,9 @@ const (
 	// defaultTraceTimeout is the amount of time a single transaction can execute
 	// by default before being forcefully aborted.
 	defaultTraceTimeout = 5 * time.Second
+	// _asyncIndexerWaitTimeout is the amount of time to wait for an asynchronously fed indexer
+	// to catch up before the query is rejected.
+	_asyncIndexerWaitTimeout = 3 * time.Second
 )
 
 type (
@@ -80,6 +87,9 @@ type (
 	CoreService interface {
 		// Account returns the metadata of an account
 		Account(addr address.Address) (*iotextypes.AccountMeta, *iotextypes.BlockIdentifier, error)
+		// AccountAtHeight returns the balance, nonce and code of an account at height, archive mode is required unless
+		// it's the tip height
+		AccountAtHeight(ctx context.Context, addr address.Address, height uint64) (*iotextypes.AccountMeta, error)
 		// ChainMeta returns blockchain metadata
 		ChainMeta() (*iotextypes.ChainMeta, string, error)
 		// ServerMeta gets the server metadata
@@ -88,10 +98,16 @@ type (
 		SendAction(ctx context.Context, in *iotextypes.Action) (string, error)
 		// ReadContract reads the state in a contract address specified by the slot
 		ReadContract(ctx context.Context, callerAddr address.Address, sc *action.Execution) (string, *iotextypes.Receipt, error)
+		// ReadContractAtHeight reads the contract on the state at height, archive mode is required unless it's the tip height
+		ReadContractAtHeight(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (string, *iotextypes.Receipt, error)
 		// ReadState reads state on blockchain
 		ReadState(protocolID string, height string, methodName []byte, arguments [][]byte) (*iotexapi.ReadStateResponse, error)
 		// SuggestGasPrice suggests gas price
 		SuggestGasPrice() (uint64, error)
+		// SuggestGasTipCap suggests gas tip cap
+		SuggestGasTipCap() (*big.Int, error)
+		// FeeHistory returns the fee history of the blocks ending at lastBlock
+		FeeHistory(ctx context.Context, blocks, lastBlock uint64, rewardPercentiles []float64) (uint64, [][]*big.Int, []*big.Int, []float64, error)
 		// EstimateGasForAction estimates gas for action
 		EstimateGasForAction(ctx context.Context, in *iotextypes.Action) (uint64, error)
 		// EpochMeta gets epoch metadata
@@ -118,12 +134,22 @@ type (
 		Action(actionHash string, checkPending bool) (*iotexapi.ActionInfo, error)
 		// ActionsByAddress returns all actions associated with an address
 		ActionsByAddress(addr address.Address, start uint64, count uint64) ([]*iotexapi.ActionInfo, error)
+		// TokenTransfersByAddress returns the token transfers from or to an address, and the total number of them
+		TokenTransfersByAddress(addr address.Address, token address.Address, start uint64, count uint64) ([]*blockindex.TokenTransfer, uint64, error)
+		// TokenTransfersByToken returns the transfers of a token, and the total number of them
+		TokenTransfersByToken(token address.Address, start uint64, count uint64) ([]*blockindex.TokenTransfer, uint64, error)
+		// InternalTransfersByAddress returns the internal transfers from or to an address, and the total number of them
+		InternalTransfersByAddress(addr address.Address, start uint64, count uint64) ([]*blockindex.InternalTransfer, uint64, error)
+		// IndexerStatus returns the height and lag of the indexers fed asynchronously, sorted by name
+		IndexerStatus() []*apitypes.IndexerStatus
 		// ActionByActionHash returns action by action hash
 		ActionByActionHash(h hash.Hash256) (*action.SealedEnvelope, *block.Block, uint32, error)
 		// PendingActionByActionHash returns action by action hash
 		PendingActionByActionHash(h hash.Hash256) (*action.SealedEnvelope, error)
 		// ActPoolActions returns the all Transaction Identifiers in the actpool
 		ActionsInActPool(actHashes []string) ([]*action.SealedEnvelope, error)
+		// ActionsInActPoolBySender returns the pending and queued actions in the actpool, keyed by sender
+		ActionsInActPoolBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope)
 		// BlockByHeightRange returns blocks within the height range
 		BlockByHeightRange(uint64, uint64) ([]*apitypes.BlockWithReceipts, error)
 		// BlockByHeight returns the block and its receipt from block height
@@ -148,28 +174,42 @@ type (
 		ChainID() uint32
 		// ReadContractStorage reads contract's storage
 		ReadContractStorage(ctx context.Context, addr address.Address, key []byte) ([]byte, error)
+		// ReadContractStorageAtHeight reads contract's storage at height, archive mode is required unless it's the tip height
+		ReadContractStorageAtHeight(ctx context.Context, addr address.Address, key []byte, height uint64) ([]byte, error)
 		// ChainListener returns the instance of Listener
 		ChainListener() apitypes.Listener
 		// SimulateExecution simulates execution
 		SimulateExecution(context.Context, address.Address, *action.Execution) ([]byte, *action.Receipt, error)
+		// CreateAccessList returns the access list of the call on the state at height, and the receipt of the call
+		// run with the access list
+		CreateAccessList(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (types.AccessList, *action.Receipt, error)
+		// SimulateActions runs the actions in order on the state at height, and returns the return value and receipt
+		// of each action, or the validation error of a signed action which is not run, the states are discarded afterwards
+		SimulateActions(ctx context.Context, height uint64, acts []*action.SimulatedAction) ([][]byte, []*action.Receipt, []error, error)
 		// SyncingProgress returns the syncing status of node
 		SyncingProgress() (uint64, uint64, uint64)
 		// TipHeight returns the tip of the chain
 		TipHeight() uint64
+		// LowestBlockHeight returns the lowest height of blocks available, blocks below it have been pruned
+		LowestBlockHeight() (uint64, error)
 		// PendingNonce returns the pending nonce of an account
 		PendingNonce(address.Address) (uint64, error)
 		// ReceiveBlock broadcasts the block to api subscribers
 		ReceiveBlock(blk *block.Block) error
 		// BlockHashByBlockHeight returns block hash by block height
 		BlockHashByBlockHeight(blkHeight uint64) (hash.Hash256, error)
-		// TraceTransaction returns the trace result of a transaction
+		// StateProof returns the merkle proofs of the account and its storage slots at height, the state root is not in
+		// the block header, so the proofs are verifiable only against a trusted state root
+		StateProof(ctx context.Context, addr address.Address, storageKeys []hash.Hash256, height uint64) (*apitypes.AccountProof, error)
+		// TraceTransaction returns the trace result of a transaction, replayed in its block, archive mode is required
 		TraceTransaction(ctx context.Context, actHash string, config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error)
-		// TraceCall returns the trace result of a call
+		// TraceBlock returns the receipts and trace results of all actions in a block
+		TraceBlock(ctx context.Context, blk *block.Block, config *tracers.TraceConfig) ([]*action.Receipt, []any, error)
+		// TraceCall returns the trace result of a call on the state at height, archive mode is required unless it's the tip height
 		TraceCall(ctx context.Context,
 			callerAddr address.Address,
-			blkNumOrHash any,
+			height uint64,
 			contractAddress string,
-			nonce uint64,
 			amount *big.Int,
 			gasLimit uint64,
 			data []byte,
@@ -187,6 +227,7 @@ type (
 		dao               blockdao.BlockDAO
 		indexer           blockindex.Indexer
 		bfIndexer         blockindex.BloomFilterIndexer
+		asyncIndexers     map[blockdao.BlockIndexer]*blockdao.AsyncIndexer
 		ap                actpool.ActPool
 		gs                *gasstation.GasStation
 		broadcastHandler  BroadcastOutbound
@@ -198,6 +239,8 @@ type (
 		messageBatcher    *batch.Manager
 		apiStats          *nodestats.APILocalStats
 		sgdIndexer        blockindex.SGDRegistry
+		ttIndexer         blockindex.TokenTransferIndexer
+		itIndexer         blockindex.InternalTransferIndexer
 		getBlockTime      evm.GetBlockTime
 	}
 
@@ -242,6 +285,32 @@ func WithSGDIndexer(sgdIndexer blockindex.SGDRegistry) Option {
 	}
 }
 
+// WithTokenTransferIndexer is the option to return token transfers through API.
+func WithTokenTransferIndexer(indexer blockindex.TokenTransferIndexer) Option {
+	return func(svr *coreService) {
+		svr.ttIndexer = indexer
+	}
+}
+
+// WithInternalTransferIndexer is the option to return internal transfers through API.
+func WithInternalTransferIndexer(indexer blockindex.InternalTransferIndexer) Option {
+	return func(svr *coreService) {
+		svr.itIndexer = indexer
+	}
+}
+
+// WithAsyncIndexers is the option to wait for the indexers which are fed asynchronously, before querying them
+func WithAsyncIndexers(indexers ...*blockdao.AsyncIndexer) Option {
+	return func(svr *coreService) {
+		if svr.asyncIndexers == nil {
+			svr.asyncIndexers = make(map[blockdao.BlockIndexer]*blockdao.AsyncIndexer, len(indexers))
+		}
+		for _, indexer := range indexers {
+			svr.asyncIndexers[indexer.Indexer()] = indexer
+		}
+	}
+}
+
 type intrinsicGasCalculator interface {
 	IntrinsicGas() (uint64, error)
 }
@@ -265,7 +334,7 @@ func newCoreService(
 	getBlockTime evm.GetBlockTime,
 	opts ...Option,
 ) (CoreService, error) {
-	if cfg == (Config{}) {
+	if reflect.DeepEqual(cfg, Config{}) {
 		log.L().Warn("API server is not configured.")
 		cfg = DefaultConfig
 	}
@@ -359,6 +428,50 @@ func (core *coreService) Account(addr address.Address) (*iotextypes.AccountMeta,
 	}, nil
 }
 
+// AccountAtHeight returns the balance, nonce and code of an account at height
+func (core *coreService) AccountAtHeight(ctx context.Context, addr address.Address, height uint64) (*iotextypes.AccountMeta, error) {
+	ctx, span := tracer.NewSpan(ctx, "coreService.AccountAtHeight")
+	defer span.End()
+	addrStr := addr.String()
+	if addrStr == address.RewardingPoolAddr || addrStr == address.StakingBucketPoolAddr {
+		if height != core.bc.TipHeight() {
+			return nil, status.Errorf(codes.Unimplemented, "cannot read protocol account %s at past height %d", addrStr, height)
+		}
+		accountMeta, _, err := core.getProtocolAccount(ctx, addrStr)
+		return accountMeta, err
+	}
+	sr, err := core.stateReaderAt(height)
+	if err != nil {
+		return nil, err
+	}
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(
+		genesis.WithGenesisContext(ctx, core.bc.Genesis()),
+		protocol.BlockCtx{BlockHeight: height},
+	))
+	state, err := accountutil.AccountState(ctx, sr, addr)
+	if err != nil {
+		return nil, historyStateError(height, err)
+	}
+	accountMeta := &iotextypes.AccountMeta{
+		Address:    addrStr,
+		Balance:    state.Balance.String(),
+		IsContract: state.IsContract(),
+	}
+	if protocol.MustGetFeatureCtx(ctx).RefactorFreshAccountConversion {
+		accountMeta.PendingNonce = state.PendingNonceConsideringFreshAccount()
+	} else {
+		accountMeta.PendingNonce = state.PendingNonce()
+	}
+	if state.IsContract() {
+		var code protocol.SerializableBytes
+		if _, err = sr.State(&code, protocol.NamespaceOption(evm.CodeKVNameSpace), protocol.KeyOption(state.CodeHash)); err != nil {
+			return nil, historyStateError(height, err)
+		}
+		accountMeta.ContractByteCode = code
+	}
+	return accountMeta, nil
+}
+
 // ChainMeta returns blockchain metadata
 func (core *coreService) ChainMeta() (*iotextypes.ChainMeta, string, error) {
 	tipHeight := core.bc.TipHeight()
@@ -372,9 +485,14 @@ func (core *coreService) ChainMeta() (*iotextypes.ChainMeta, string, error) {
 	if core.bs != nil {
 		_, _, _, syncStatus = core.bs.SyncStatus()
 	}
+	lowestHeight, err := core.LowestBlockHeight()
+	if err != nil {
+		return nil, "", status.Error(codes.Internal, err.Error())
+	}
 	chainMeta := &iotextypes.ChainMeta{
-		Height:  tipHeight,
-		ChainID: core.bc.ChainID(),
+		Height:            tipHeight,
+		ChainID:           core.bc.ChainID(),
+		LowestBlockHeight: lowestHeight,
 	}
 	if core.indexer == nil {
 		return chainMeta, syncStatus, nil
@@ -528,23 +646,69 @@ func (core *coreService) validateChainID(chainID uint32) error {
 func (core *coreService) ReadContract(ctx context.Context, callerAddr address.Address, sc *action.Execution) (string, *iotextypes.Receipt, error) {
 	log.Logger("api").Debug("receive read smart contract request")
 	key := hash.Hash160b(append([]byte(sc.Contract()), sc.Data()...))
+	// the result of a call with simulation overrides is not cached
+	_, overridden := evm.GetSimulationOverrideCtx(ctx)
 	// TODO: either moving readcache into the upper layer or change the storage format
-	if d, ok := core.readCache.Get(key); ok {
+	if d, ok := core.readCache.Get(key); ok && !overridden {
 		res := iotexapi.ReadContractResponse{}
 		if err := proto.Unmarshal(d, &res); err == nil {
 			return res.Data, res.Receipt, nil
 		}
 	}
-	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
-	state, err := accountutil.AccountState(ctx, core.sf, callerAddr)
+	res, err := core.readContract(ctx, callerAddr, sc, core.bc.TipHeight())
 	if err != nil {
-		return "", nil, status.Error(codes.InvalidArgument, err.Error())
+		return "", nil, err
+	}
+	if d, err := proto.Marshal(res); err == nil && !overridden {
+		core.readCache.Put(key, d)
+	}
+	return res.Data, res.Receipt, nil
+}
+
+// ReadContractAtHeight reads the contract on the state at height
+func (core *coreService) ReadContractAtHeight(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (string, *iotextypes.Receipt, error) {
+	if height == core.bc.TipHeight() {
+		return core.ReadContract(ctx, callerAddr, sc)
 	}
-	if ctx, err = core.bc.Context(ctx); err != nil {
+	res, err := core.readContract(ctx, callerAddr, sc, height)
+	if err != nil {
 		return "", nil, err
 	}
+	return res.Data, res.Receipt, nil
+}
+
+func (core *coreService) readContract(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (*iotexapi.ReadContractResponse, error) {
+	retval, receipt, err := core.simulateCall(ctx, callerAddr, sc, height)
+	if err != nil {
+		return nil, err
+	}
+	// ReadContract() is read-only, if no error returned, we consider it a success
+	receipt.Status = uint64(iotextypes.ReceiptStatus_Success)
+	return &iotexapi.ReadContractResponse{
+		Data:    hex.EncodeToString(retval),
+		Receipt: receipt.ConvertToReceiptPb(),
+	}, nil
+}
+
+// simulateCall runs the call with the pending nonce of the caller and zero gas price on the state at height
+func (core *coreService) simulateCall(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) ([]byte, *action.Receipt, error) {
+	sr, err := core.stateReaderAt(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
+	state, err := accountutil.AccountState(ctx, sr, callerAddr)
+	if err != nil {
+		if err = historyStateError(height, err); status.Code(err) == codes.Unavailable {
+			return nil, nil, err
+		}
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if ctx, err = core.contextAtHeight(ctx, height); err != nil {
+		return nil, nil, err
+	}
 	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
-		BlockHeight: core.bc.TipHeight(),
+		BlockHeight: height,
 	}))
 	var pendingNonce uint64
 	if protocol.MustGetFeatureCtx(ctx).RefactorFreshAccountConversion {
@@ -553,29 +717,56 @@ func (core *coreService) ReadContract(ctx context.Context, callerAddr address.Ad
 		pendingNonce = state.PendingNonce()
 	}
 	sc.SetNonce(pendingNonce)
-	var (
-		g             = core.bc.Genesis()
-		blockGasLimit = g.BlockGasLimitByHeight(core.bc.TipHeight())
-	)
+	blockGasLimit := core.blockGasLimit(ctx, height)
 	if sc.GasLimit() == 0 || blockGasLimit < sc.GasLimit() {
 		sc.SetGasLimit(blockGasLimit)
 	}
 	sc.SetGasPrice(big.NewInt(0)) // ReadContract() is read-only, use 0 to prevent insufficient gas
 
-	retval, receipt, err := core.simulateExecution(ctx, callerAddr, sc, core.dao.GetBlockHash, core.getBlockTime)
-	if err != nil {
-		return "", nil, status.Error(codes.Internal, err.Error())
+	var (
+		retval  []byte
+		receipt *action.Receipt
+	)
+	if height == core.bc.TipHeight() {
+		retval, receipt, err = core.simulateExecution(ctx, callerAddr, sc, core.dao.GetBlockHash, core.getBlockTime)
+	} else {
+		retval, receipt, err = core.simulateExecutionAtHeight(ctx, height, callerAddr, sc)
 	}
-	// ReadContract() is read-only, if no error returned, we consider it a success
-	receipt.Status = uint64(iotextypes.ReceiptStatus_Success)
-	res := iotexapi.ReadContractResponse{
-		Data:    hex.EncodeToString(retval),
-		Receipt: receipt.ConvertToReceiptPb(),
+	if err != nil {
+		if err = historyStateError(height, err); status.Code(err) == codes.Unavailable {
+			return nil, nil, err
+		}
+		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
-	if d, err := proto.Marshal(&res); err == nil {
-		core.readCache.Put(key, d)
+	return retval, receipt, nil
+}
+
+// CreateAccessList returns the access list of the call on the state at height, and the receipt of the call run with
+// the access list. The call is repeated with the collected access list until the list is stable
+func (core *coreService) CreateAccessList(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (types.AccessList, *action.Receipt, error) {
+	var (
+		accessList = sc.AccessList()
+		prev       *logger.AccessListTracer
+	)
+	for {
+		exec, err := action.NewExecutionWithAccessList(sc.Contract(), 0, sc.Amount(), sc.GasLimit(), sc.GasPrice(), sc.Data(), accessList)
+		if err != nil {
+			return nil, nil, err
+		}
+		tracer := &accessListTracer{list: accessList}
+		_, receipt, err := core.simulateCall(protocol.WithVMConfigCtx(ctx, vm.Config{
+			Tracer: tracer,
+		}), callerAddr, exec, height)
+		if err != nil {
+			return nil, nil, err
+		}
+		// the call doesn't enter the evm, or it touches no more addresses or slots
+		if tracer.AccessListTracer == nil || (prev != nil && tracer.Equal(prev)) {
+			return accessList, receipt, nil
+		}
+		prev = tracer.AccessListTracer
+		accessList = tracer.AccessList()
 	}
-	return res.Data, res.Receipt, nil
 }
 
 // ReadState reads state on blockchain
@@ -609,6 +800,16 @@ func (core *coreService) SuggestGasPrice() (uint64, error) {
 	return core.gs.SuggestGasPrice()
 }
 
+// SuggestGasTipCap suggests gas tip cap
+func (core *coreService) SuggestGasTipCap() (*big.Int, error) {
+	return core.gs.SuggestGasTipCap()
+}
+
+// FeeHistory returns the fee history of the blocks ending at lastBlock
+func (core *coreService) FeeHistory(ctx context.Context, blocks, lastBlock uint64, rewardPercentiles []float64) (uint64, [][]*big.Int, []*big.Int, []float64, error) {
+	return core.gs.FeeHistory(ctx, blocks, lastBlock, rewardPercentiles)
+}
+
 // EstimateGasForAction estimates gas for action
 func (core *coreService) EstimateGasForAction(ctx context.Context, in *iotextypes.Action) (uint64, error) {
 	selp, err := (&action.Deserializer{}).SetEvmNetworkID(core.EVMNetworkID()).ActionToSealedEnvelope(in)
@@ -785,15 +986,14 @@ func (core *coreService) ReceiptByActionHash(h hash.Hash256) (*action.Receipt, e
 	if core.indexer == nil {
 		return nil, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
 	}
-
-	actIndex, err := core.indexer.GetActionIndex(h[:])
+	actIndex, err := core.getActionIndex(h[:])
 	if err != nil {
-		return nil, errors.Wrap(ErrNotFound, err.Error())
+		return nil, err
 	}
 
 	receipts, err := core.dao.GetReceipts(actIndex.BlockHeight())
 	if err != nil {
-		return nil, err
+		return nil, prunedBlockError(err)
 	}
 	if receipt := filterReceipts(receipts, h); receipt != nil {
 		return receipt, nil
@@ -814,19 +1014,21 @@ func (core *coreService) TransactionLogByActionHash(actHash string) (*iotextypes
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-
-	actIndex, err := core.indexer.GetActionIndex(h)
+	actIndex, err := core.getActionIndex(h)
 	if err != nil {
-		if errors.Cause(err) == db.ErrNotExist {
+		if errors.Cause(err) == ErrNotFound {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	sysLog, err := core.dao.TransactionLogs(actIndex.BlockHeight())
 	if err != nil {
-		if errors.Cause(err) == db.ErrNotExist {
+		switch errors.Cause(err) {
+		case db.ErrNotExist:
 			return nil, status.Error(codes.NotFound, err.Error())
+		case filedao.ErrBlockPruned:
+			return nil, prunedBlockError(err)
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -855,8 +1057,11 @@ func (core *coreService) TransactionLogByBlockHeight(blockHeight uint64) (*iotex
 
 	h, err := core.dao.GetBlockHash(blockHeight)
 	if err != nil {
-		if errors.Cause(err) == db.ErrNotExist {
+		switch errors.Cause(err) {
+		case db.ErrNotExist:
 			return nil, nil, status.Error(codes.NotFound, err.Error())
+		case filedao.ErrBlockPruned:
+			return nil, nil, prunedBlockError(err)
 		}
 		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
@@ -867,9 +1072,12 @@ func (core *coreService) TransactionLogByBlockHeight(blockHeight uint64) (*iotex
 	}
 	sysLog, err := core.dao.TransactionLogs(blockHeight)
 	if err != nil {
-		if errors.Cause(err) == db.ErrNotExist {
+		switch errors.Cause(err) {
+		case db.ErrNotExist:
 			// should return empty, no transaction happened in block
 			return blockIdentifier, nil, nil
+		case filedao.ErrBlockPruned:
+			return nil, nil, prunedBlockError(err)
 		}
 		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
@@ -880,6 +1088,13 @@ func (core *coreService) TipHeight() uint64 {
 	return core.bc.TipHeight()
 }
 
+func (core *coreService) LowestBlockHeight() (uint64, error) {
+	if dao, ok := core.dao.(blockdao.BlockDAOWithBottom); ok {
+		return dao.Bottom()
+	}
+	return 1, nil
+}
+
 // Start starts the API server
 func (core *coreService) Start(_ context.Context) error {
 	if err := core.chainListener.Start(); err != nil {
@@ -991,10 +1206,16 @@ func (core *coreService) Actions(start uint64, count uint64) ([]*iotexapi.Action
 	if count > core.cfg.RangeQueryLimit {
 		return nil, status.Error(codes.InvalidArgument, "range exceeds the limit")
 	}
-
-	totalActions, err := core.indexer.GetTotalActions()
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	var totalActions uint64
+	if err := core.lookupIndex(core.indexer, func() (bool, error) {
+		var err error
+		totalActions, err = core.indexer.GetTotalActions()
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		return start < totalActions, nil
+	}); err != nil {
+		return nil, err
 	}
 	if start >= totalActions {
 		return nil, status.Error(codes.InvalidArgument, "start exceeds the total actions in the block")
@@ -1067,14 +1288,24 @@ func (core *coreService) ActionsByAddress(addr address.Address, start uint64, co
 	if count > core.cfg.RangeQueryLimit {
 		return nil, status.Error(codes.InvalidArgument, "range exceeds the limit")
 	}
-
-	actions, err := core.indexer.GetActionsByAddress(hash.BytesToHash160(addr.Bytes()), start, count)
-	if err != nil {
-		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
-			// no actions associated with address, return nil
-			return nil, nil
+	var actions [][]byte
+	if err := core.lookupIndex(core.indexer, func() (bool, error) {
+		var err error
+		actions, err = core.indexer.GetActionsByAddress(hash.BytesToHash160(addr.Bytes()), start, count)
+		switch errors.Cause(err) {
+		case nil:
+			return true, nil
+		case db.ErrBucketNotExist, db.ErrNotExist, db.ErrInvalid:
+			// no actions associated with address, or start is beyond them
+			return false, nil
+		default:
+			return false, status.Error(codes.NotFound, err.Error())
 		}
-		return nil, status.Error(codes.NotFound, err.Error())
+	}); err != nil {
+		return nil, err
+	}
+	if len(actions) == 0 {
+		return nil, nil
 	}
 
 	var res []*iotexapi.ActionInfo
@@ -1088,6 +1319,74 @@ func (core *coreService) ActionsByAddress(addr address.Address, start uint64, co
 	return res, nil
 }
 
+// TokenTransfersByAddress returns the token transfers from or to an address, of the token if it's not nil
+func (core *coreService) TokenTransfersByAddress(addr address.Address, token address.Address, start uint64, count uint64) ([]*blockindex.TokenTransfer, uint64, error) {
+	if err := core.checkTokenTransferQuery(count); err != nil {
+		return nil, 0, err
+	}
+	if err := core.waitForIndexerTip(core.ttIndexer); err != nil {
+		return nil, 0, err
+	}
+	transfers, total, err := core.ttIndexer.TransfersByAddress(addr, token, start, count)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return transfers, total, nil
+}
+
+// TokenTransfersByToken returns the transfers of a token
+func (core *coreService) TokenTransfersByToken(token address.Address, start uint64, count uint64) ([]*blockindex.TokenTransfer, uint64, error) {
+	if err := core.checkTokenTransferQuery(count); err != nil {
+		return nil, 0, err
+	}
+	if err := core.waitForIndexerTip(core.ttIndexer); err != nil {
+		return nil, 0, err
+	}
+	transfers, total, err := core.ttIndexer.TransfersByToken(token, start, count)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return transfers, total, nil
+}
+
+// InternalTransfersByAddress returns the internal transfers from or to an address
+func (core *coreService) InternalTransfersByAddress(addr address.Address, start uint64, count uint64) ([]*blockindex.InternalTransfer, uint64, error) {
+	if core.itIndexer == nil {
+		return nil, 0, status.Error(codes.Unavailable, blockindex.ErrInternalTransferIndexNA.Error())
+	}
+	if err := core.checkTransferQueryCount(count); err != nil {
+		return nil, 0, err
+	}
+	if err := core.waitForIndexerTip(core.itIndexer); err != nil {
+		return nil, 0, err
+	}
+	transfers, total, err := core.itIndexer.TransfersByAddress(addr, start, count)
+	if err != nil {
+		if errors.Cause(err) == blockindex.ErrInternalTransferIndexNA {
+			return nil, 0, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return transfers, total, nil
+}
+
+func (core *coreService) checkTokenTransferQuery(count uint64) error {
+	if core.ttIndexer == nil {
+		return status.Error(codes.Unavailable, blockindex.ErrTokenTransferIndexNA.Error())
+	}
+	return core.checkTransferQueryCount(count)
+}
+
+func (core *coreService) checkTransferQueryCount(count uint64) error {
+	if count == 0 {
+		return status.Error(codes.InvalidArgument, "count must be greater than zero")
+	}
+	if count > core.cfg.RangeQueryLimit {
+		return status.Error(codes.InvalidArgument, "range exceeds the limit")
+	}
+	return nil
+}
+
 // BlockHashByBlockHeight returns block hash by block height
 func (core *coreService) BlockHashByBlockHeight(blkHeight uint64) (hash.Hash256, error) {
 	return core.dao.GetBlockHash(blkHeight)
@@ -1098,14 +1397,13 @@ func (core *coreService) ActionByActionHash(h hash.Hash256) (*action.SealedEnvel
 	if err := core.checkActionIndex(); err != nil {
 		return nil, nil, 0, status.Error(codes.NotFound, blockindex.ErrActionIndexNA.Error())
 	}
-
-	actIndex, err := core.indexer.GetActionIndex(h[:])
+	actIndex, err := core.getActionIndex(h[:])
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(ErrNotFound, err.Error())
+		return nil, nil, 0, err
 	}
 	blk, err := core.dao.GetBlockByHeight(actIndex.BlockHeight())
 	if err != nil {
-		return nil, nil, 0, errors.Wrap(ErrNotFound, err.Error())
+		return nil, nil, 0, blockNotFoundError(err)
 	}
 	selp, index, err := blk.ActionByHash(h)
 	if err != nil {
@@ -1210,14 +1508,14 @@ func (core *coreService) getBlockByHeight(height uint64) (*apitypes.BlockWithRec
 	}
 	blk, err := core.dao.GetBlockByHeight(height)
 	if err != nil {
-		return nil, errors.Wrap(ErrNotFound, err.Error())
+		return nil, blockNotFoundError(err)
 	}
 	receipts := []*action.Receipt{}
 	if blk.Height() > 0 {
 		var err error
 		receipts, err = core.dao.GetReceipts(height)
 		if err != nil {
-			return nil, errors.Wrap(ErrNotFound, err.Error())
+			return nil, blockNotFoundError(err)
 		}
 	}
 	return &apitypes.BlockWithReceipts{
@@ -1252,12 +1550,12 @@ func (core *coreService) committedAction(selp *action.SealedEnvelope, blkHash ha
 	}
 	header, err := core.dao.Header(blkHash)
 	if err != nil {
-		return nil, err
+		return nil, prunedBlockError(err)
 	}
 	sender := selp.SenderAddress()
 	receipts, err := core.dao.GetReceipts(blkHeight)
 	if err != nil {
-		return nil, err
+		return nil, prunedBlockError(err)
 	}
 	receipt := filterReceipts(receipts, actHash)
 	if receipt == nil {
@@ -1375,6 +1673,9 @@ func (core *coreService) LogsInBlockByHash(filter *logfilter.LogFilter, blockHas
 }
 
 func (core *coreService) logsInBlock(filter *logfilter.LogFilter, blockNumber uint64) ([]*action.Log, error) {
+	if err := core.waitForIndexer(core.bfIndexer, blockNumber); err != nil {
+		return nil, err
+	}
 	logBloomFilter, err := core.bfIndexer.BlockFilterByHeight(blockNumber)
 	if err != nil {
 		return nil, err
@@ -1386,12 +1687,96 @@ func (core *coreService) logsInBlock(filter *logfilter.LogFilter, blockNumber ui
 
 	receipts, err := core.dao.GetReceipts(blockNumber)
 	if err != nil {
-		return nil, err
+		return nil, prunedBlockError(err)
 	}
 
 	return filter.MatchLogs(receipts), nil
 }
 
+// waitForIndexer waits for the indexer to reach the height if it is fed asynchronously
+func (core *coreService) waitForIndexer(indexer blockdao.BlockIndexer, height uint64) error {
+	ai, ok := core.asyncIndexers[indexer]
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), _asyncIndexerWaitTimeout)
+	defer cancel()
+	if err := ai.WaitForHeight(ctx, height); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return nil
+}
+
+// waitForIndexerTip waits for the indexer to reach the tip height if it is fed asynchronously, for the queries not
+// bound to a height, like looking up an action by hash
+func (core *coreService) waitForIndexerTip(indexer blockdao.BlockIndexer) error {
+	if _, ok := core.asyncIndexers[indexer]; !ok {
+		return nil
+	}
+	return core.waitForIndexer(indexer, core.bc.TipHeight())
+}
+
+// IndexerStatus returns the height and lag of the indexers fed asynchronously, sorted by name
+func (core *coreService) IndexerStatus() []*apitypes.IndexerStatus {
+	indexers := make([]*apitypes.IndexerStatus, 0, len(core.asyncIndexers))
+	for _, ai := range core.asyncIndexers {
+		height, _ := ai.Height()
+		indexers = append(indexers, &apitypes.IndexerStatus{
+			Name:   ai.Name(),
+			Height: height,
+			Lag:    ai.Lag(),
+		})
+	}
+	sort.Slice(indexers, func(i, j int) bool {
+		return indexers[i].Name < indexers[j].Name
+	})
+	return indexers
+}
+
+// lookupIndex runs the lookup on the indexer, which returns false if it misses. The lookup is run again after the
+// indexer reaches the tip height, only if it misses and the indexer is fed asynchronously
+func (core *coreService) lookupIndex(indexer blockdao.BlockIndexer, lookup func() (bool, error)) error {
+	hit, err := lookup()
+	if hit || err != nil {
+		return err
+	}
+	if _, ok := core.asyncIndexers[indexer]; !ok {
+		return nil
+	}
+	if err := core.waitForIndexerTip(indexer); err != nil {
+		return err
+	}
+	_, err = lookup()
+	return err
+}
+
+// getActionIndex looks up the index of the action by hash, ErrNotFound is returned if the action is not indexed
+func (core *coreService) getActionIndex(h []byte) (*blockindex.ActionIndex, error) {
+	var (
+		actIndex *blockindex.ActionIndex
+		notFound error
+	)
+	if err := core.lookupIndex(core.indexer, func() (bool, error) {
+		var err error
+		actIndex, err = core.indexer.GetActionIndex(h)
+		switch errors.Cause(err) {
+		case nil:
+			return true, nil
+		case db.ErrNotExist, db.ErrBucketNotExist:
+			notFound = errors.Wrap(ErrNotFound, err.Error())
+			return false, nil
+		default:
+			return false, status.Error(codes.Internal, err.Error())
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if actIndex == nil {
+		return nil, notFound
+	}
+	return actIndex, nil
+}
+
 // LogsInRange filter logs among [start, end] blocks
 func (core *coreService) LogsInRange(filter *logfilter.LogFilter, start, end, paginationSize uint64) ([]*action.Log, []hash.Hash256, error) {
 	start, end, err := core.correctQueryRange(start, end)
@@ -1404,6 +1789,9 @@ func (core *coreService) LogsInRange(filter *logfilter.LogFilter, start, end, pa
 	if paginationSize > 5000 {
 		paginationSize = 5000
 	}
+	if err := core.waitForIndexer(core.bfIndexer, end); err != nil {
+		return nil, nil, err
+	}
 	// getLogs via range Blooom filter [start, end]
 	blockNumbers, err := core.bfIndexer.FilterBlocksInRange(filter, start, end, paginationSize)
 	if err != nil {
@@ -1514,10 +1902,7 @@ func (core *coreService) EstimateExecutionGasConsumption(ctx context.Context, sc
 	sc.SetNonce(pendingNonce)
 	//gasprice should be 0, otherwise it may cause the API to return an error, such as insufficient balance.
 	sc.SetGasPrice(big.NewInt(0))
-	var (
-		g             = core.bc.Genesis()
-		blockGasLimit = g.BlockGasLimitByHeight(core.bc.TipHeight())
-	)
+	blockGasLimit := core.blockGasLimit(ctx, core.bc.TipHeight())
 	sc.SetGasLimit(blockGasLimit)
 	enough, receipt, err := core.isGasLimitEnough(ctx, callerAddr, sc)
 	if err != nil {
@@ -1557,6 +1942,15 @@ func (core *coreService) EstimateExecutionGasConsumption(ctx context.Context, sc
 	return estimatedGas, nil
 }
 
+// blockGasLimit returns the gas limit of the block at height, unless it's overridden in the simulation
+func (core *coreService) blockGasLimit(ctx context.Context, height uint64) uint64 {
+	if o, ok := evm.GetSimulationOverrideCtx(ctx); ok && o.Block != nil && o.Block.GasLimit != nil {
+		return *o.Block.GasLimit
+	}
+	g := core.bc.Genesis()
+	return g.BlockGasLimitByHeight(height)
+}
+
 func (core *coreService) isGasLimitEnough(
 	ctx context.Context,
 	caller address.Address,
@@ -1678,6 +2072,11 @@ func (core *coreService) ActionsInActPool(actHashes []string) ([]*action.SealedE
 	return ret, nil
 }
 
+// ActionsInActPoolBySender returns the pending and queued actions in the actpool, keyed by sender
+func (core *coreService) ActionsInActPoolBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope) {
+	return core.ap.ActionsBySender()
+}
+
 // Genesis returns the genesis of the chain
 func (core *coreService) Genesis() genesis.Genesis {
 	return core.bc.Genesis()
@@ -1702,6 +2101,25 @@ func (core *coreService) ReadContractStorage(ctx context.Context, addr address.A
 	return core.sf.ReadContractStorage(ctx, addr, key)
 }
 
+// ReadContractStorageAtHeight reads contract's storage at height
+func (core *coreService) ReadContractStorageAtHeight(ctx context.Context, addr address.Address, key []byte, height uint64) ([]byte, error) {
+	if height == core.bc.TipHeight() {
+		return core.ReadContractStorage(ctx, addr, key)
+	}
+	ctx, err := core.contextAtHeight(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: height,
+	}))
+	val, err := core.sf.ReadContractStorageAtHeight(ctx, height, addr, key)
+	if err != nil {
+		return nil, historyStateError(height, err)
+	}
+	return val, nil
+}
+
 func (core *coreService) ReceiveBlock(blk *block.Block) error {
 	core.readCache.Clear()
 	return core.chainListener.ReceiveBlock(blk)
@@ -1736,74 +2154,211 @@ func (core *coreService) SimulateExecution(ctx context.Context, addr address.Add
 	return core.simulateExecution(ctx, addr, exec, core.dao.GetBlockHash, core.getBlockTime)
 }
 
+// SimulateActions runs the actions in order on one working set on top of the state at height, and returns the return
+// value and receipt of each action, the states are discarded afterwards. A signed action failing the validation is not
+// run, and its error is returned instead
+func (core *coreService) SimulateActions(ctx context.Context, height uint64, acts []*action.SimulatedAction) ([][]byte, []*action.Receipt, []error, error) {
+	if len(acts) > core.cfg.BundleSizeLimit {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "bundle size %d exceeds the limit %d", len(acts), core.cfg.BundleSizeLimit)
+	}
+	var gas uint64
+	for _, act := range acts {
+		gas += act.Envelope().GasLimit()
+		if gas > core.cfg.BundleGasCap {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "total gas limit of the bundle exceeds the cap %d", core.cfg.BundleGasCap)
+		}
+	}
+	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
+	ctx, err := core.contextAtHeight(ctx, height)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx = evm.WithHelperCtx(ctx, evm.HelperContext{
+		GetBlockHash:   core.dao.GetBlockHash,
+		GetBlockTime:   core.getBlockTime,
+		DepositGasFunc: rewarding.DepositGasWithSGD,
+		Sgd:            core.sgdIndexer,
+	})
+	traces := make([]*logger.StructLogger, len(acts))
+	receipts, errs, err := core.sf.SimulateActions(protocol.WithFeatureWithHeightCtx(ctx), height, acts, func(ctx context.Context, i int, _ *action.SimulatedAction) context.Context {
+		// the tracer only captures the return value, the opcodes are not needed
+		traces[i] = logger.NewStructLogger(&logger.Config{
+			DisableStack:   true,
+			DisableStorage: true,
+			Limit:          1,
+		})
+		return protocol.WithVMConfigCtx(ctx, vm.Config{
+			Tracer: traces[i],
+		})
+	})
+	if err != nil {
+		if err = historyStateError(height, err); status.Code(err) == codes.Unavailable {
+			return nil, nil, nil, err
+		}
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	retvals := make([][]byte, len(acts))
+	for i := range traces {
+		if errs[i] != nil {
+			errs[i] = status.Error(codes.InvalidArgument, errs[i].Error())
+			continue
+		}
+		retvals[i] = traces[i].Output()
+	}
+	return retvals, receipts, errs, nil
+}
+
 // SyncingProgress returns the syncing status of node
 func (core *coreService) SyncingProgress() (uint64, uint64, uint64) {
 	startingHeight, currentHeight, targetHeight, _ := core.bs.SyncStatus()
 	return startingHeight, currentHeight, targetHeight
 }
 
-// TraceTransaction returns the trace result of transaction
+// StateProof returns the merkle proofs of the account and its storage slots at height
+func (core *coreService) StateProof(ctx context.Context, addr address.Address, storageKeys []hash.Hash256, height uint64) (*apitypes.AccountProof, error) {
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	stateRoot, proof, err := core.sf.ProofAtHeight(height, protocol.LegacyKeyOption(addrHash))
+	if err != nil {
+		return nil, err
+	}
+	sr, err := core.stateReaderAt(height)
+	if err != nil {
+		return nil, err
+	}
+	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
+	account, err := accountutil.AccountState(ctx, sr, addr)
+	if err != nil {
+		return nil, err
+	}
+	storageRoot, storageProofs, err := evm.StorageProof(sr, addrHash, account.Root, storageKeys)
+	if err != nil {
+		return nil, err
+	}
+	return &apitypes.AccountProof{
+		Account:       account,
+		StateRoot:     stateRoot,
+		Proof:         proof,
+		StorageRoot:   storageRoot,
+		StorageProofs: storageProofs,
+	}, nil
+}
+
+// stateReaderAt returns the reader of the state at height, which reads the archived state unless it's the tip height
+func (core *coreService) stateReaderAt(height uint64) (protocol.StateReader, error) {
+	tip, err := core.sf.Height()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if height > tip {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is higher than tip height %d", height, tip)
+	}
+	if height == tip {
+		return core.sf, nil
+	}
+	return factory.NewHistoryStateReader(core.sf, height), nil
+}
+
+// contextAtHeight returns the blockchain context whose tip is the block at height
+func (core *coreService) contextAtHeight(ctx context.Context, height uint64) (context.Context, error) {
+	ctx, err := core.bc.Context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bcCtx := protocol.MustGetBlockchainCtx(ctx)
+	if bcCtx.Tip.Height == height {
+		return ctx, nil
+	}
+	if bcCtx.Tip, err = core.tipInfoAtHeight(height); err != nil {
+		return nil, err
+	}
+	return protocol.WithBlockchainCtx(ctx, bcCtx), nil
+}
+
+// tipInfoAtHeight returns the tip info of the block at height
+func (core *coreService) tipInfoAtHeight(height uint64) (protocol.TipInfo, error) {
+	if height == 0 {
+		g := core.bc.Genesis()
+		return protocol.TipInfo{
+			Height:    0,
+			Hash:      g.Hash(),
+			Timestamp: time.Unix(g.Timestamp, 0),
+		}, nil
+	}
+	header, err := core.dao.HeaderByHeight(height)
+	if err != nil {
+		return protocol.TipInfo{}, err
+	}
+	return protocol.TipInfo{
+		Height:    height,
+		GasUsed:   header.GasUsed(),
+		Hash:      header.HashBlock(),
+		Timestamp: header.Timestamp(),
+		BaseFee:   header.BaseFee(),
+	}, nil
+}
+
+// historyStateError converts the error of reading a past state which the node does not keep, like the missing trie
+// node error of an ethereum full node
+func historyStateError(height uint64, err error) error {
+	switch errors.Cause(err) {
+	case factory.ErrNoArchiveData, factory.ErrNotSupported, db.ErrNotExist:
+		return status.Errorf(codes.Unavailable, "missing trie node: state at height %d is not available, archive mode is required", height)
+	default:
+		return err
+	}
+}
+
+// prunedBlockError converts the error of reading a block which has been pruned from the block store, to tell it from
+// a block which does not exist
+func prunedBlockError(err error) error {
+	if errors.Cause(err) == filedao.ErrBlockPruned {
+		return status.Errorf(codes.NotFound, "pruned history unavailable: %s", err.Error())
+	}
+	return err
+}
+
+// blockNotFoundError wraps the error of reading a block into ErrNotFound, unless the block has been pruned
+func blockNotFoundError(err error) error {
+	if errors.Cause(err) == filedao.ErrBlockPruned {
+		return prunedBlockError(err)
+	}
+	return errors.Wrap(ErrNotFound, err.Error())
+}
+
+// TraceTransaction replays the block of the transaction on the state of its parent block, and returns the trace
+// result of the transaction
 func (core *coreService) TraceTransaction(ctx context.Context, actHash string, config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error) {
-	actInfo, err := core.Action(util.Remove0xPrefix(actHash), false)
+	h, err := hash.HexStringToHash256(util.Remove0xPrefix(actHash))
+	if err != nil {
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	_, blk, index, err := core.ActionByActionHash(h)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	act, err := (&action.Deserializer{}).SetEvmNetworkID(core.EVMNetworkID()).ActionToSealedEnvelope(actInfo.Action)
+	receipts, traces, err := core.traceBlock(ctx, blk, config, int(index))
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	sc, ok := act.Action().(*action.Execution)
-	if !ok {
-		return nil, nil, nil, errors.New("the type of action is not supported")
+	var retval []byte
+	if tracer, ok := traces[index].(*logger.StructLogger); ok {
+		retval = tracer.Output()
 	}
-	addr, _ := address.FromString(address.ZeroAddress)
-	retval, receipt, tracer, err := core.traceTx(ctx, new(tracers.Context), config, func(ctx context.Context) ([]byte, *action.Receipt, error) {
-
-		return core.simulateExecution(ctx, addr, sc, core.dao.GetBlockHash, core.getBlockTime)
-	})
-	return retval, receipt, tracer, err
+	return retval, receipts[index], traces[index], nil
 }
 
-// TraceCall returns the trace result of call
+// TraceCall returns the trace result of call on the state at height
 func (core *coreService) TraceCall(ctx context.Context,
 	callerAddr address.Address,
-	blkNumOrHash any,
+	height uint64,
 	contractAddress string,
-	nonce uint64,
 	amount *big.Int,
 	gasLimit uint64,
 	data []byte,
 	config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error) {
-	var (
-		g             = core.bc.Genesis()
-		blockGasLimit = g.BlockGasLimitByHeight(core.bc.TipHeight())
-	)
-	if gasLimit == 0 {
-		gasLimit = blockGasLimit
-	}
-	ctx, err := core.bc.Context(ctx)
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	if nonce == 0 {
-		state, err := accountutil.AccountState(ctx, core.sf, callerAddr)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
-			BlockHeight: core.bc.TipHeight(),
-		}))
-		var pendingNonce uint64
-		if protocol.MustGetFeatureCtx(ctx).RefactorFreshAccountConversion {
-			pendingNonce = state.PendingNonceConsideringFreshAccount()
-		} else {
-			pendingNonce = state.PendingNonce()
-		}
-		nonce = pendingNonce
-	}
 	exec, err := action.NewExecution(
 		contractAddress,
-		nonce,
+		0,
 		amount,
 		gasLimit,
 		big.NewInt(0),
@@ -1813,11 +2368,87 @@ func (core *coreService) TraceCall(ctx context.Context,
 		return nil, nil, nil, err
 	}
 	retval, receipt, tracer, err := core.traceTx(ctx, new(tracers.Context), config, func(ctx context.Context) ([]byte, *action.Receipt, error) {
-		return core.simulateExecution(ctx, callerAddr, exec, core.dao.GetBlockHash, core.getBlockTime)
+		// the nonce and gas limit of the call are set the same way as eth_call
+		return core.simulateCall(ctx, callerAddr, exec, height)
 	})
 	return retval, receipt, tracer, err
 }
 
+// TraceBlock replays all actions of the block on the state of its parent block, and returns the receipt and tracer of each action
+func (core *coreService) TraceBlock(ctx context.Context, blk *block.Block, config *tracers.TraceConfig) ([]*action.Receipt, []any, error) {
+	return core.traceBlock(ctx, blk, config, -1)
+}
+
+// traceBlock replays all actions of the block on the state of its parent block, only the action at index is traced
+// if index is not negative, the tracers of the other actions are nil
+func (core *coreService) traceBlock(ctx context.Context, blk *block.Block, config *tracers.TraceConfig, index int) ([]*action.Receipt, []any, error) {
+	height := blk.Height()
+	if height == 0 {
+		return nil, nil, errors.Wrap(errInvalidFormat, "genesis block is not traceable")
+	}
+	parent, err := core.tipInfoAtHeight(height - 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		blkHash = blk.HashBlock()
+		acts    = blk.RunnableActions().Actions()
+		traces  = make([]vm.EVMLogger, len(acts))
+		timeout time.Duration
+		cancels = make([]context.CancelFunc, 0, len(acts))
+	)
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+	for i, selp := range acts {
+		if index >= 0 && i != index {
+			continue
+		}
+		actHash, err := selp.Hash()
+		if err != nil {
+			return nil, nil, err
+		}
+		if traces[i], timeout, err = newTracer(&tracers.Context{
+			BlockHash:   common.Hash(blkHash),
+			BlockNumber: new(big.Int).SetUint64(height),
+			TxIndex:     i,
+			TxHash:      common.Hash(actHash),
+		}, config); err != nil {
+			return nil, nil, err
+		}
+	}
+	ctx = genesis.WithGenesisContext(
+		protocol.WithBlockchainCtx(ctx, protocol.BlockchainCtx{
+			Tip:          parent,
+			ChainID:      core.bc.ChainID(),
+			EvmNetworkID: core.bc.EvmNetworkID(),
+		}),
+		core.bc.Genesis(),
+	)
+	receipts, err := core.sf.ReplayBlock(protocol.WithFeatureWithHeightCtx(ctx), blk, func(ctx context.Context, i int, _ *action.SealedEnvelope) context.Context {
+		if traces[i] == nil {
+			return ctx
+		}
+		// the timer of each tracer starts right before its action is replayed
+		cancels = append(cancels, stopTracerOnTimeout(ctx, traces[i], timeout))
+		return protocol.WithVMConfigCtx(ctx, vm.Config{
+			Tracer: traces[i],
+		})
+	})
+	if err != nil {
+		return nil, nil, historyStateError(height-1, err)
+	}
+	results := make([]any, len(traces))
+	for i := range traces {
+		if traces[i] != nil {
+			results[i] = traces[i]
+		}
+	}
+	return receipts, results, nil
+}
+
 // Track tracks the api call
 func (core *coreService) Track(ctx context.Context, start time.Time, method string, size int64, success bool) {
 	if core.apiStats == nil {
@@ -1832,47 +2463,75 @@ func (core *coreService) Track(ctx context.Context, start time.Time, method stri
 }
 
 func (core *coreService) traceTx(ctx context.Context, txctx *tracers.Context, config *tracers.TraceConfig, simulateFn func(ctx context.Context) ([]byte, *action.Receipt, error)) ([]byte, *action.Receipt, any, error) {
-	var (
-		tracer vm.EVMLogger
-		err    error
-	)
+	tracer, timeout, err := newTracer(txctx, config)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cancel := stopTracerOnTimeout(ctx, tracer, timeout)
+	defer cancel()
+	ctx = protocol.WithVMConfigCtx(ctx, vm.Config{
+		Tracer:    tracer,
+		NoBaseFee: true,
+	})
+	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{})
+	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
+	ctx = protocol.WithBlockchainCtx(protocol.WithFeatureCtx(ctx), protocol.BlockchainCtx{})
+	retval, receipt, err := simulateFn(ctx)
+	return retval, receipt, tracer, err
+}
+
+// newTracer creates the tracer and its timeout by the trace config, the struct logger is used by default
+func newTracer(txctx *tracers.Context, config *tracers.TraceConfig) (vm.EVMLogger, time.Duration, error) {
 	switch {
 	case config == nil:
-		tracer = logger.NewStructLogger(nil)
+		return logger.NewStructLogger(nil), 0, nil
 	case config.Tracer != nil:
 		// Define a meaningful timeout of a single transaction trace
-		timeout := defaultTraceTimeout
+		var (
+			timeout = defaultTraceTimeout
+			err     error
+		)
 		if config.Timeout != nil {
 			if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-				return nil, nil, nil, err
+				return nil, 0, err
 			}
 		}
 		t, err := tracers.DefaultDirectory.New(*config.Tracer, txctx, config.TracerConfig)
 		if err != nil {
-			return nil, nil, nil, err
+			return nil, 0, err
 		}
-		deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
-		defer cancel()
-		go func() {
-			<-deadlineCtx.Done()
-			if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-				t.Stop(errors.New("execution timeout"))
-			}
-		}()
-		tracer = t
-
+		return t, timeout, nil
 	default:
-		tracer = logger.NewStructLogger(config.Config)
+		return logger.NewStructLogger(config.Config), 0, nil
 	}
-	ctx = protocol.WithVMConfigCtx(ctx, vm.Config{
-		Tracer:    tracer,
-		NoBaseFee: true,
-	})
-	ctx = protocol.WithBlockCtx(ctx, protocol.BlockCtx{})
-	ctx = genesis.WithGenesisContext(ctx, core.bc.Genesis())
-	ctx = protocol.WithBlockchainCtx(protocol.WithFeatureCtx(ctx), protocol.BlockchainCtx{})
-	retval, receipt, err := simulateFn(ctx)
-	return retval, receipt, tracer, err
+}
+
+// accessListTracer collects the addresses and slots touched by a call, the inner tracer is created when the call
+// starts, so that the sender, the recipient and the precompiles active in the block are excluded
+type accessListTracer struct {
+	*logger.AccessListTracer
+	list types.AccessList
+}
+
+func (t *accessListTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil, env.Context.Time)
+	t.AccessListTracer = logger.NewAccessListTracer(t.list, from, to, vm.ActivePrecompiles(rules))
+}
+
+// stopTracerOnTimeout stops the tracer if it doesn't finish within timeout, the returned cancel func releases the timer
+func stopTracerOnTimeout(ctx context.Context, tracer vm.EVMLogger, timeout time.Duration) context.CancelFunc {
+	t, ok := tracer.(tracers.Tracer)
+	if !ok || timeout == 0 {
+		return func() {}
+	}
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			t.Stop(errors.New("execution timeout"))
+		}
+	}()
+	return cancel
 }
 
 func (core *coreService) simulateExecution(ctx context.Context, addr address.Address, exec *action.Execution, getBlockHash evm.GetBlockHash, getBlockTime evm.GetBlockTime) ([]byte, *action.Receipt, error) {
@@ -1885,6 +2544,16 @@ func (core *coreService) simulateExecution(ctx context.Context, addr address.Add
 	return core.sf.SimulateExecution(ctx, addr, exec)
 }
 
+func (core *coreService) simulateExecutionAtHeight(ctx context.Context, height uint64, addr address.Address, exec *action.Execution) ([]byte, *action.Receipt, error) {
+	ctx = evm.WithHelperCtx(ctx, evm.HelperContext{
+		GetBlockHash:   core.dao.GetBlockHash,
+		GetBlockTime:   core.getBlockTime,
+		DepositGasFunc: rewarding.DepositGasWithSGD,
+		Sgd:            core.sgdIndexer,
+	})
+	return core.sf.SimulateExecutionAtHeight(ctx, height, addr, exec)
+}
+
 func filterReceipts(receipts []*action.Receipt, actHash hash.Hash256) *action.Receipt {
 	for _, r := range receipts {
 		if r.ActionHash == actHash {
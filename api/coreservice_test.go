@@ -8,17 +8,22 @@ package api
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"strconv"
 	"testing"
 	"time"
 
 	. "github.com/agiledragon/gomonkey/v2"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -30,6 +35,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/api/logfilter"
+	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/blockdao"
@@ -37,8 +43,12 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
+	"github.com/iotexproject/iotex-core/pkg/unit"
 	"github.com/iotexproject/iotex-core/server/itx/nodestats"
 	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	mock_apitypes "github.com/iotexproject/iotex-core/test/mock/mock_apiresponder"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
@@ -442,6 +452,16 @@ func TestTransactionLogByBlockHeight(t *testing.T) {
 			_, _, err := cs.TransactionLogByBlockHeight(uint64(1))
 			require.ErrorContains(err, t.Name())
 		})
+
+		t.Run("BlockPruned", func(t *testing.T) {
+			blkDAO.EXPECT().ContainsTransactionLog().Return(true).Times(1)
+			blkDAO.EXPECT().Height().Return(uint64(2), nil).Times(1)
+			blkDAO.EXPECT().GetBlockHash(gomock.Any()).Return(hash.Hash256{}, errors.Wrap(filedao.ErrBlockPruned, t.Name())).Times(1)
+
+			_, _, err := cs.TransactionLogByBlockHeight(uint64(1))
+			require.Equal(codes.NotFound, status.Code(err))
+			require.ErrorContains(err, "pruned history unavailable")
+		})
 	})
 
 	t.Run("FailedToTransactionLogs", func(t *testing.T) {
@@ -468,6 +488,72 @@ func TestTransactionLogByBlockHeight(t *testing.T) {
 	})
 }
 
+func TestBlockByHeightPruned(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bc     = mock_blockchain.NewMockBlockchain(ctrl)
+		blkDAO = mock_blockdao.NewMockBlockDAO(ctrl)
+		cs     = &coreService{bc: bc, dao: blkDAO}
+	)
+	bc.EXPECT().TipHeight().Return(uint64(10)).Times(2)
+
+	blkDAO.EXPECT().GetBlockByHeight(uint64(1)).Return(nil, db.ErrNotExist).Times(1)
+	_, err := cs.BlockByHeight(1)
+	require.ErrorIs(err, ErrNotFound)
+
+	// a pruned block is not reported as a block that does not exist
+	blkDAO.EXPECT().GetBlockByHeight(uint64(2)).Return(nil, errors.Wrap(filedao.ErrBlockPruned, "block 2 is below the lowest available height 5")).Times(1)
+	_, err = cs.BlockByHeight(2)
+	require.NotErrorIs(err, ErrNotFound)
+	require.Equal(codes.NotFound, status.Code(err))
+	require.ErrorContains(err, "pruned history unavailable: block 2 is below the lowest available height 5")
+}
+
+func TestWaitForIndexer(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		bc        = mock_blockchain.NewMockBlockchain(ctrl)
+		indexer   = mock_blockindex.NewMockIndexer(ctrl)
+		bfIndexer = mock_blockindex.NewMockBloomFilterIndexer(ctrl)
+		cs        = &coreService{bc: bc, indexer: indexer, bfIndexer: bfIndexer}
+	)
+	indexer.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+	indexer.EXPECT().Height().Return(uint64(5), nil).Times(1)
+	ai := blockdao.NewAsyncIndexer("test", indexer, blockdao.DefaultAsyncIndexerConfig)
+	require.NoError(ai.Start(context.Background()))
+	WithAsyncIndexers(ai)(cs)
+
+	require.Equal([]*apitypes.IndexerStatus{{Name: "test", Height: 5, Lag: 0}}, cs.IndexerStatus())
+
+	// the indexer fed synchronously is not waited for
+	require.NoError(cs.waitForIndexer(bfIndexer, 10))
+	require.NoError(cs.waitForIndexer(indexer, 5))
+	bc.EXPECT().TipHeight().Return(uint64(5)).Times(1)
+	require.NoError(cs.waitForIndexerTip(indexer))
+	// the async indexer behind the tip
+	bc.EXPECT().TipHeight().Return(uint64(6)).Times(1)
+	err := cs.waitForIndexerTip(indexer)
+	require.Equal(codes.Unavailable, status.Code(err))
+	require.ErrorContains(err, "indexer test is at height 5, behind height 6")
+
+	// the lookup hitting the index doesn't wait, while a miss is looked up again once the indexer reaches the tip
+	actHash := hash.Hash256b([]byte("action"))
+	indexer.EXPECT().GetActionIndex(actHash[:]).Return(&blockindex.ActionIndex{}, nil).Times(1)
+	actIndex, err := cs.getActionIndex(actHash[:])
+	require.NoError(err)
+	require.NotNil(actIndex)
+	bc.EXPECT().TipHeight().Return(uint64(5)).Times(1)
+	indexer.EXPECT().GetActionIndex(actHash[:]).Return(nil, db.ErrNotExist).Times(2)
+	_, err = cs.getActionIndex(actHash[:])
+	require.ErrorIs(err, ErrNotFound)
+}
+
 func TestEstimateExecutionGasConsumption(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
@@ -618,23 +704,32 @@ func TestEstimateExecutionGasConsumption(t *testing.T) {
 
 func TestTraceTransaction(t *testing.T) {
 	require := require.New(t)
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	svr, bc, _, ap, cleanCallback := setupTestCoreService()
-	defer cleanCallback()
+	cfg := newConfig()
+	cfg.chain.EnableArchiveMode = true
+	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+	require.NoError(err)
+	defer testutil.CleanupPath(bfIndexFile)
 	ctx := context.Background()
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingBlocks(bc, ap))
+	svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+	require.NoError(err)
+
 	tsf, err := action.SignedExecution(identityset.Address(29).String(),
 		identityset.PrivateKey(29), 1, big.NewInt(0), testutil.TestGasLimit,
 		big.NewInt(testutil.TestGasPriceInt64), []byte{})
 	require.NoError(err)
 	tsfhash, err := tsf.Hash()
-
-	blk1Time := testutil.TimestampNow()
+	require.NoError(err)
 	require.NoError(ap.Add(ctx, tsf))
-	blk, err := bc.MintNewBlock(blk1Time)
+	blk, err := bc.MintNewBlock(testutil.TimestampNow())
 	require.NoError(err)
 	require.NoError(bc.CommitBlock(blk))
-	cfg := &tracers.TraceConfig{
+
+	traceCfg := &tracers.TraceConfig{
 		Config: &logger.Config{
 			EnableMemory:     true,
 			DisableStack:     false,
@@ -642,13 +737,32 @@ func TestTraceTransaction(t *testing.T) {
 			EnableReturnData: true,
 		},
 	}
-	retval, receipt, traces, err := svr.TraceTransaction(ctx, hex.EncodeToString(tsfhash[:]), cfg)
+	retval, receipt, traces, err := svr.TraceTransaction(ctx, hex.EncodeToString(tsfhash[:]), traceCfg)
 	require.NoError(err)
 	require.Equal("0x", byteToHex(retval))
+	require.Equal(tsfhash, receipt.ActionHash)
 	require.Equal(uint64(1), receipt.Status)
 	require.Equal(uint64(0x2710), receipt.GasConsumed)
 	require.Empty(receipt.ExecutionRevertMsg())
 	require.Equal(0, len(traces.(*logger.StructLogger).StructLogs()))
+
+	// every action is traced at its index in the block
+	for height := uint64(1); height <= bc.TipHeight(); height++ {
+		blk, err := dao.GetBlockByHeight(height)
+		require.NoError(err)
+		expected, err := dao.GetReceipts(height)
+		require.NoError(err)
+		for i := range expected {
+			_, receipt, _, err := svr.TraceTransaction(ctx, hex.EncodeToString(expected[i].ActionHash[:]), nil)
+			require.NoError(err)
+			require.Equal(expected[i].ActionHash, receipt.ActionHash)
+			require.Equal(expected[i].Status, receipt.Status)
+			require.Equal(expected[i].GasConsumed, receipt.GasConsumed)
+		}
+		require.Len(expected, len(blk.Actions))
+	}
+	_, _, _, err = svr.TraceTransaction(ctx, hex.EncodeToString(hash.ZeroHash256[:]), traceCfg)
+	require.ErrorIs(err, ErrNotFound)
 }
 
 func TestTraceCall(t *testing.T) {
@@ -679,7 +793,7 @@ func TestTraceCall(t *testing.T) {
 	retval, receipt, traces, err := svr.TraceCall(ctx,
 		identityset.Address(29), blk.Height(),
 		identityset.Address(29).String(),
-		0, big.NewInt(0), testutil.TestGasLimit,
+		big.NewInt(0), testutil.TestGasLimit,
 		[]byte{}, cfg)
 	require.NoError(err)
 	require.Equal("0x", byteToHex(retval))
@@ -689,6 +803,356 @@ func TestTraceCall(t *testing.T) {
 	require.Equal(0, len(traces.(*logger.StructLogger).StructLogs()))
 }
 
+func TestTraceBlock(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.chain.EnableArchiveMode = true
+	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+	require.NoError(err)
+	defer testutil.CleanupPath(bfIndexFile)
+	ctx := context.Background()
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingBlocks(bc, ap))
+	svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+	require.NoError(err)
+
+	_, _, err = svr.TraceBlock(ctx, &block.Block{}, nil)
+	require.ErrorContains(err, "genesis block is not traceable")
+	for height := uint64(1); height <= bc.TipHeight(); height++ {
+		blk, err := dao.GetBlockByHeight(height)
+		require.NoError(err)
+		expected, err := dao.GetReceipts(height)
+		require.NoError(err)
+		receipts, traces, err := svr.TraceBlock(ctx, blk, nil)
+		require.NoError(err)
+		require.Len(receipts, len(blk.Actions))
+		require.Len(traces, len(blk.Actions))
+		for i := range receipts {
+			require.Equal(expected[i].ActionHash, receipts[i].ActionHash)
+			require.Equal(expected[i].Status, receipts[i].Status)
+			require.Equal(expected[i].GasConsumed, receipts[i].GasConsumed)
+			_, ok := traces[i].(*logger.StructLogger)
+			require.True(ok)
+		}
+	}
+}
+
+func TestStateProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.chain.EnableArchiveMode = true
+	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+	require.NoError(err)
+	defer testutil.CleanupPath(bfIndexFile)
+	ctx := context.Background()
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingBlocks(bc, ap))
+	svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+	require.NoError(err)
+
+	layerOneKey := hash.Hash160b([]byte(factory.AccountKVNamespace))
+	slot := hash.BytesToHash256([]byte{1})
+	absent := hash.Hash160b([]byte("absent"))
+	absentAddr, err := address.FromBytes(absent[:])
+	require.NoError(err)
+	for _, height := range []uint64{1, bc.TipHeight()} {
+		for _, addr := range []address.Address{identityset.Address(28), absentAddr} {
+			expected, err := accountutil.AccountState(genesis.WithGenesisContext(ctx, bc.Genesis()), factory.NewHistoryStateReader(sf, height), addr)
+			require.NoError(err)
+			proof, err := svr.StateProof(ctx, addr, []hash.Hash256{slot}, height)
+			require.NoError(err)
+			require.Equal(expected.Balance, proof.Account.Balance)
+			layerTwoKey := hash.Hash160b(addr.Bytes())
+			value, err := mptrie.VerifyTwoLayerProof(proof.StateRoot, layerOneKey[:], layerTwoKey[:], proof.Proof)
+			if addr == absentAddr {
+				require.Equal(trie.ErrNotExist, errors.Cause(err))
+				require.Zero(expected.Balance.Sign())
+			} else {
+				require.NoError(err)
+				acct := &state.Account{}
+				require.NoError(acct.Deserialize(value))
+				require.Equal(expected.Balance, acct.Balance)
+			}
+			// storage of an account without code is empty
+			require.Len(proof.StorageProofs, 1)
+			require.Nil(proof.StorageProofs[0].Value)
+			_, err = mptrie.VerifyProof(evm.StorageHashFunc(hash.BytesToHash160(addr.Bytes())), proof.StorageRoot, slot[:], proof.StorageProofs[0].Proof)
+			require.Equal(trie.ErrNotExist, errors.Cause(err))
+		}
+	}
+}
+
+func TestStateAtHeight(t *testing.T) {
+	for _, archive := range []bool{true, false} {
+		t.Run(fmt.Sprintf("archive=%v", archive), func(t *testing.T) {
+			require := require.New(t)
+			cfg := newConfig()
+			cfg.chain.EnableArchiveMode = archive
+			bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+			require.NoError(err)
+			defer testutil.CleanupPath(bfIndexFile)
+			ctx := context.Background()
+			require.NoError(bc.Start(ctx))
+			defer func() {
+				require.NoError(bc.Stop(ctx))
+			}()
+			require.NoError(addTestingBlocks(bc, ap))
+			svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+			require.NoError(err)
+
+			addr := identityset.Address(28)
+			tip := bc.TipHeight()
+			_, err = svr.AccountAtHeight(ctx, addr, tip+1)
+			require.Equal(codes.InvalidArgument, status.Code(err))
+			for _, height := range []uint64{1, tip} {
+				exec, err := action.NewExecution(identityset.Address(29).String(), 0, big.NewInt(0), 0, big.NewInt(0), nil)
+				require.NoError(err)
+				if !archive && height != tip {
+					_, err = svr.AccountAtHeight(ctx, addr, height)
+					require.Equal(codes.Unavailable, status.Code(err))
+					require.ErrorContains(err, "missing trie node")
+					_, _, err = svr.ReadContractAtHeight(ctx, addr, exec, height)
+					require.Equal(codes.Unavailable, status.Code(err))
+					_, err = svr.ReadContractStorageAtHeight(ctx, addr, []byte{1}, height)
+					require.Equal(codes.Unavailable, status.Code(err))
+					continue
+				}
+				var sr protocol.StateReader = sf
+				if height != tip {
+					sr = factory.NewHistoryStateReader(sf, height)
+				}
+				expected, err := accountutil.AccountState(genesis.WithGenesisContext(ctx, bc.Genesis()), sr, addr)
+				require.NoError(err)
+				meta, err := svr.AccountAtHeight(ctx, addr, height)
+				require.NoError(err)
+				require.Equal(expected.Balance.String(), meta.Balance)
+				require.False(meta.IsContract)
+				_, receipt, err := svr.ReadContractAtHeight(ctx, addr, exec, height)
+				require.NoError(err)
+				require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+			}
+		})
+	}
+}
+
+func TestSimulationOverride(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+	require.NoError(err)
+	defer testutil.CleanupPath(bfIndexFile)
+	ctx := context.Background()
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingBlocks(bc, ap))
+	svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+	require.NoError(err)
+
+	var (
+		caller   = identityset.Address(28)
+		contract = identityset.Address(29)
+		evmAddr  = common.BytesToAddress(contract.Bytes())
+		slot     = common.BigToHash(big.NewInt(0))
+		number   = uint64(1000)
+		// returns sload(0), number and balance(address)
+		code = common.FromHex("60005460005243602052303160405260606000f3")
+	)
+	call := func(ctx context.Context) string {
+		exec, err := action.NewExecution(contract.String(), 0, big.NewInt(0), 0, big.NewInt(0), nil)
+		require.NoError(err)
+		ret, _, err := svr.ReadContract(ctx, caller, exec)
+		require.NoError(err)
+		return ret
+	}
+	require.Empty(call(ctx))
+	ret := call(evm.WithSimulationOverrideCtx(ctx, &evm.SimulationOverride{
+		State: evm.StateOverride{
+			evmAddr: {
+				Balance:   big.NewInt(7),
+				Code:      code,
+				StateDiff: map[common.Hash]common.Hash{slot: common.BigToHash(big.NewInt(42))},
+			},
+		},
+		Block: &evm.BlockOverride{Number: &number},
+	}))
+	require.Equal(fmt.Sprintf("%064x%064x%064x", 42, number, 7), ret)
+
+	// the overrides are neither persisted nor cached
+	require.Empty(call(ctx))
+	meta, err := svr.AccountAtHeight(ctx, contract, bc.TipHeight())
+	require.NoError(err)
+	require.False(meta.IsContract)
+
+	// both state and stateDiff are overridden
+	exec, err := action.NewExecution(contract.String(), 0, big.NewInt(0), 0, big.NewInt(0), nil)
+	require.NoError(err)
+	_, _, err = svr.ReadContract(evm.WithSimulationOverrideCtx(ctx, &evm.SimulationOverride{
+		State: evm.StateOverride{
+			evmAddr: {
+				State:     map[common.Hash]common.Hash{},
+				StateDiff: map[common.Hash]common.Hash{},
+			},
+		},
+	}), caller, exec)
+	require.ErrorContains(err, "both state and stateDiff")
+}
+
+func TestCreateAccessList(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+	require.NoError(err)
+	defer testutil.CleanupPath(bfIndexFile)
+	ctx := context.Background()
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingBlocks(bc, ap))
+	svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+	require.NoError(err)
+
+	var (
+		caller   = identityset.Address(28)
+		contract = identityset.Address(29)
+		other    = common.BytesToAddress(identityset.Address(30).Bytes())
+		evmAddr  = common.BytesToAddress(contract.Bytes())
+		// sload(1), balance(other), balance(0x01) and balance(caller)
+		code = common.FromHex("6001545073" + hex.EncodeToString(other.Bytes()) + "315060013150333150" + "00")
+	)
+	overrideCtx := evm.WithSimulationOverrideCtx(ctx, &evm.SimulationOverride{
+		State: evm.StateOverride{
+			evmAddr: {Code: code},
+		},
+	})
+	exec, err := action.NewExecution(contract.String(), 0, big.NewInt(0), 0, big.NewInt(0), nil)
+	require.NoError(err)
+	list, receipt, err := svr.CreateAccessList(overrideCtx, caller, exec, bc.TipHeight())
+	require.NoError(err)
+	require.Equal(uint64(iotextypes.ReceiptStatus_Success), receipt.Status)
+	// the sender and the precompile are excluded, the recipient is only listed for its slot
+	require.ElementsMatch(ethtypes.AccessList{
+		{Address: evmAddr, StorageKeys: []common.Hash{common.BigToHash(big.NewInt(1))}},
+		{Address: other, StorageKeys: []common.Hash{}},
+	}, list)
+
+	// the given access list is extended
+	given := common.BytesToAddress(identityset.Address(31).Bytes())
+	exec, err = action.NewExecutionWithAccessList(contract.String(), 0, big.NewInt(0), 0, big.NewInt(0), nil, ethtypes.AccessList{{Address: given}})
+	require.NoError(err)
+	list, _, err = svr.CreateAccessList(overrideCtx, caller, exec, bc.TipHeight())
+	require.NoError(err)
+	require.Len(list, 3)
+}
+
+func TestSimulateActions(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	bc, dao, indexer, bfIndexer, sf, ap, registry, bfIndexFile, err := setupChain(cfg)
+	require.NoError(err)
+	defer testutil.CleanupPath(bfIndexFile)
+	ctx := context.Background()
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addTestingBlocks(bc, ap))
+	svr, err := newCoreService(cfg.api, bc, nil, sf, dao, indexer, bfIndexer, ap, registry, func(u uint64) (time.Time, error) { return time.Time{}, nil })
+	require.NoError(err)
+
+	var (
+		producer  = identityset.Address(27)
+		freshKey  = hash.Hash160b([]byte("fresh"))
+		fresh, _  = address.FromBytes(freshKey[:])
+		recipient = identityset.Address(33)
+		gasLimit  = uint64(1000000)
+		// deploys a contract whose runtime code is a single JUMPDEST
+		initCode = common.FromHex("6001600c60003960016000f35b")
+	)
+	builder := func() *action.EnvelopeBuilder {
+		return (&action.EnvelopeBuilder{}).SetGasLimit(gasLimit).SetGasPrice(big.NewInt(0)).SetChainID(bc.ChainID())
+	}
+	tsf1, err := action.NewTransfer(0, big.NewInt(10), fresh.String(), nil, 0, big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := action.NewTransfer(0, big.NewInt(10), recipient.String(), nil, 0, big.NewInt(0))
+	require.NoError(err)
+	deploy, err := action.NewExecution("", 0, big.NewInt(0), 0, big.NewInt(0), initCode)
+	require.NoError(err)
+	nonce, err := svr.PendingNonce(producer)
+	require.NoError(err)
+	// the signed action follows the unsigned call of the same sender
+	selp, err := action.SignedTransfer(recipient.String(), identityset.PrivateKey(27), nonce+1, big.NewInt(1), nil, gasLimit, big.NewInt(0))
+	require.NoError(err)
+	// the signed actions failing the validation are not run
+	nonceTooHigh, err := action.SignedTransfer(recipient.String(), identityset.PrivateKey(27), nonce+5, big.NewInt(1), nil, gasLimit, big.NewInt(0))
+	require.NoError(err)
+	overspent, err := action.SignedTransfer(producer.String(), identityset.PrivateKey(33), 0, unit.ConvertIotxToRau(1e10), nil, gasLimit, big.NewInt(0))
+	require.NoError(err)
+
+	retvals, receipts, errs, err := svr.SimulateActions(ctx, bc.TipHeight(), []*action.SimulatedAction{
+		action.NewSimulatedCall(producer, builder().SetAction(tsf1).Build()),
+		action.NewSimulatedCall(fresh, builder().SetAction(tsf2).Build()),
+		action.NewSimulatedSealedAction(selp),
+		action.NewSimulatedSealedAction(nonceTooHigh),
+		action.NewSimulatedSealedAction(overspent),
+		action.NewSimulatedCall(producer, builder().SetAction(deploy).Build()),
+	})
+	require.NoError(err)
+	require.Len(receipts, 6)
+	require.Len(errs, 6)
+	for i, r := range receipts {
+		if i == 3 || i == 4 {
+			require.Nil(r)
+			require.Equal(codes.InvalidArgument, status.Code(errs[i]))
+			continue
+		}
+		require.NoError(errs[i])
+		require.Equal(uint64(iotextypes.ReceiptStatus_Success), r.Status)
+	}
+	require.Contains(errs[3].Error(), action.ErrNonceTooHigh.Error())
+	require.Contains(errs[4].Error(), action.ErrInsufficientFunds.Error())
+	selpHash, err := selp.Hash()
+	require.NoError(err)
+	require.Equal(selpHash, receipts[2].ActionHash)
+	require.Equal([]byte{0x5b}, retvals[5])
+
+	// the states are discarded
+	meta, _, err := svr.Account(fresh)
+	require.NoError(err)
+	require.Equal("0", meta.Balance)
+	pendingNonce, err := svr.PendingNonce(producer)
+	require.NoError(err)
+	require.Equal(nonce, pendingNonce)
+
+	// the fresh account can't transfer without the preceding call
+	_, _, _, err = svr.SimulateActions(ctx, bc.TipHeight(), []*action.SimulatedAction{
+		action.NewSimulatedCall(fresh, builder().SetAction(tsf2).Build()),
+	})
+	require.Error(err)
+
+	// the bundle exceeds the size limit or the gas cap
+	cs := svr.(*coreService)
+	cs.cfg.BundleSizeLimit, cs.cfg.BundleGasCap = 2, 2*gasLimit
+	call := action.NewSimulatedCall(producer, builder().SetAction(tsf1).Build())
+	_, _, _, err = svr.SimulateActions(ctx, bc.TipHeight(), []*action.SimulatedAction{call, call, call})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(err, "exceeds the limit")
+	cs.cfg.BundleSizeLimit = 3
+	_, _, _, err = svr.SimulateActions(ctx, bc.TipHeight(), []*action.SimulatedAction{call, call, call})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(err, "exceeds the cap")
+	_, _, _, err = svr.SimulateActions(ctx, bc.TipHeight(), []*action.SimulatedAction{call, call})
+	require.NoError(err)
+}
+
 func TestProofAndCompareReverseActions(t *testing.T) {
 	sliceN := func(n uint64) (value []uint64) {
 		value = make([]uint64, 0, n)
@@ -41,6 +41,7 @@ import (
 	"github.com/iotexproject/iotex-core/api/logfilter"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/recovery"
 	"github.com/iotexproject/iotex-core/pkg/tracer"
@@ -80,23 +81,29 @@ func RecoveryInterceptor() grpc_recovery.Option {
 	})
 }
 
-// NewGRPCServer creates a new grpc server
-func NewGRPCServer(core CoreService, grpcPort int) *GRPCServer {
+// NewGRPCServer creates a new grpc server, the calls are checked against the API keys and quotas if guard is not nil
+func NewGRPCServer(core CoreService, grpcPort int, guard *APIGuard) *GRPCServer {
 	if grpcPort == 0 {
 		return nil
 	}
 
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_prometheus.StreamServerInterceptor,
+		otelgrpc.StreamServerInterceptor(),
+		grpc_recovery.StreamServerInterceptor(RecoveryInterceptor()),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_prometheus.UnaryServerInterceptor,
+		otelgrpc.UnaryServerInterceptor(),
+		grpc_recovery.UnaryServerInterceptor(RecoveryInterceptor()),
+	}
+	if guard != nil {
+		streamInterceptors = append(streamInterceptors, guard.StreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, guard.UnaryServerInterceptor())
+	}
 	gSvr := grpc.NewServer(
-		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
-			grpc_prometheus.StreamServerInterceptor,
-			otelgrpc.StreamServerInterceptor(),
-			grpc_recovery.StreamServerInterceptor(RecoveryInterceptor()),
-		)),
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			grpc_prometheus.UnaryServerInterceptor,
-			otelgrpc.UnaryServerInterceptor(),
-			grpc_recovery.UnaryServerInterceptor(RecoveryInterceptor()),
-		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(streamInterceptors...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(unaryInterceptors...)),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 	)
@@ -709,6 +716,87 @@ func (svr *gRPCHandler) TraceTransactionStructLogs(ctx context.Context, in *iote
 	}, nil
 }
 
+// GetInternalTransfersByAddress returns the internal transfers from or to an address
+func (svr *gRPCHandler) GetInternalTransfersByAddress(ctx context.Context, in *iotexapi.GetInternalTransfersByAddressRequest) (*iotexapi.GetInternalTransfersByAddressResponse, error) {
+	addr, err := address.FromString(in.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	transfers, total, err := svr.coreService.InternalTransfersByAddress(addr, in.GetStart(), in.GetCount())
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*iotexapi.InternalTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		ret = append(ret, &iotexapi.InternalTransfer{
+			BlkHeight: t.BlockHeight,
+			ActHash:   t.ActionHash[:],
+			Transaction: &iotextypes.TransactionLog_Transaction{
+				Amount:    t.Amount.String(),
+				Sender:    t.Sender,
+				Recipient: t.Recipient,
+				Type:      t.Type,
+			},
+		})
+	}
+	return &iotexapi.GetInternalTransfersByAddressResponse{
+		Transfers: ret,
+		Total:     total,
+	}, nil
+}
+
+// GetTokenTransfers returns the token transfers from or to an address, or of a token
+func (svr *gRPCHandler) GetTokenTransfers(ctx context.Context, in *iotexapi.GetTokenTransfersRequest) (*iotexapi.GetTokenTransfersResponse, error) {
+	var (
+		addr, token address.Address
+		transfers   []*blockindex.TokenTransfer
+		total       uint64
+		err         error
+	)
+	if in.GetAddress() != "" {
+		if addr, err = address.FromString(in.GetAddress()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if in.GetToken() != "" {
+		if token, err = address.FromString(in.GetToken()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	switch {
+	case addr != nil:
+		transfers, total, err = svr.coreService.TokenTransfersByAddress(addr, token, in.GetStart(), in.GetCount())
+	case token != nil:
+		transfers, total, err = svr.coreService.TokenTransfersByToken(token, in.GetStart(), in.GetCount())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either address or token is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*iotexapi.TokenTransfer, 0, len(transfers))
+	for _, t := range transfers {
+		tr := &iotexapi.TokenTransfer{
+			Standard:  t.Standard.String(),
+			Token:     t.Token.String(),
+			Sender:    t.From.String(),
+			Recipient: t.To.String(),
+			Amount:    t.Amount.String(),
+			BlkHeight: t.BlockHeight,
+			ActHash:   t.ActionHash[:],
+			LogIndex:  t.LogIndex,
+		}
+		if t.TokenID != nil {
+			tr.TokenId = t.TokenID.String()
+		}
+		ret = append(ret, tr)
+	}
+	return &iotexapi.GetTokenTransfersResponse{
+		Transfers: ret,
+		Total:     total,
+	}, nil
+}
+
 // generateBlockMeta generates BlockMeta from block
 func generateBlockMeta(blkStore *apitypes.BlockWithReceipts) *iotextypes.BlockMeta {
 	blk := blkStore.Block
@@ -1279,6 +1279,10 @@ func TestGrpcServer_GetChainMetaIntegrity(t *testing.T) {
 		require.Equal(test.height, chainMetaPb.Height)
 		require.Equal(test.numActions, chainMetaPb.NumActions)
 		require.Equal(test.tps, chainMetaPb.Tps)
+		if !test.emptyChain {
+			// no block is pruned
+			require.Equal(uint64(1), chainMetaPb.LowestBlockHeight)
+		}
 		if test.epoch != nil {
 			require.Equal(test.epoch.Num, chainMetaPb.Epoch.Num)
 			require.Equal(test.epoch.Height, chainMetaPb.Epoch.Height)
@@ -2630,6 +2634,7 @@ func TestGrpcServer_TraceTransactionStructLogsIntegrity(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig()
 	cfg.api.GRPCPort = testutil.RandomPort()
+	cfg.chain.EnableArchiveMode = true
 	svr, bc, _, _, _, actPool, bfIndexFile, err := createServerV2(cfg, true)
 	require.NoError(err)
 	grpcHandler := newGRPCHandler(svr.core)
@@ -2643,10 +2648,11 @@ func TestGrpcServer_TraceTransactionStructLogsIntegrity(t *testing.T) {
 	_, err = grpcHandler.TraceTransactionStructLogs(context.Background(), request)
 	require.Error(err)
 
-	//unsupport type
+	// a transfer doesn't run in the evm
 	request.ActionHash = hex.EncodeToString(_transferHash1[:])
-	_, err = grpcHandler.TraceTransactionStructLogs(context.Background(), request)
-	require.Error(err)
+	ret, err := grpcHandler.TraceTransactionStructLogs(context.Background(), request)
+	require.NoError(err)
+	require.Empty(ret.StructLogs)
 
 	// deploy a contract
 	contractCode := "6080604052348015600f57600080fd5b5060de8061001e6000396000f3fe6080604052348015600f57600080fd5b506004361060285760003560e01c8063ee82ac5e14602d575b600080fd5b605660048036036020811015604157600080fd5b8101908080359060200190929190505050606c565b6040518082815260200191505060405180910390f35b60008082409050807f2d93f7749862d33969fb261757410b48065a1bc86a56da5c47820bd063e2338260405160405180910390a28091505091905056fea265627a7a723158200a258cd08ea99ee11aa68c78b6d2bf7ea912615a1e64a81b90a2abca2dd59cfa64736f6c634300050c0032"
@@ -2663,7 +2669,7 @@ func TestGrpcServer_TraceTransactionStructLogsIntegrity(t *testing.T) {
 	actPool.Reset()
 	ex1Hash, _ := ex1.Hash()
 	request.ActionHash = hex.EncodeToString(ex1Hash[:])
-	ret, err := grpcHandler.TraceTransactionStructLogs(context.Background(), request)
+	ret, err = grpcHandler.TraceTransactionStructLogs(context.Background(), request)
 	require.NoError(err)
 	require.Equal(len(ret.StructLogs), 17)
 	log := ret.StructLogs[0]
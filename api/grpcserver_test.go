@@ -18,10 +18,13 @@ import (
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
@@ -1028,6 +1031,112 @@ func TestGrpcServer_TraceTransactionStructLogs(t *testing.T) {
 	require.Equal(0, len(resp.StructLogs))
 }
 
+func TestGrpcServer_GetInternalTransfersByAddress(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	grpcSvr := newGRPCHandler(core)
+
+	addr := identityset.Address(28)
+	actHash := hash.Hash256b([]byte("_testAction"))
+	transfers := []*blockindex.InternalTransfer{
+		{
+			BlockHeight: 5,
+			ActionHash:  actHash,
+			Type:        iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER,
+			Amount:      big.NewInt(100),
+			Sender:      identityset.Address(10).String(),
+			Recipient:   addr.String(),
+		},
+	}
+	core.EXPECT().InternalTransfersByAddress(addr, uint64(1), uint64(10)).Return(transfers, uint64(2), nil)
+	resp, err := grpcSvr.GetInternalTransfersByAddress(context.Background(), &iotexapi.GetInternalTransfersByAddressRequest{
+		Address: addr.String(),
+		Start:   1,
+		Count:   10,
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), resp.Total)
+	require.Len(resp.Transfers, 1)
+	require.Equal(uint64(5), resp.Transfers[0].BlkHeight)
+	require.Equal(actHash[:], resp.Transfers[0].ActHash)
+	require.Equal("100", resp.Transfers[0].Transaction.Amount)
+	require.Equal(identityset.Address(10).String(), resp.Transfers[0].Transaction.Sender)
+	require.Equal(addr.String(), resp.Transfers[0].Transaction.Recipient)
+	require.Equal(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, resp.Transfers[0].Transaction.Type)
+
+	_, err = grpcSvr.GetInternalTransfersByAddress(context.Background(), &iotexapi.GetInternalTransfersByAddressRequest{
+		Address: "invalid",
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcServer_GetTokenTransfers(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	grpcSvr := newGRPCHandler(core)
+
+	var (
+		addr    = identityset.Address(28)
+		token   = identityset.Address(10)
+		actHash = hash.Hash256b([]byte("_testAction"))
+	)
+	transfers := []*blockindex.TokenTransfer{
+		{
+			Standard:    blockindex.XRC721,
+			Token:       token,
+			From:        identityset.Address(29),
+			To:          addr,
+			TokenID:     big.NewInt(7),
+			Amount:      big.NewInt(1),
+			BlockHeight: 5,
+			ActionHash:  actHash,
+			LogIndex:    2,
+		},
+	}
+	core.EXPECT().TokenTransfersByAddress(addr, token, uint64(1), uint64(10)).Return(transfers, uint64(2), nil)
+	resp, err := grpcSvr.GetTokenTransfers(context.Background(), &iotexapi.GetTokenTransfersRequest{
+		Address: addr.String(),
+		Token:   token.String(),
+		Start:   1,
+		Count:   10,
+	})
+	require.NoError(err)
+	require.Equal(uint64(2), resp.Total)
+	require.Len(resp.Transfers, 1)
+	tr := resp.Transfers[0]
+	require.Equal("xrc721", tr.Standard)
+	require.Equal(token.String(), tr.Token)
+	require.Equal(identityset.Address(29).String(), tr.Sender)
+	require.Equal(addr.String(), tr.Recipient)
+	require.Equal("7", tr.TokenId)
+	require.Equal("1", tr.Amount)
+	require.Equal(uint64(5), tr.BlkHeight)
+	require.Equal(actHash[:], tr.ActHash)
+	require.Equal(uint32(2), tr.LogIndex)
+
+	// the transfers of the token
+	core.EXPECT().TokenTransfersByToken(token, uint64(0), uint64(10)).Return(nil, uint64(0), nil)
+	resp, err = grpcSvr.GetTokenTransfers(context.Background(), &iotexapi.GetTokenTransfersRequest{
+		Token: token.String(),
+		Count: 10,
+	})
+	require.NoError(err)
+	require.Empty(resp.Transfers)
+
+	for _, req := range []*iotexapi.GetTokenTransfersRequest{
+		{},
+		{Address: "invalid"},
+		{Token: "invalid"},
+	} {
+		_, err = grpcSvr.GetTokenTransfers(context.Background(), req)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
 func getAction() (act *iotextypes.Action) {
 	pubKey1 := identityset.PrivateKey(28).PublicKey()
 	addr2 := identityset.Address(29).String()
@@ -68,7 +68,7 @@ func (handler *hTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
-	if err := handler.msgHandler.HandlePOSTReq(req.Context(), req.Body,
+	if err := handler.msgHandler.HandlePOSTReq(withRequestCaller(req), req.Body,
 		apitypes.NewResponseWriter(
 			func(resp interface{}) (int, error) {
 				w.Header().Set("Access-Control-Allow-Origin", "*")
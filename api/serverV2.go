@@ -22,6 +22,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/blocksync"
 	"github.com/iotexproject/iotex-core/pkg/tracer"
+	"github.com/iotexproject/iotex-core/server/itx/nodestats"
 	"github.com/iotexproject/iotex-core/state/factory"
 )
 
@@ -52,7 +53,16 @@ func NewServerV2(
 	if err != nil {
 		return nil, err
 	}
-	web3Handler := NewWeb3Handler(coreAPI, cfg.RedisCacheURL, cfg.BatchRequestLimit)
+	var stats *nodestats.APILocalStats
+	if core, ok := coreAPI.(*coreService); ok {
+		stats = core.apiStats
+	}
+	guard := NewAPIGuard(cfg.Auth, stats)
+	web3Opts := []Web3HandlerOption{WithAPIGuard(guard)}
+	if cfg.EnableDebugAPI {
+		web3Opts = append(web3Opts, WithDebugAPI())
+	}
+	web3Handler := NewWeb3Handler(coreAPI, cfg.RedisCacheURL, cfg.BatchRequestLimit, web3Opts...)
 
 	tp, err := tracer.NewProvider(
 		tracer.WithServiceName(cfg.Tracer.ServiceName),
@@ -71,7 +81,7 @@ func NewServerV2(
 
 	return &ServerV2{
 		core:         coreAPI,
-		grpcServer:   NewGRPCServer(coreAPI, cfg.GRPCPort),
+		grpcServer:   NewGRPCServer(coreAPI, cfg.GRPCPort, guard),
 		httpSvr:      NewHTTPServer("", cfg.HTTPPort, wrappedWeb3Handler),
 		websocketSvr: NewHTTPServer("", cfg.WebSocketPort, wrappedWebsocketHandler),
 		tracer:       tp,
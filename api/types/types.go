@@ -5,7 +5,9 @@ import (
 	"errors"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/state"
 )
 
 // MaxResponseSize is the max size of response
@@ -37,6 +39,23 @@ type (
 		Block    *block.Block
 		Receipts []*action.Receipt
 	}
+
+	// AccountProof includes an account with its merkle proof in the state trie, and the merkle proofs of the slots
+	// in its storage trie
+	AccountProof struct {
+		Account       *state.Account
+		StateRoot     []byte
+		Proof         [][]byte
+		StorageRoot   []byte
+		StorageProofs []*evm.StorageSlotProof
+	}
+
+	// IndexerStatus is the height of an indexer fed asynchronously, and the number of blocks it is behind the tip
+	IndexerStatus struct {
+		Name   string
+		Height uint64
+		Lag    uint64
+	}
 )
 
 // responseWriter for server
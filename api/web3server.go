@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -27,9 +28,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	rewardingabi "github.com/iotexproject/iotex-core/action/protocol/rewarding/ethabi"
 	stakingabi "github.com/iotexproject/iotex-core/action/protocol/staking/ethabi"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockindex"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/tracer"
 	"github.com/iotexproject/iotex-core/pkg/util/addrutil"
@@ -39,6 +43,8 @@ const (
 	_metamaskBalanceContractAddr = "io1k8uw2hrlvnfq8s2qpwwc24ws2ru54heenx8chr"
 	// _defaultBatchRequestLimit is the default maximum number of items in a batch.
 	_defaultBatchRequestLimit = 100 // Maximum number of items in a batch.
+	// _defaultTokenTransferCount is the default number of token transfers returned in a page.
+	_defaultTokenTransferCount = 100
 )
 
 type (
@@ -51,7 +57,12 @@ type (
 		coreService       CoreService
 		cache             apiCache
 		batchRequestLimit int
+		enableDebugAPI    bool
+		guard             *APIGuard
 	}
+
+	// Web3HandlerOption is the option to create the web3 handler
+	Web3HandlerOption func(*web3Handler)
 )
 
 type (
@@ -80,6 +91,9 @@ var (
 	errInvalidBlock      = errors.New("invalid block")
 	errUnsupportedAction = errors.New("the type of action is not supported")
 	errMsgBatchTooLarge  = errors.New("batch too large")
+	errDebugAPIDisabled  = errors.New("debug api is disabled")
+	// errOverrideNotSupported is returned if the overrides are given to a call which is not run by the EVM
+	errOverrideNotSupported = status.Error(codes.InvalidArgument, "state and block overrides are only supported on contract calls")
 
 	_pendingBlockNumber  = "pending"
 	_latestBlockNumber   = "latest"
@@ -90,13 +104,31 @@ func init() {
 	prometheus.MustRegister(_web3ServerMtc)
 }
 
+// WithAPIGuard checks the web3 requests against the API keys and quotas
+func WithAPIGuard(guard *APIGuard) Web3HandlerOption {
+	return func(svr *web3Handler) {
+		svr.guard = guard
+	}
+}
+
+// WithDebugAPI enables the debug_* trace methods
+func WithDebugAPI() Web3HandlerOption {
+	return func(svr *web3Handler) {
+		svr.enableDebugAPI = true
+	}
+}
+
 // NewWeb3Handler creates a handle to process web3 requests
-func NewWeb3Handler(core CoreService, cacheURL string, batchRequestLimit int) Web3Handler {
-	return &web3Handler{
+func NewWeb3Handler(core CoreService, cacheURL string, batchRequestLimit int, opts ...Web3HandlerOption) Web3Handler {
+	svr := &web3Handler{
 		coreService:       core,
 		cache:             newAPICache(15*time.Minute, cacheURL),
 		batchRequestLimit: batchRequestLimit,
 	}
+	for _, opt := range opts {
+		opt(svr)
+	}
+	return svr
 }
 
 // HandlePOSTReq handles web3 request
@@ -146,11 +178,45 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 	log.T(ctx).Debug("handleWeb3Req", zap.String("method", method.(string)), zap.String("requestParams", fmt.Sprintf("%+v", web3Req)))
 	_web3ServerMtc.WithLabelValues(method.(string)).Inc()
 	_web3ServerMtc.WithLabelValues("requests_total").Inc()
+	if err = svr.guard.Check(ctx, method.(string)); err == nil {
+		res, err = svr.dispatchWeb3Req(ctx, method, web3Req, writer)
+	}
+	if err != nil {
+		log.Logger("api").Debug("web3server",
+			zap.String("requestParams", fmt.Sprintf("%+v", web3Req)),
+			zap.Error(err))
+	} else {
+		log.Logger("api").Debug("web3Debug", zap.String("response", fmt.Sprintf("%+v", res)))
+	}
+	var id any
+	reqID := web3Req.Get("id")
+	switch reqID.Type {
+	case gjson.String:
+		id = reqID.String()
+	case gjson.Number:
+		id = reqID.Int()
+	default:
+		id = 0
+		res, err = nil, errors.New("invalid id type")
+	}
+	size, err1 = writer.Write(&web3Response{
+		id:     id,
+		result: res,
+		err:    err,
+	})
+	return err1
+}
+
+func (svr *web3Handler) dispatchWeb3Req(ctx context.Context, method any, web3Req *gjson.Result, writer apitypes.Web3ResponseWriter) (res any, err error) {
 	switch method {
 	case "eth_accounts":
 		res, err = svr.ethAccounts()
 	case "eth_gasPrice":
 		res, err = svr.gasPrice()
+	case "eth_maxPriorityFeePerGas":
+		res, err = svr.maxPriorityFee()
+	case "eth_feeHistory":
+		res, err = svr.feeHistory(ctx, web3Req)
 	case "eth_getBlockByHash":
 		res, err = svr.getBlockByHash(web3Req)
 	case "eth_chainId":
@@ -195,6 +261,10 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		res, err = svr.estimateGas(web3Req)
 	case "eth_sendRawTransaction":
 		res, err = svr.sendRawTransaction(web3Req)
+	case "eth_callBundle":
+		res, err = svr.callBundle(ctx, web3Req)
+	case "eth_createAccessList":
+		res, err = svr.createAccessList(ctx, web3Req)
 	case "eth_getTransactionByHash":
 		res, err = svr.getTransactionByHash(web3Req)
 	case "eth_getTransactionByBlockNumberAndIndex":
@@ -207,6 +277,8 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		res, err = svr.getTransactionReceipt(web3Req)
 	case "eth_getStorageAt":
 		res, err = svr.getStorageAt(web3Req)
+	case "eth_getProof":
+		res, err = svr.getProof(ctx, web3Req)
 	case "eth_getFilterLogs":
 		res, err = svr.getFilterLogs(web3Req)
 	case "eth_getFilterChanges":
@@ -225,11 +297,20 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 		res, err = svr.subscribe(web3Req, writer)
 	case "eth_unsubscribe":
 		res, err = svr.unsubscribe(web3Req)
-	//TODO: enable debug api after archive mode is supported
-	// case "debug_traceTransaction":
-	// 	res, err = svr.traceTransaction(ctx, web3Req)
-	// case "debug_traceCall":
-	// 	res, err = svr.traceCall(ctx, web3Req)
+	case "iotex_getTokenTransfers":
+		res, err = svr.getTokenTransfers(web3Req)
+	case "iotex_getInternalTransfers":
+		res, err = svr.getInternalTransfers(web3Req)
+	case "iotex_indexerStatus":
+		res, err = svr.indexerStatus()
+	case "txpool_content":
+		res, err = svr.txpoolContent()
+	case "txpool_status":
+		res, err = svr.txpoolStatus()
+	case "txpool_inspect":
+		res, err = svr.txpoolInspect()
+	case "debug_traceTransaction", "debug_traceCall", "debug_traceBlockByNumber", "debug_traceBlockByHash":
+		res, err = svr.debugTrace(ctx, method.(string), web3Req)
 	case "eth_coinbase", "eth_getUncleCountByBlockHash", "eth_getUncleCountByBlockNumber",
 		"eth_sign", "eth_signTransaction", "eth_sendTransaction", "eth_getUncleByBlockHashAndIndex",
 		"eth_getUncleByBlockNumberAndIndex", "eth_pendingTransactions":
@@ -237,30 +318,7 @@ func (svr *web3Handler) handleWeb3Req(ctx context.Context, web3Req *gjson.Result
 	default:
 		res, err = nil, errors.Wrapf(errors.New("web3 method not found"), "method: %s\n", web3Req.Get("method"))
 	}
-	if err != nil {
-		log.Logger("api").Debug("web3server",
-			zap.String("requestParams", fmt.Sprintf("%+v", web3Req)),
-			zap.Error(err))
-	} else {
-		log.Logger("api").Debug("web3Debug", zap.String("response", fmt.Sprintf("%+v", res)))
-	}
-	var id any
-	reqID := web3Req.Get("id")
-	switch reqID.Type {
-	case gjson.String:
-		id = reqID.String()
-	case gjson.Number:
-		id = reqID.Int()
-	default:
-		id = 0
-		res, err = nil, errors.New("invalid id type")
-	}
-	size, err1 = writer.Write(&web3Response{
-		id:     id,
-		result: res,
-		err:    err,
-	})
-	return err1
+	return
 }
 
 func parseWeb3Reqs(reader io.Reader) (gjson.Result, error) {
@@ -295,6 +353,64 @@ func (svr *web3Handler) gasPrice() (interface{}, error) {
 	return uint64ToHex(ret), nil
 }
 
+func (svr *web3Handler) maxPriorityFee() (interface{}, error) {
+	ret, err := svr.coreService.SuggestGasTipCap()
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.EncodeBig(ret), nil
+}
+
+func (svr *web3Handler) feeHistory(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	blkCnt, newestBlk, rewardPercentiles := in.Get("params.0"), in.Get("params.1"), in.Get("params.2")
+	if !blkCnt.Exists() || !newestBlk.Exists() {
+		return nil, errInvalidFormat
+	}
+	var (
+		blocks uint64
+		err    error
+	)
+	if blkCnt.Type == gjson.Number {
+		blocks = blkCnt.Uint()
+	} else if blocks, err = hexStringToNumber(blkCnt.String()); err != nil {
+		return nil, err
+	}
+	lastBlock, err := svr.parseBlockNumber(newestBlk.String())
+	if err != nil {
+		return nil, err
+	}
+	var percentiles []float64
+	for _, p := range rewardPercentiles.Array() {
+		if p.Type != gjson.Number {
+			return nil, errInvalidFormat
+		}
+		percentiles = append(percentiles, p.Float())
+	}
+	oldest, rewards, baseFees, ratios, err := svr.coreService.FeeHistory(ctx, blocks, lastBlock, percentiles)
+	if err != nil {
+		return nil, err
+	}
+	ret := &feeHistoryResult{
+		OldestBlock:   uint64ToHex(oldest),
+		BaseFeePerGas: make([]string, 0, len(baseFees)),
+		GasUsedRatio:  ratios,
+	}
+	for _, fee := range baseFees {
+		ret.BaseFeePerGas = append(ret.BaseFeePerGas, hexutil.EncodeBig(fee))
+	}
+	if ret.GasUsedRatio == nil {
+		ret.GasUsedRatio = []float64{}
+	}
+	for _, blkRewards := range rewards {
+		r := make([]string, 0, len(blkRewards))
+		for _, v := range blkRewards {
+			r = append(r, hexutil.EncodeBig(v))
+		}
+		ret.Reward = append(ret.Reward, r)
+	}
+	return ret, nil
+}
+
 func (svr *web3Handler) getChainID() (interface{}, error) {
 	return uint64ToHex(uint64(svr.coreService.EVMNetworkID())), nil
 }
@@ -332,7 +448,11 @@ func (svr *web3Handler) getBalance(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	accountMeta, _, err := svr.coreService.Account(ioAddr)
+	height, err := svr.parseBlockParam(in.Get("params.1"))
+	if err != nil {
+		return nil, err
+	}
+	accountMeta, err := svr.coreService.AccountAtHeight(context.Background(), ioAddr, height)
 	if err != nil {
 		return nil, err
 	}
@@ -349,13 +469,23 @@ func (svr *web3Handler) getTransactionCount(in *gjson.Result) (interface{}, erro
 	if err != nil {
 		return nil, err
 	}
-	// TODO (liuhaai): returns the nonce in given block height after archive mode is supported
-	// blkNum, err := getStringFromArray(in, 1)
-	pendingNonce, err := svr.coreService.PendingNonce(ioAddr)
+	blkParam := in.Get("params.1")
+	if !blkParam.Exists() || blkParam.String() == _pendingBlockNumber {
+		pendingNonce, err := svr.coreService.PendingNonce(ioAddr)
+		if err != nil {
+			return nil, err
+		}
+		return uint64ToHex(pendingNonce), nil
+	}
+	height, err := svr.parseBlockParam(blkParam)
 	if err != nil {
 		return nil, err
 	}
-	return uint64ToHex(pendingNonce), nil
+	accountMeta, err := svr.coreService.AccountAtHeight(context.Background(), ioAddr, height)
+	if err != nil {
+		return nil, err
+	}
+	return uint64ToHex(accountMeta.PendingNonce), nil
 }
 
 func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
@@ -366,12 +496,28 @@ func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
 	if to == _metamaskBalanceContractAddr {
 		return nil, nil
 	}
+	height, err := svr.parseBlockParam(in.Get("params.1"))
+	if err != nil {
+		return nil, err
+	}
+	override, err := parseSimulationOverride(in.Get("params.2"), in.Get("params.3"))
+	if err != nil {
+		return nil, err
+	}
+	// protocol states are read at the tip height unless a past height is given
+	readStateHeight := ""
+	if height != svr.coreService.TipHeight() {
+		readStateHeight = strconv.FormatUint(height, 10)
+	}
+	if (to == address.StakingProtocolAddr || to == address.RewardingProtocol) && override != nil {
+		return nil, errOverrideNotSupported
+	}
 	if to == address.StakingProtocolAddr {
 		sctx, err := stakingabi.BuildReadStateRequest(data)
 		if err != nil {
 			return nil, err
 		}
-		states, err := svr.coreService.ReadState("staking", "", sctx.Parameters().MethodName, sctx.Parameters().Arguments)
+		states, err := svr.coreService.ReadState("staking", readStateHeight, sctx.Parameters().MethodName, sctx.Parameters().Arguments)
 		if err != nil {
 			return nil, err
 		}
@@ -386,7 +532,7 @@ func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
-		states, err := svr.coreService.ReadState("rewarding", "", sctx.Parameters().MethodName, sctx.Parameters().Arguments)
+		states, err := svr.coreService.ReadState("rewarding", readStateHeight, sctx.Parameters().MethodName, sctx.Parameters().Arguments)
 		if err != nil {
 			return nil, err
 		}
@@ -397,7 +543,8 @@ func (svr *web3Handler) call(in *gjson.Result) (interface{}, error) {
 		return "0x" + ret, nil
 	}
 	exec, _ := action.NewExecution(to, 0, value, gasLimit, gasPrice, data)
-	ret, receipt, err := svr.coreService.ReadContract(context.Background(), callerAddr, exec)
+	ctx := evm.WithSimulationOverrideCtx(context.Background(), override)
+	ret, receipt, err := svr.coreService.ReadContractAtHeight(ctx, callerAddr, exec, height)
 	if err != nil {
 		return nil, err
 	}
@@ -412,6 +559,10 @@ func (svr *web3Handler) estimateGas(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	override, err := parseSimulationOverride(in.Get("params.2"), in.Get("params.3"))
+	if err != nil {
+		return nil, err
+	}
 
 	var (
 		tx     *types.Transaction
@@ -439,8 +590,11 @@ func (svr *web3Handler) estimateGas(in *gjson.Result) (interface{}, error) {
 
 	var estimatedGas uint64
 	if exec, ok := elp.Action().(*action.Execution); ok {
-		estimatedGas, err = svr.coreService.EstimateExecutionGasConsumption(context.Background(), exec, from)
+		estimatedGas, err = svr.coreService.EstimateExecutionGasConsumption(evm.WithSimulationOverrideCtx(context.Background(), override), exec, from)
 	} else {
+		if override != nil {
+			return nil, errOverrideNotSupported
+		}
 		estimatedGas, err = svr.coreService.EstimateGasForNonExecution(elp.Action())
 	}
 	if err != nil {
@@ -452,6 +606,76 @@ func (svr *web3Handler) estimateGas(in *gjson.Result) (interface{}, error) {
 	return uint64ToHex(estimatedGas), nil
 }
 
+func (svr *web3Handler) createAccessList(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	callerAddr, to, gasLimit, gasPrice, value, data, err := parseCallObject(in)
+	if err != nil {
+		return nil, err
+	}
+	var accessList types.AccessList
+	if list := in.Get("params.0.accessList"); list.Exists() {
+		if err := json.Unmarshal([]byte(list.Raw), &accessList); err != nil {
+			return nil, errors.Wrapf(errInvalidFormat, "accessList: %s", err.Error())
+		}
+	}
+	height, err := svr.parseBlockParam(in.Get("params.1"))
+	if err != nil {
+		return nil, err
+	}
+	exec, _ := action.NewExecutionWithAccessList(to, 0, value, gasLimit, gasPrice, data, accessList)
+	accessList, receipt, err := svr.coreService.CreateAccessList(ctx, callerAddr, exec, height)
+	if err != nil {
+		return nil, err
+	}
+	if accessList == nil {
+		accessList = types.AccessList{}
+	}
+	result := &createAccessListResult{
+		AccessList: accessList,
+		GasUsed:    uint64ToHex(receipt.GasConsumed),
+	}
+	switch {
+	case len(receipt.ExecutionRevertMsg()) > 0:
+		result.Error = "execution reverted: " + receipt.ExecutionRevertMsg()
+	case receipt.Status != uint64(iotextypes.ReceiptStatus_Success):
+		result.Error = iotextypes.ReceiptStatus(receipt.Status).String()
+	}
+	return result, nil
+}
+
+// callBundle runs the calls and signed transactions in order on one state at the given height, and returns the result
+// of each of them, or the error of a signed transaction failing the validation, the state is discarded afterwards
+func (svr *web3Handler) callBundle(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	calls := in.Get("params.0")
+	if !calls.IsArray() || len(calls.Array()) == 0 {
+		return nil, errInvalidFormat
+	}
+	height, err := svr.parseBlockParam(in.Get("params.1"))
+	if err != nil {
+		return nil, err
+	}
+	acts := make([]*action.SimulatedAction, 0, len(calls.Array()))
+	for i, call := range calls.Array() {
+		act, err := svr.parseSimulatedAction(call, height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "call %d", i)
+		}
+		acts = append(acts, act)
+	}
+	retvals, receipts, errs, err := svr.coreService.SimulateActions(ctx, height, acts)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*callBundleResult, 0, len(receipts))
+	for i, receipt := range receipts {
+		results = append(results, &callBundleResult{
+			retval:  retvals[i],
+			receipt: receipt,
+			err:     errs[i],
+		})
+	}
+	return results, nil
+}
+
 func (svr *web3Handler) sendRawTransaction(in *gjson.Result) (interface{}, error) {
 	dataStr := in.Get("params.0")
 	if !dataStr.Exists() {
@@ -503,7 +727,11 @@ func (svr *web3Handler) getCode(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	accountMeta, _, err := svr.coreService.Account(ioAddr)
+	height, err := svr.parseBlockParam(in.Get("params.1"))
+	if err != nil {
+		return nil, err
+	}
+	accountMeta, err := svr.coreService.AccountAtHeight(context.Background(), ioAddr, height)
 	if err != nil {
 		return nil, err
 	}
@@ -747,13 +975,54 @@ func (svr *web3Handler) getStorageAt(in *gjson.Result) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	val, err := svr.coreService.ReadContractStorage(context.Background(), contractAddr, pos)
+	height, err := svr.parseBlockParam(in.Get("params.2"))
+	if err != nil {
+		return nil, err
+	}
+	val, err := svr.coreService.ReadContractStorageAtHeight(context.Background(), contractAddr, pos, height)
 	if err != nil {
 		return nil, err
 	}
 	return "0x" + hex.EncodeToString(val), nil
 }
 
+// getProof returns the merkle proofs of the account and its storage slots. Unlike ethereum, the state root is not
+// committed in the block header, so a client can't verify the proof against a block it trusts, but only against a
+// state root it obtains from a trusted node
+func (svr *web3Handler) getProof(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	ethAddr, storageKeys, blkParam := in.Get("params.0"), in.Get("params.1"), in.Get("params.2")
+	if !ethAddr.Exists() || !storageKeys.IsArray() || !blkParam.Exists() {
+		return nil, errInvalidFormat
+	}
+	addr, err := address.FromHex(ethAddr.String())
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]hash.Hash256, 0, len(storageKeys.Array()))
+	for _, key := range storageKeys.Array() {
+		b, err := hexToBytes(key.String())
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > len(hash.Hash256{}) {
+			return nil, errors.Wrapf(errInvalidFormat, "storage key %s is too long", key.String())
+		}
+		keys = append(keys, hash.BytesToHash256(b))
+	}
+	height, err := svr.parseBlockParam(blkParam)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := svr.coreService.StateProof(ctx, addr, keys, height)
+	if err != nil {
+		return nil, err
+	}
+	return &getProofResult{
+		address: addr,
+		proof:   proof,
+	}, nil
+}
+
 func (svr *web3Handler) newFilter(filter *filterObject) (interface{}, error) {
 	//check the validity of filter before caching
 	if filter == nil {
@@ -943,11 +1212,113 @@ func (svr *web3Handler) unsubscribe(in *gjson.Result) (interface{}, error) {
 	return chainListener.RemoveResponder(id.String())
 }
 
+func (svr *web3Handler) debugTrace(ctx context.Context, method string, in *gjson.Result) (interface{}, error) {
+	if !svr.enableDebugAPI {
+		return nil, errDebugAPIDisabled
+	}
+	switch method {
+	case "debug_traceTransaction":
+		return svr.traceTransaction(ctx, in)
+	case "debug_traceCall":
+		return svr.traceCall(ctx, in)
+	case "debug_traceBlockByNumber":
+		return svr.traceBlockByNumber(ctx, in)
+	default:
+		return svr.traceBlockByHash(ctx, in)
+	}
+}
+
 func (svr *web3Handler) traceTransaction(ctx context.Context, in *gjson.Result) (interface{}, error) {
 	actHash, options := in.Get("params.0"), in.Get("params.1")
 	if !actHash.Exists() {
 		return nil, errInvalidFormat
 	}
+	retval, receipt, tracer, err := svr.coreService.TraceTransaction(ctx, actHash.String(), parseTraceConfig(options))
+	if err != nil {
+		return nil, err
+	}
+	return traceResult(retval, receipt, tracer)
+}
+
+func (svr *web3Handler) traceCall(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	var (
+		err          error
+		contractAddr string
+		callData     []byte
+		gasLimit     uint64
+		value        *big.Int
+		callerAddr   address.Address
+	)
+	blkParam, options := in.Get("params.1"), in.Get("params.2")
+	callerAddr, contractAddr, gasLimit, _, value, callData, err = parseCallObject(in)
+	if err != nil {
+		return nil, err
+	}
+	height, err := svr.parseBlockParam(blkParam)
+	if err != nil {
+		return nil, err
+	}
+	retval, receipt, tracer, err := svr.coreService.TraceCall(ctx, callerAddr, height, contractAddr, value, gasLimit, callData, parseTraceConfig(options))
+	if err != nil {
+		return nil, err
+	}
+	return traceResult(retval, receipt, tracer)
+}
+
+func (svr *web3Handler) traceBlockByNumber(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	blkNum, options := in.Get("params.0"), in.Get("params.1")
+	if !blkNum.Exists() {
+		return nil, errInvalidFormat
+	}
+	num, err := svr.parseBlockNumber(blkNum.String())
+	if err != nil {
+		return nil, err
+	}
+	blk, err := svr.coreService.BlockByHeight(num)
+	if err != nil {
+		return nil, err
+	}
+	return svr.traceBlock(ctx, blk.Block, parseTraceConfig(options))
+}
+
+func (svr *web3Handler) traceBlockByHash(ctx context.Context, in *gjson.Result) (interface{}, error) {
+	blkHash, options := in.Get("params.0"), in.Get("params.1")
+	if !blkHash.Exists() {
+		return nil, errInvalidFormat
+	}
+	blk, err := svr.coreService.BlockByHash(util.Remove0xPrefix(blkHash.String()))
+	if err != nil {
+		return nil, err
+	}
+	return svr.traceBlock(ctx, blk.Block, parseTraceConfig(options))
+}
+
+func (svr *web3Handler) traceBlock(ctx context.Context, blk *block.Block, cfg *tracers.TraceConfig) (interface{}, error) {
+	receipts, traces, err := svr.coreService.TraceBlock(ctx, blk, cfg)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*debugTraceBlockResult, len(receipts))
+	for i, receipt := range receipts {
+		results[i] = &debugTraceBlockResult{
+			TxHash: "0x" + hex.EncodeToString(receipt.ActionHash[:]),
+		}
+		var retval []byte
+		if tracer, ok := traces[i].(*logger.StructLogger); ok {
+			retval = tracer.Output()
+		}
+		res, err := traceResult(retval, receipt, traces[i])
+		if err != nil {
+			// failure of tracing an action doesn't fail the whole block
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Result = res
+	}
+	return results, nil
+}
+
+func parseTraceConfig(options gjson.Result) *tracers.TraceConfig {
 	var (
 		enableMemory, disableStack, disableStorage, enableReturnData bool
 	)
@@ -972,10 +1343,14 @@ func (svr *web3Handler) traceTransaction(ctx context.Context, in *gjson.Result)
 			cfg.TracerConfig = json.RawMessage(tracerConfig.Raw)
 		}
 	}
-	retval, receipt, tracer, err := svr.coreService.TraceTransaction(ctx, actHash.String(), cfg)
-	if err != nil {
-		return nil, err
+	if timeout := options.Get("timeout"); timeout.Exists() {
+		cfg.Timeout = new(string)
+		*cfg.Timeout = timeout.String()
 	}
+	return cfg
+}
+
+func traceResult(retval []byte, receipt *action.Receipt, tracer any) (interface{}, error) {
 	switch tracer := tracer.(type) {
 	case *logger.StructLogger:
 		return &debugTraceTransactionResult{
@@ -992,77 +1367,238 @@ func (svr *web3Handler) traceTransaction(ctx context.Context, in *gjson.Result)
 	}
 }
 
-func (svr *web3Handler) traceCall(ctx context.Context, in *gjson.Result) (interface{}, error) {
+func (svr *web3Handler) txpoolContent() (interface{}, error) {
+	return svr.txpoolByNonce(func(selp *action.SealedEnvelope) (interface{}, error) {
+		return svr.assemblePendingTransaction(selp)
+	})
+}
+
+func (svr *web3Handler) txpoolInspect() (interface{}, error) {
+	return svr.txpoolByNonce(func(selp *action.SealedEnvelope) (interface{}, error) {
+		ethTx, err := selp.ToEthTx()
+		if err != nil {
+			return nil, err
+		}
+		if ethTx.To() == nil {
+			return fmt.Sprintf("contract creation: %d wei + %d gas × %d wei", ethTx.Value(), ethTx.Gas(), ethTx.GasPrice()), nil
+		}
+		return fmt.Sprintf("%s: %d wei + %d gas × %d wei", ethTx.To().Hex(), ethTx.Value(), ethTx.Gas(), ethTx.GasPrice()), nil
+	})
+}
+
+// txpoolByNonce groups the actions in actpool into pending and queued ones, then by sender and nonce
+func (svr *web3Handler) txpoolByNonce(format func(*action.SealedEnvelope) (interface{}, error)) (interface{}, error) {
+	ret := map[string]map[string]map[string]interface{}{
+		"pending": {},
+		"queued":  {},
+	}
+	group := func(sender string, acts []*action.SealedEnvelope, content map[string]map[string]interface{}) error {
+		acts = ethCompatibleActions(acts)
+		if len(acts) == 0 {
+			return nil
+		}
+		byNonce := make(map[string]interface{}, len(acts))
+		for _, selp := range acts {
+			v, err := format(selp)
+			if err != nil {
+				return err
+			}
+			byNonce[strconv.FormatUint(selp.Nonce(), 10)] = v
+		}
+		content[sender] = byNonce
+		return nil
+	}
+	pending, queued := svr.coreService.ActionsInActPoolBySender()
+	for name, actsBySender := range map[string]map[string][]*action.SealedEnvelope{
+		"pending": pending,
+		"queued":  queued,
+	} {
+		for sender, acts := range actsBySender {
+			ethAddr, err := ioAddrToEthAddr(sender)
+			if err != nil {
+				return nil, err
+			}
+			if err := group(ethAddr, acts, ret[name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ret, nil
+}
+
+// txpoolStatus counts the actions and their gas in actpool, only the actions shown by txpoolByNonce are counted
+func (svr *web3Handler) txpoolStatus() (interface{}, error) {
+	var pending, queued, pendingGas, queuedGas uint64
+	pendingActs, queuedActs := svr.coreService.ActionsInActPoolBySender()
+	for _, acts := range pendingActs {
+		for _, selp := range ethCompatibleActions(acts) {
+			pending++
+			pendingGas += selp.GasLimit()
+		}
+	}
+	for _, acts := range queuedActs {
+		for _, selp := range ethCompatibleActions(acts) {
+			queued++
+			queuedGas += selp.GasLimit()
+		}
+	}
+	return &txpoolStatusResult{
+		Pending:    uint64ToHex(pending),
+		Queued:     uint64ToHex(queued),
+		PendingGas: uint64ToHex(pendingGas),
+		QueuedGas:  uint64ToHex(queuedGas),
+	}, nil
+}
+
+// ethCompatibleActions returns the actions which can be represented as eth transactions
+func ethCompatibleActions(acts []*action.SealedEnvelope) []*action.SealedEnvelope {
+	ret := make([]*action.SealedEnvelope, 0, len(acts))
+	for _, selp := range acts {
+		if _, ok := selp.Action().(action.EthCompatibleAction); ok {
+			ret = append(ret, selp)
+		}
+	}
+	return ret
+}
+
+// indexerStatus returns the height of each indexer fed asynchronously, and the number of blocks it is behind the tip
+func (svr *web3Handler) indexerStatus() (interface{}, error) {
+	indexers := svr.coreService.IndexerStatus()
+	results := make([]*indexerStatusResult, 0, len(indexers))
+	for _, indexer := range indexers {
+		results = append(results, &indexerStatusResult{
+			Name:   indexer.Name,
+			Height: uint64ToHex(indexer.Height),
+			Lag:    uint64ToHex(indexer.Lag),
+		})
+	}
+	return results, nil
+}
+
+// getTokenTransfers returns the token transfers from or to an address, or of a token if the address is not given
+func (svr *web3Handler) getTokenTransfers(in *gjson.Result) (interface{}, error) {
+	query := in.Get("params.0")
+	if !query.IsObject() {
+		return nil, errInvalidFormat
+	}
 	var (
+		addr, token  address.Address
+		start, count uint64 = 0, _defaultTokenTransferCount
 		err          error
-		contractAddr string
-		callData     []byte
-		gasLimit     uint64
-		value        *big.Int
-		callerAddr   address.Address
 	)
-	blkNumOrHashObj, options := in.Get("params.1"), in.Get("params.2")
-	callerAddr, contractAddr, gasLimit, _, value, callData, err = parseCallObject(in)
-	if err != nil {
-		return nil, err
+	if v := query.Get("address"); v.Exists() {
+		if addr, err = ethAddrToIoAddr(v.String()); err != nil {
+			return nil, err
+		}
 	}
-	var blkNumOrHash any
-	if blkNumOrHashObj.Exists() {
-		blkNumOrHash = blkNumOrHashObj.Get("blockHash").String()
-		if blkNumOrHash == "" {
-			blkNumOrHash = blkNumOrHashObj.Get("blockNumber").Uint()
+	if v := query.Get("token"); v.Exists() {
+		if token, err = ethAddrToIoAddr(v.String()); err != nil {
+			return nil, err
+		}
+	}
+	if v := query.Get("start"); v.Exists() {
+		if start, err = hexStringToNumber(v.String()); err != nil {
+			return nil, err
+		}
+	}
+	if v := query.Get("count"); v.Exists() {
+		if count, err = hexStringToNumber(v.String()); err != nil {
+			return nil, err
 		}
 	}
-
 	var (
-		enableMemory, disableStack, disableStorage, enableReturnData bool
-		tracerJs, tracerTimeout                                      *string
+		transfers []*blockindex.TokenTransfer
+		total     uint64
 	)
-	if options.Exists() {
-		enableMemory = options.Get("enableMemory").Bool()
-		disableStack = options.Get("disableStack").Bool()
-		disableStorage = options.Get("disableStorage").Bool()
-		enableReturnData = options.Get("enableReturnData").Bool()
-		trace := options.Get("tracer")
-		if trace.Exists() {
-			tracerJs = new(string)
-			*tracerJs = trace.String()
+	switch {
+	case addr != nil:
+		transfers, total, err = svr.coreService.TokenTransfersByAddress(addr, token, start, count)
+	case token != nil:
+		transfers, total, err = svr.coreService.TokenTransfersByToken(token, start, count)
+	default:
+		return nil, errInvalidFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	ret := &tokenTransfersResult{
+		Total:     uint64ToHex(total),
+		Transfers: make([]*tokenTransferResult, 0, len(transfers)),
+	}
+	for _, t := range transfers {
+		tr := &tokenTransferResult{
+			Standard:        t.Standard.String(),
+			Token:           common.BytesToAddress(t.Token.Bytes()).Hex(),
+			From:            common.BytesToAddress(t.From.Bytes()).Hex(),
+			To:              common.BytesToAddress(t.To.Bytes()).Hex(),
+			Value:           "0x" + t.Amount.Text(16),
+			BlockNumber:     uint64ToHex(t.BlockHeight),
+			TransactionHash: "0x" + hex.EncodeToString(t.ActionHash[:]),
+			LogIndex:        uint64ToHex(uint64(t.LogIndex)),
 		}
-		traceTimeout := options.Get("timeout")
-		if traceTimeout.Exists() {
-			tracerTimeout = new(string)
-			*tracerTimeout = traceTimeout.String()
+		if t.TokenID != nil {
+			tr.TokenID = "0x" + t.TokenID.Text(16)
 		}
+		ret.Transfers = append(ret.Transfers, tr)
 	}
-	cfg := &tracers.TraceConfig{
-		Tracer:  tracerJs,
-		Timeout: tracerTimeout,
-		Config: &logger.Config{
-			EnableMemory:     enableMemory,
-			DisableStack:     disableStack,
-			DisableStorage:   disableStorage,
-			EnableReturnData: enableReturnData,
-		},
-	}
+	return ret, nil
+}
 
-	retval, receipt, tracer, err := svr.coreService.TraceCall(ctx, callerAddr, blkNumOrHash, contractAddr, 0, value, gasLimit, callData, cfg)
+// getInternalTransfers returns the internal transfers of IOTX from or to an address
+func (svr *web3Handler) getInternalTransfers(in *gjson.Result) (interface{}, error) {
+	query := in.Get("params.0")
+	if !query.IsObject() {
+		return nil, errInvalidFormat
+	}
+	addr, err := ethAddrToIoAddr(query.Get("address").String())
 	if err != nil {
 		return nil, err
 	}
-	switch tracer := tracer.(type) {
-	case *logger.StructLogger:
-		return &debugTraceTransactionResult{
-			Failed:      receipt.Status != uint64(iotextypes.ReceiptStatus_Success),
-			Revert:      receipt.ExecutionRevertMsg(),
-			ReturnValue: byteToHex(retval),
-			StructLogs:  fromLoggerStructLogs(tracer.StructLogs()),
-			Gas:         receipt.GasConsumed,
-		}, nil
-	case tracers.Tracer:
-		return tracer.GetResult()
-	default:
-		return nil, fmt.Errorf("unknown tracer type: %T", tracer)
+	var start, count uint64 = 0, _defaultTokenTransferCount
+	if v := query.Get("start"); v.Exists() {
+		if start, err = hexStringToNumber(v.String()); err != nil {
+			return nil, err
+		}
 	}
+	if v := query.Get("count"); v.Exists() {
+		if count, err = hexStringToNumber(v.String()); err != nil {
+			return nil, err
+		}
+	}
+	transfers, total, err := svr.coreService.InternalTransfersByAddress(addr, start, count)
+	if err != nil {
+		return nil, err
+	}
+	// special addresses like the rewarding pool have no evm representation, and are returned as is
+	toEthAddr := func(ioAddr string) (string, error) {
+		if address.IsAddrV1Special(ioAddr) {
+			return ioAddr, nil
+		}
+		return ioAddrToEthAddr(ioAddr)
+	}
+	ret := &internalTransfersResult{
+		Total:     uint64ToHex(total),
+		Transfers: make([]*internalTransferResult, 0, len(transfers)),
+	}
+	for _, t := range transfers {
+		from, err := toEthAddr(t.Sender)
+		if err != nil {
+			return nil, err
+		}
+		to, err := toEthAddr(t.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		ret.Transfers = append(ret.Transfers, &internalTransferResult{
+			Type:            t.Type.String(),
+			From:            from,
+			To:              to,
+			Value:           "0x" + t.Amount.Text(16),
+			BlockNumber:     uint64ToHex(t.BlockHeight),
+			TransactionHash: "0x" + hex.EncodeToString(t.ActionHash[:]),
+		})
+	}
+	return ret, nil
 }
 
 func (svr *web3Handler) unimplemented() (interface{}, error) {
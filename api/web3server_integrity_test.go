@@ -231,7 +231,7 @@ func getBlockByNumber(t *testing.T, handler *hTTPHandler) {
 
 func getBalance(t *testing.T, handler *hTTPHandler) {
 	require := require.New(t)
-	result := serveTestHTTP(require, handler, "eth_getBalance", `["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", 1]`)
+	result := serveTestHTTP(require, handler, "eth_getBalance", `["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "latest"]`)
 	ans, ok := new(big.Int).SetString("9999999999999999999999999991", 10)
 	require.True(ok)
 	actual, ok := result.(string)
@@ -247,7 +247,7 @@ func getTransactionCount(t *testing.T, handler *hTTPHandler) {
 		params   string
 		expected int
 	}{
-		{`["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "0x1"]`, 2},
+		{`["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "latest"]`, 2},
 		{`["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "pending"]`, 2},
 	} {
 		result := serveTestHTTP(require, handler, "eth_getTransactionCount", test.params)
@@ -272,7 +272,7 @@ func ethCall(t *testing.T, handler *hTTPHandler) {
 				"value":    "0x1",
 				"data":     "0x1"
 			  },
-			1]`,
+			"latest"]`,
 			1,
 		},
 		{
@@ -284,7 +284,7 @@ func ethCall(t *testing.T, handler *hTTPHandler) {
 				"value":    "0x1",
 				"data":     "0x1"
 			   },
-			1]`,
+			"latest"]`,
 			0,
 		},
 	} {
@@ -784,7 +784,7 @@ func getCode(t *testing.T, handler *hTTPHandler, bc blockchain.Blockchain, dao b
 	contract, _ := deployContractV2(bc, dao, actPool, identityset.PrivateKey(13), 2, bc.TipHeight(), contractCode)
 	contractAddr, _ := ioAddrToEthAddr(contract)
 
-	result := serveTestHTTP(require, handler, "eth_getCode", fmt.Sprintf(`["%s", "0x1"]`, contractAddr))
+	result := serveTestHTTP(require, handler, "eth_getCode", fmt.Sprintf(`["%s", "latest"]`, contractAddr))
 	actual, ok := result.(string)
 	require.True(ok)
 	require.Contains(contractCode, util.Remove0xPrefix(actual))
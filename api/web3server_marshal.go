@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/hex"
 	"encoding/json"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -78,6 +79,57 @@ type (
 		HighestBlock  string `json:"highestBlock"`
 	}
 
+	txpoolStatusResult struct {
+		Pending    string `json:"pending"`
+		Queued     string `json:"queued"`
+		PendingGas string `json:"pendingGas"`
+		QueuedGas  string `json:"queuedGas"`
+	}
+
+	tokenTransfersResult struct {
+		Total     string                 `json:"total"`
+		Transfers []*tokenTransferResult `json:"transfers"`
+	}
+
+	tokenTransferResult struct {
+		Standard        string `json:"standard"`
+		Token           string `json:"token"`
+		From            string `json:"from"`
+		To              string `json:"to"`
+		TokenID         string `json:"tokenId,omitempty"`
+		Value           string `json:"value"`
+		BlockNumber     string `json:"blockNumber"`
+		TransactionHash string `json:"transactionHash"`
+		LogIndex        string `json:"logIndex"`
+	}
+
+	internalTransfersResult struct {
+		Total     string                    `json:"total"`
+		Transfers []*internalTransferResult `json:"transfers"`
+	}
+
+	internalTransferResult struct {
+		Type            string `json:"type"`
+		From            string `json:"from"`
+		To              string `json:"to"`
+		Value           string `json:"value"`
+		BlockNumber     string `json:"blockNumber"`
+		TransactionHash string `json:"transactionHash"`
+	}
+
+	indexerStatusResult struct {
+		Name   string `json:"name"`
+		Height string `json:"height"`
+		Lag    string `json:"lag"`
+	}
+
+	feeHistoryResult struct {
+		OldestBlock   string     `json:"oldestBlock"`
+		BaseFeePerGas []string   `json:"baseFeePerGas"`
+		GasUsedRatio  []float64  `json:"gasUsedRatio"`
+		Reward        [][]string `json:"reward,omitempty"`
+	}
+
 	debugTraceTransactionResult struct {
 		Failed      bool                 `json:"failed"`
 		Revert      string               `json:"revert"`
@@ -85,6 +137,29 @@ type (
 		Gas         uint64               `json:"gas"`
 		StructLogs  []apitypes.StructLog `json:"structLogs"`
 	}
+
+	getProofResult struct {
+		address address.Address
+		proof   *apitypes.AccountProof
+	}
+
+	createAccessListResult struct {
+		AccessList types.AccessList `json:"accessList"`
+		Error      string           `json:"error,omitempty"`
+		GasUsed    string           `json:"gasUsed"`
+	}
+
+	callBundleResult struct {
+		retval  []byte
+		receipt *action.Receipt
+		err     error
+	}
+
+	debugTraceBlockResult struct {
+		TxHash string      `json:"txHash"`
+		Result interface{} `json:"result,omitempty"`
+		Error  string      `json:"error,omitempty"`
+	}
 )
 
 var (
@@ -146,6 +221,7 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 		blkHash           hash.Hash256
 		producerAddress   string
 		logsBloomStr      string
+		baseFee           *string
 		gasLimit, gasUsed uint64
 
 		txs              = make([]interface{}, 0)
@@ -176,6 +252,10 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 	if len(obj.transactions) > 0 {
 		txs = obj.transactions
 	}
+	if fee := obj.blk.Header.BaseFee(); fee != nil {
+		feeHex := hexutil.EncodeBig(fee)
+		baseFee = &feeHex
+	}
 	return json.Marshal(&struct {
 		Author           string        `json:"author"`
 		Number           string        `json:"number"`
@@ -197,6 +277,7 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 		Transactions     []interface{} `json:"transactions"`
 		Step             string        `json:"step"`
 		Uncles           []string      `json:"uncles"`
+		BaseFeePerGas    *string       `json:"baseFeePerGas,omitempty"`
 	}{
 		Author:           producerAddr,
 		Number:           uint64ToHex(obj.blk.Height()),
@@ -218,6 +299,7 @@ func (obj *getBlockResult) MarshalJSON() ([]byte, error) {
 		Transactions:     txs,
 		Step:             "373422302",
 		Uncles:           []string{},
+		BaseFeePerGas:    baseFee,
 	})
 }
 
@@ -233,11 +315,25 @@ func (obj *getTransactionResult) MarshalJSON() ([]byte, error) {
 		blkNum      *string
 		txIndex     *string
 		blkHash     *string
+		txType      *string
+		chainID     *string
+		accessList  *types.AccessList
+		maxFee      *string
+		maxTip      *string
 	)
 
 	if obj.receipt != nil {
 		txHash = obj.receipt.ActionHash[:]
 	}
+	if obj.ethTx.Type() != types.LegacyTxType {
+		// typed tx (EIP-2718) carries its own chainID and access list
+		tmp, id, list := uint64ToHex(uint64(obj.ethTx.Type())), hexutil.EncodeBig(obj.ethTx.ChainId()), obj.ethTx.AccessList()
+		txType, chainID, accessList = &tmp, &id, &list
+	}
+	if obj.ethTx.Type() == types.DynamicFeeTxType {
+		fee, tip := hexutil.EncodeBig(obj.ethTx.GasFeeCap()), hexutil.EncodeBig(obj.ethTx.GasTipCap())
+		maxFee, maxTip = &fee, &tip
+	}
 	if obj.receipt != nil {
 		tmp := uint64ToHex(obj.receipt.BlockHeight)
 		blkNum = &tmp
@@ -265,6 +361,12 @@ func (obj *getTransactionResult) MarshalJSON() ([]byte, error) {
 		R                string  `json:"r"`
 		S                string  `json:"s"`
 		V                string  `json:"v"`
+
+		Type                 *string           `json:"type,omitempty"`
+		ChainID              *string           `json:"chainId,omitempty"`
+		AccessList           *types.AccessList `json:"accessList,omitempty"`
+		MaxFeePerGas         *string           `json:"maxFeePerGas,omitempty"`
+		MaxPriorityFeePerGas *string           `json:"maxPriorityFeePerGas,omitempty"`
 	}{
 		Hash:             "0x" + hex.EncodeToString(txHash),
 		Nonce:            uint64ToHex(obj.ethTx.Nonce()),
@@ -280,6 +382,12 @@ func (obj *getTransactionResult) MarshalJSON() ([]byte, error) {
 		R:                hexutil.EncodeBig(r),
 		S:                hexutil.EncodeBig(s),
 		V:                hexutil.EncodeBig(v),
+
+		Type:                 txType,
+		ChainID:              chainID,
+		AccessList:           accessList,
+		MaxFeePerGas:         maxFee,
+		MaxPriorityFeePerGas: maxTip,
 	})
 }
 
@@ -356,6 +464,127 @@ func (obj *getLogsResult) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (obj *getProofResult) MarshalJSON() ([]byte, error) {
+	if obj.proof == nil || obj.proof.Account == nil {
+		return nil, errInvalidObject
+	}
+	type storageProof struct {
+		Key   string       `json:"key"`
+		Value *hexutil.Big `json:"value"`
+		Proof []string     `json:"proof"`
+	}
+	storageProofs := make([]storageProof, 0, len(obj.proof.StorageProofs))
+	for _, sp := range obj.proof.StorageProofs {
+		storageProofs = append(storageProofs, storageProof{
+			Key:   "0x" + hex.EncodeToString(sp.Key[:]),
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(sp.Value)),
+			Proof: bytesListToHex(sp.Proof),
+		})
+	}
+	account := obj.proof.Account
+	codeHash := account.CodeHash
+	if len(codeHash) == 0 {
+		codeHash = types.EmptyCodeHash.Bytes()
+	}
+	return json.Marshal(&struct {
+		Address      string         `json:"address"`
+		AccountProof []string       `json:"accountProof"`
+		Balance      *hexutil.Big   `json:"balance"`
+		CodeHash     string         `json:"codeHash"`
+		Nonce        string         `json:"nonce"`
+		StorageHash  string         `json:"storageHash"`
+		StorageProof []storageProof `json:"storageProof"`
+	}{
+		Address:      "0x" + hex.EncodeToString(obj.address.Bytes()),
+		AccountProof: bytesListToHex(obj.proof.Proof),
+		Balance:      (*hexutil.Big)(account.Balance),
+		CodeHash:     "0x" + hex.EncodeToString(codeHash),
+		Nonce:        uint64ToHex(account.PendingNonceConsideringFreshAccount()),
+		StorageHash:  "0x" + hex.EncodeToString(obj.proof.StorageRoot),
+		StorageProof: storageProofs,
+	})
+}
+
+func (obj *callBundleResult) MarshalJSON() ([]byte, error) {
+	// a signed transaction failing the validation is not run
+	if obj.err != nil {
+		errMsg := obj.err.Error()
+		if s, ok := status.FromError(obj.err); ok {
+			errMsg = s.Message()
+		}
+		return json.Marshal(&struct {
+			Error string `json:"error"`
+		}{
+			Error: errMsg,
+		})
+	}
+	if obj.receipt == nil {
+		return nil, errInvalidObject
+	}
+	logs := make([]*getLogsResult, 0, len(obj.receipt.Logs()))
+	for _, v := range obj.receipt.Logs() {
+		logs = append(logs, &getLogsResult{hash.ZeroHash256, v})
+	}
+	// the balance diffs are summed up from the transaction logs, an empty sender or recipient means minted or burned
+	diffs := make(map[string]*big.Int)
+	addDiff := func(addr string, amount *big.Int) error {
+		if addr == "" || amount == nil {
+			return nil
+		}
+		ethAddr, err := ioAddrToEthAddr(addr)
+		if err != nil {
+			return err
+		}
+		if _, ok := diffs[ethAddr]; !ok {
+			diffs[ethAddr] = new(big.Int)
+		}
+		diffs[ethAddr].Add(diffs[ethAddr], amount)
+		return nil
+	}
+	for _, l := range obj.receipt.TransactionLogs() {
+		if l.Amount == nil {
+			continue
+		}
+		if err := addDiff(l.Sender, new(big.Int).Neg(l.Amount)); err != nil {
+			return nil, err
+		}
+		if err := addDiff(l.Recipient, l.Amount); err != nil {
+			return nil, err
+		}
+	}
+	balanceDiffs := make(map[string]*hexutil.Big, len(diffs))
+	for addr, diff := range diffs {
+		if diff.Sign() != 0 {
+			balanceDiffs[addr] = (*hexutil.Big)(diff)
+		}
+	}
+	return json.Marshal(&struct {
+		TransactionHash string                  `json:"transactionHash"`
+		Status          string                  `json:"status"`
+		GasUsed         string                  `json:"gasUsed"`
+		ReturnData      string                  `json:"returnData"`
+		Revert          string                  `json:"revert,omitempty"`
+		Logs            []*getLogsResult        `json:"logs"`
+		BalanceDiffs    map[string]*hexutil.Big `json:"balanceDiffs"`
+	}{
+		TransactionHash: "0x" + hex.EncodeToString(obj.receipt.ActionHash[:]),
+		Status:          uint64ToHex(obj.receipt.Status),
+		GasUsed:         uint64ToHex(obj.receipt.GasConsumed),
+		ReturnData:      "0x" + hex.EncodeToString(obj.retval),
+		Revert:          obj.receipt.ExecutionRevertMsg(),
+		Logs:            logs,
+		BalanceDiffs:    balanceDiffs,
+	})
+}
+
+func bytesListToHex(list [][]byte) []string {
+	ret := make([]string, 0, len(list))
+	for _, b := range list {
+		ret = append(ret, "0x"+hex.EncodeToString(b))
+	}
+	return ret
+}
+
 func (obj *streamResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
 		Jsonrpc string       `json:"jsonrpc"`
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
@@ -14,21 +15,31 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/go-pkgs/util"
+	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-proto/golang/iotexapi"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockindex"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
 	mock_apitypes "github.com/iotexproject/iotex-core/test/mock/mock_apiresponder"
@@ -170,7 +181,7 @@ func TestGasPrice(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().SuggestGasPrice().Return(uint64(1), nil)
 	ret, err := web3svr.gasPrice()
 	require.NoError(err)
@@ -181,12 +192,55 @@ func TestGasPrice(t *testing.T) {
 	require.Equal("mock gas price error", err.Error())
 }
 
+func TestMaxPriorityFee(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().SuggestGasTipCap().Return(big.NewInt(1000), nil)
+	ret, err := web3svr.maxPriorityFee()
+	require.NoError(err)
+	require.Equal("0x3e8", ret.(string))
+}
+
+func TestFeeHistory(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().TipHeight().Return(uint64(10))
+	core.EXPECT().FeeHistory(gomock.Any(), uint64(2), uint64(10), []float64{20, 80}).Return(
+		uint64(9),
+		[][]*big.Int{{big.NewInt(1), big.NewInt(2)}, {big.NewInt(3), big.NewInt(4)}},
+		[]*big.Int{big.NewInt(100), big.NewInt(110), big.NewInt(120)},
+		[]float64{0.5, 0.75},
+		nil,
+	)
+	in := gjson.Parse(`{"params":["0x2", "latest", [20, 80]]}`)
+	ret, err := web3svr.feeHistory(context.Background(), &in)
+	require.NoError(err)
+	res, err := json.Marshal(ret)
+	require.NoError(err)
+	require.JSONEq(`{
+		"oldestBlock": "0x9",
+		"baseFeePerGas": ["0x64", "0x6e", "0x78"],
+		"gasUsedRatio": [0.5, 0.75],
+		"reward": [["0x1", "0x2"], ["0x3", "0x4"]]
+	}`, string(res))
+
+	in = gjson.Parse(`{"params":["0x2"]}`)
+	_, err = web3svr.feeHistory(context.Background(), &in)
+	require.ErrorIs(err, errInvalidFormat)
+}
+
 func TestGetChainID(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().EVMNetworkID().Return(uint32(1))
 	ret, err := web3svr.getChainID()
 	require.NoError(err)
@@ -198,7 +252,7 @@ func TestGetBlockNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().TipHeight().Return(uint64(1))
 	ret, err := web3svr.getBlockNumber()
 	require.NoError(err)
@@ -210,7 +264,7 @@ func TestGetBlockByNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -261,9 +315,10 @@ func TestGetBalance(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	balance := "111111111111111111"
-	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{Balance: balance}, nil, nil)
+	core.EXPECT().TipHeight().Return(uint64(3)).AnyTimes()
+	core.EXPECT().AccountAtHeight(gomock.Any(), gomock.Any(), uint64(1)).Return(&iotextypes.AccountMeta{Balance: balance}, nil).Times(2)
 
 	in := gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", 1]}`)
 	ret, err := web3svr.getBalance(&in)
@@ -271,6 +326,37 @@ func TestGetBalance(t *testing.T) {
 	ans, ok := new(big.Int).SetString(balance, 10)
 	require.True(ok)
 	require.Equal("0x"+fmt.Sprintf("%x", ans), ret.(string))
+
+	// EIP-1898 block hash object
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetPrevBlockHash(hash.ZeroHash256).
+		SetTimeStamp(time.Now()).
+		SignAndBuild(identityset.PrivateKey(0))
+	require.NoError(err)
+	core.EXPECT().BlockByHash("0123").Return(&apitypes.BlockWithReceipts{Block: &blk}, nil)
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", {"blockHash": "0x0123"}]}`)
+	ret, err = web3svr.getBalance(&in)
+	require.NoError(err)
+	require.Equal("0x"+fmt.Sprintf("%x", ans), ret.(string))
+
+	// the tip
+	core.EXPECT().AccountAtHeight(gomock.Any(), gomock.Any(), uint64(3)).Return(&iotextypes.AccountMeta{Balance: "1"}, nil)
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "latest"]}`)
+	ret, err = web3svr.getBalance(&in)
+	require.NoError(err)
+	require.Equal("0x1", ret.(string))
+
+	// a block beyond the tip
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", {"blockNumber": "0x4"}]}`)
+	_, err = web3svr.getBalance(&in)
+	require.Equal(codes.NotFound, status.Code(err))
+
+	// the node does not keep the state
+	core.EXPECT().AccountAtHeight(gomock.Any(), gomock.Any(), uint64(2)).Return(nil, historyStateError(2, factory.ErrNoArchiveData))
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "0x2"]}`)
+	_, err = web3svr.getBalance(&in)
+	require.ErrorContains(err, "missing trie node")
 }
 
 func TestGetTransactionCount(t *testing.T) {
@@ -278,17 +364,24 @@ func TestGetTransactionCount(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().TipHeight().Return(uint64(3)).AnyTimes()
 	core.EXPECT().PendingNonce(gomock.Any()).Return(uint64(2), nil)
+	core.EXPECT().AccountAtHeight(gomock.Any(), gomock.Any(), uint64(1)).Return(&iotextypes.AccountMeta{PendingNonce: 1}, nil)
 
 	inNil := gjson.Parse(`{"params":[]}`)
 	ret, err := web3svr.getTransactionCount(&inNil)
 	require.EqualError(err, errInvalidFormat.Error())
 
-	in := gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", 1]}`)
+	in := gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", "pending"]}`)
 	ret, err = web3svr.getTransactionCount(&in)
 	require.NoError(err)
 	require.Equal("0x2", ret.(string))
+
+	in = gjson.Parse(`{"params":["0xDa7e12Ef57c236a06117c5e0d04a228e7181CF36", 1]}`)
+	ret, err = web3svr.getTransactionCount(&in)
+	require.NoError(err)
+	require.Equal("0x1", ret.(string))
 }
 
 func TestCall(t *testing.T) {
@@ -296,7 +389,8 @@ func TestCall(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().TipHeight().Return(uint64(1)).AnyTimes()
 
 	t.Run("to is StakingProtocol addr", func(t *testing.T) {
 		meta := &iotextypes.AccountMeta{
@@ -340,8 +434,21 @@ func TestCall(t *testing.T) {
 		require.Equal("0x0000000000000000000000000000000000000000000000000000000000002710", ret.(string))
 	})
 
+	t.Run("override on native protocol", func(t *testing.T) {
+		for _, to := range []string{"0x04C22AfaE6a03438b8FED74cb1Cf441168DF3F12", "0xA576C141e5659137ddDa4223d209d4744b2106BE"} {
+			in := gjson.Parse(fmt.Sprintf(`{"params":[{
+				"from": "",
+				"to":   "%s",
+				"data": "ad7a672f"
+			   },
+			   1, {"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {"balance": "0x1"}}]}`, to))
+			_, err := web3svr.call(&in)
+			require.Equal(codes.InvalidArgument, status.Code(err))
+		}
+	})
+
 	t.Run("to is contract addr", func(t *testing.T) {
-		core.EXPECT().ReadContract(gomock.Any(), gomock.Any(), gomock.Any()).Return("111111", nil, nil)
+		core.EXPECT().ReadContractAtHeight(gomock.Any(), gomock.Any(), gomock.Any(), uint64(1)).Return("111111", nil, nil)
 		in := gjson.Parse(`{"params":[{
 			"from":     "",
 			"to":       "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5",
@@ -367,7 +474,7 @@ func TestCall(t *testing.T) {
 			ExecutionRevertMsg: "revert call",
 			TxIndex:            0,
 		}
-		core.EXPECT().ReadContract(gomock.Any(), gomock.Any(), gomock.Any()).Return("", receipt, nil)
+		core.EXPECT().ReadContractAtHeight(gomock.Any(), gomock.Any(), gomock.Any(), uint64(1)).Return("", receipt, nil)
 		in := gjson.Parse(`{"params":[{
 			"from":     "",
 			"to":       "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5",
@@ -387,8 +494,8 @@ func TestEstimateGas(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
-	core.EXPECT().ChainID().Return(uint32(1)).Times(2)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().ChainID().Return(uint32(1)).Times(3)
 
 	t.Run("estimate execution", func(t *testing.T) {
 		core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{IsContract: true}, nil, nil)
@@ -425,6 +532,21 @@ func TestEstimateGas(t *testing.T) {
 		require.NoError(err)
 		require.Equal(uint64ToHex(uint64(36000)), ret.(string))
 	})
+
+	t.Run("override on nonexecution", func(t *testing.T) {
+		core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{IsContract: false}, nil, nil)
+
+		in := gjson.Parse(`{"params":[{
+			"from":     "",
+			"to":       "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5",
+			"gas":      "0x4e20",
+			"gasPrice": "0xe8d4a51000",
+			"value":    "0x1"
+		   },
+		   1, null, {"number": "0x10"}]}`)
+		_, err := web3svr.estimateGas(&in)
+		require.Equal(codes.InvalidArgument, status.Code(err))
+	})
 }
 
 func TestSendRawTransaction(t *testing.T) {
@@ -432,7 +554,7 @@ func TestSendRawTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().EVMNetworkID().Return(uint32(1))
 	core.EXPECT().ChainID().Return(uint32(1))
 	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{IsContract: true}, nil, nil)
@@ -452,15 +574,139 @@ func TestSendRawTransaction(t *testing.T) {
 	})
 }
 
+func TestWeb3CreateAccessList(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().TipHeight().Return(uint64(10)).AnyTimes()
+	// the request context is passed to the core service
+	ctx := context.WithValue(context.Background(), struct{}{}, "request")
+
+	var (
+		contract = common.HexToAddress("0x7c13866F9253DEf79e20034eDD011e1d69E67fe5")
+		slot     = common.BigToHash(big.NewInt(1))
+	)
+	t.Run("create access list", func(t *testing.T) {
+		core.EXPECT().CreateAccessList(ctx, gomock.Any(), gomock.Any(), uint64(10)).DoAndReturn(
+			func(_ context.Context, _ address.Address, exec *action.Execution, _ uint64) (types.AccessList, *action.Receipt, error) {
+				require.Equal(types.AccessList{{Address: contract, StorageKeys: []common.Hash{}}}, exec.AccessList())
+				return types.AccessList{{Address: contract, StorageKeys: []common.Hash{slot}}}, &action.Receipt{
+					Status:      uint64(iotextypes.ReceiptStatus_Success),
+					GasConsumed: 23000,
+				}, nil
+			})
+		in := gjson.Parse(`{"params":[{
+				"from":       "",
+				"to":         "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5",
+				"data":       "0x6d4ce63c",
+				"accessList": [{"address": "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5", "storageKeys": []}]
+			},
+			"latest"]}`)
+		ret, err := web3svr.createAccessList(ctx, &in)
+		require.NoError(err)
+		raw, err := json.Marshal(ret)
+		require.NoError(err)
+		result := gjson.ParseBytes(raw)
+		require.Equal(uint64ToHex(23000), result.Get("gasUsed").String())
+		require.Equal(strings.ToLower(contract.Hex()), result.Get("accessList.0.address").String())
+		require.Equal(slot.Hex(), result.Get("accessList.0.storageKeys.0").String())
+		require.False(result.Get("error").Exists())
+	})
+
+	t.Run("reverted call", func(t *testing.T) {
+		receipt := &action.Receipt{Status: uint64(iotextypes.ReceiptStatus_ErrExecutionReverted)}
+		receipt.SetExecutionRevertMsg("not allowed")
+		core.EXPECT().CreateAccessList(ctx, gomock.Any(), gomock.Any(), uint64(10)).Return(nil, receipt, nil)
+		in := gjson.Parse(`{"params":[{"to": "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"}]}`)
+		ret, err := web3svr.createAccessList(ctx, &in)
+		require.NoError(err)
+		raw, err := json.Marshal(ret)
+		require.NoError(err)
+		result := gjson.ParseBytes(raw)
+		require.Equal("execution reverted: not allowed", result.Get("error").String())
+		require.Equal("[]", result.Get("accessList").Raw)
+	})
+}
+
+func TestCallBundle(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	ctx := context.WithValue(context.Background(), struct{}{}, "request")
+
+	t.Run("empty bundle", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[[], "latest"]}`)
+		_, err := web3svr.callBundle(ctx, &in)
+		require.ErrorIs(err, errInvalidFormat)
+	})
+
+	t.Run("call and raw transaction", func(t *testing.T) {
+		core.EXPECT().TipHeight().Return(uint64(10)).Times(2)
+		core.EXPECT().EVMNetworkID().Return(uint32(1)).Times(2)
+		core.EXPECT().ChainID().Return(uint32(1)).Times(2)
+		core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{IsContract: false}, nil, nil).Times(2)
+		var (
+			sender    = identityset.Address(1)
+			recipient = identityset.Address(2)
+		)
+		core.EXPECT().SimulateActions(ctx, uint64(10), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uint64, acts []*action.SimulatedAction) ([][]byte, []*action.Receipt, []error, error) {
+				require.Len(acts, 2)
+				require.Equal(sender.String(), acts[0].Caller().String())
+				tsf, ok := acts[0].Envelope().Action().(*action.Transfer)
+				require.True(ok)
+				require.Equal(big.NewInt(5), tsf.Amount())
+				receipt := &action.Receipt{
+					Status:      uint64(iotextypes.ReceiptStatus_Success),
+					GasConsumed: 10000,
+				}
+				receipt.AddTransactionLogs(&action.TransactionLog{
+					Type:      iotextypes.TransactionLogType_NATIVE_TRANSFER,
+					Sender:    sender.String(),
+					Recipient: recipient.String(),
+					Amount:    big.NewInt(5),
+				})
+				return [][]byte{nil, nil}, []*action.Receipt{receipt, nil}, []error{nil, status.Error(codes.InvalidArgument, "nonce too high")}, nil
+			})
+
+		in := gjson.Parse(fmt.Sprintf(`{"params":[[{
+				"from":  "%s",
+				"to":    "%s",
+				"gas":   "0x4e20",
+				"value": "0x5"
+			},
+			"f8600180830186a09412745fec82b585f239c01090882eb40702c32b04808025a0b0e1aab5b64d744ae01fc9f1c3e9919844a799e90c23129d611f7efe6aec8a29a0195e28d22d9b280e00d501ff63525bb76f5c87b8646c89d5d9c5485edcb1b498"],
+			"latest"]}`, common.BytesToAddress(sender.Bytes()).Hex(), common.BytesToAddress(recipient.Bytes()).Hex()))
+		ret, err := web3svr.callBundle(ctx, &in)
+		require.NoError(err)
+		raw, err := json.Marshal(ret)
+		require.NoError(err)
+		results := gjson.ParseBytes(raw)
+		require.Len(results.Array(), 2)
+		require.Equal("0x1", results.Get("0.status").String())
+		require.Equal(uint64ToHex(10000), results.Get("0.gasUsed").String())
+		diffs := results.Get("0.balanceDiffs").Map()
+		require.Equal("-0x5", diffs[common.BytesToAddress(sender.Bytes()).Hex()].String())
+		require.Equal("0x5", diffs[common.BytesToAddress(recipient.Bytes()).Hex()].String())
+		require.Equal("nonce too high", results.Get("1.error").String())
+		require.False(results.Get("1.status").Exists())
+	})
+}
+
 func TestGetCode(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	code := "608060405234801561001057600080fd5b50610150806100206contractbytecode"
 	data, _ := hex.DecodeString(code)
-	core.EXPECT().Account(gomock.Any()).Return(&iotextypes.AccountMeta{ContractByteCode: data}, nil, nil)
+	core.EXPECT().TipHeight().Return(uint64(1)).AnyTimes()
+	core.EXPECT().AccountAtHeight(gomock.Any(), gomock.Any(), uint64(1)).Return(&iotextypes.AccountMeta{ContractByteCode: data}, nil)
 
 	t.Run("nil params", func(t *testing.T) {
 		inNil := gjson.Parse(`{"params":[]}`)
@@ -481,7 +727,7 @@ func TestGetNodeInfo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().ServerMeta().Return("111", "", "", "222", "")
 	ret, err := web3svr.getNodeInfo()
 	require.NoError(err)
@@ -493,7 +739,7 @@ func TestGetNetworkID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().EVMNetworkID().Return(uint32(123))
 	ret, err := web3svr.getNetworkID()
 	require.NoError(err)
@@ -505,7 +751,7 @@ func TestIsSyncing(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().SyncingProgress().Return(uint64(1), uint64(2), uint64(3))
 	ret, err := web3svr.isSyncing()
 	require.NoError(err)
@@ -521,7 +767,7 @@ func TestGetBlockTransactionCountByHash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -557,7 +803,7 @@ func TestGetBlockByHash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -609,7 +855,7 @@ func TestGetTransactionByHash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	selp, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -679,7 +925,7 @@ func TestGetLogs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	logs := []*action.Log{
 		{
@@ -728,7 +974,7 @@ func TestGetTransactionReceipt(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	selp, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -776,7 +1022,7 @@ func TestGetBlockTransactionCountByNumber(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -811,7 +1057,7 @@ func TestGetTransactionByBlockHashAndIndex(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -858,7 +1104,7 @@ func TestGetTransactionByBlockNumberAndIndex(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	tsf, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -904,14 +1150,167 @@ func TestGetStorageAt(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 	val := []byte("test")
-	core.EXPECT().ReadContractStorage(gomock.Any(), gomock.Any(), gomock.Any()).Return(val, nil)
+	core.EXPECT().TipHeight().Return(uint64(3)).AnyTimes()
+	core.EXPECT().ReadContractStorageAtHeight(gomock.Any(), gomock.Any(), gomock.Any(), uint64(3)).Return(val, nil)
+	core.EXPECT().ReadContractStorageAtHeight(gomock.Any(), gomock.Any(), gomock.Any(), uint64(2)).Return([]byte{1}, nil)
 
 	in := gjson.Parse(`{"params":["0x123456789abc", "0"]}`)
 	ret, err := web3svr.getStorageAt(&in)
 	require.NoError(err)
 	require.Equal("0x"+hex.EncodeToString(val), ret.(string))
+
+	in = gjson.Parse(`{"params":["0x123456789abc", "0", "0x2"]}`)
+	ret, err = web3svr.getStorageAt(&in)
+	require.NoError(err)
+	require.Equal("0x01", ret.(string))
+}
+
+func TestGetProof(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	ctx := context.Background()
+	addr := identityset.Address(28)
+	slot := hash.BytesToHash256([]byte{1})
+	proof := &apitypes.AccountProof{
+		Account:     &state.Account{Balance: big.NewInt(100)},
+		StateRoot:   []byte("stateRoot"),
+		Proof:       [][]byte{[]byte("node1"), []byte("node2")},
+		StorageRoot: []byte("storageRoot"),
+		StorageProofs: []*evm.StorageSlotProof{
+			{Key: slot, Value: []byte{0x12, 0x34}, Proof: [][]byte{[]byte("slotNode")}},
+		},
+	}
+	core.EXPECT().TipHeight().Return(uint64(10)).AnyTimes()
+	core.EXPECT().StateProof(ctx, addr, []hash.Hash256{slot}, uint64(10)).Return(proof, nil)
+	core.EXPECT().StateProof(ctx, addr, []hash.Hash256{}, uint64(5)).Return(nil, errors.New("no archive data")).Times(2)
+
+	t.Run("invalid params", func(t *testing.T) {
+		for _, str := range []string{
+			`{"params":[]}`,
+			`{"params":["` + addr.Hex() + `", "0x1", "latest"]}`,
+			`{"params":["` + addr.Hex() + `", ["0x` + strings.Repeat("11", 33) + `"], "latest"]}`,
+		} {
+			in := gjson.Parse(str)
+			_, err := web3svr.getProof(ctx, &in)
+			require.Error(err)
+		}
+	})
+
+	t.Run("get proof", func(t *testing.T) {
+		in := gjson.Parse(`{"params":["` + addr.Hex() + `", ["0x01"], "latest"]}`)
+		ret, err := web3svr.getProof(ctx, &in)
+		require.NoError(err)
+		rlt, ok := ret.(*getProofResult)
+		require.True(ok)
+		require.Equal(proof, rlt.proof)
+		data, err := json.Marshal(rlt)
+		require.NoError(err)
+		require.JSONEq(`{
+			"address":"`+addr.Hex()+`",
+			"accountProof":["0x6e6f646531","0x6e6f646532"],
+			"balance":"0x64",
+			"codeHash":"0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+			"nonce":"0x0",
+			"storageHash":"0x73746f72616765526f6f74",
+			"storageProof":[{
+				"key":"0x`+hex.EncodeToString(slot[:])+`",
+				"value":"0x1234",
+				"proof":["0x736c6f744e6f6465"]
+			}]
+		}`, string(data))
+	})
+
+	t.Run("failed to get proof", func(t *testing.T) {
+		in := gjson.Parse(`{"params":["` + addr.Hex() + `", [], "0x5"]}`)
+		_, err := web3svr.getProof(ctx, &in)
+		require.EqualError(err, "no archive data")
+		in = gjson.Parse(`{"params":["` + addr.Hex() + `", [], {"blockNumber":"0x5"}]}`)
+		_, err = web3svr.getProof(ctx, &in)
+		require.EqualError(err, "no archive data")
+		in = gjson.Parse(`{"params":["` + addr.Hex() + `", [], "0xb"]}`)
+		_, err = web3svr.getProof(ctx, &in)
+		require.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestTxpool(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+
+	sender := identityset.Address(27)
+	newTsf := func(nonce uint64) *action.SealedEnvelope {
+		selp, err := action.SignedTransfer(identityset.Address(28).String(), identityset.PrivateKey(27), nonce, big.NewInt(10), []byte{}, uint64(21000), big.NewInt(1))
+		require.NoError(err)
+		return selp
+	}
+	exec, err := action.SignedExecution("", identityset.PrivateKey(27), 4, big.NewInt(0), 100000, big.NewInt(2), []byte("test"))
+	require.NoError(err)
+	// the actions which are not eth compatible are neither shown nor counted
+	elp := (&action.EnvelopeBuilder{}).SetNonce(1).SetGasLimit(100000).SetGasPrice(big.NewInt(1)).
+		SetAction(action.NewPutPollResult(1, 1, nil)).Build()
+	poll, err := action.Sign(elp, identityset.PrivateKey(29))
+	require.NoError(err)
+	pending := map[string][]*action.SealedEnvelope{
+		sender.String():                  {newTsf(1), newTsf(2)},
+		identityset.Address(29).String(): {poll},
+	}
+	queued := map[string][]*action.SealedEnvelope{
+		sender.String(): {exec, poll},
+	}
+	core.EXPECT().ActionsInActPoolBySender().Return(pending, queued).Times(3)
+	core.EXPECT().EVMNetworkID().Return(uint32(0)).Times(3)
+	senderHex, err := ioAddrToEthAddr(sender.String())
+	require.NoError(err)
+	to := common.BytesToAddress(identityset.Address(28).Bytes()).Hex()
+
+	t.Run("status", func(t *testing.T) {
+		ret, err := web3svr.txpoolStatus()
+		require.NoError(err)
+		data, err := json.Marshal(ret)
+		require.NoError(err)
+		require.JSONEq(`{"pending":"0x2","queued":"0x1","pendingGas":"0xa410","queuedGas":"0x186a0"}`, string(data))
+	})
+
+	t.Run("content", func(t *testing.T) {
+		ret, err := web3svr.txpoolContent()
+		require.NoError(err)
+		content, ok := ret.(map[string]map[string]map[string]interface{})
+		require.True(ok)
+		require.Len(content["pending"], 1)
+		require.Len(content["pending"][senderHex], 2)
+		require.Len(content["queued"][senderHex], 1)
+		tx, ok := content["pending"][senderHex]["2"].(*getTransactionResult)
+		require.True(ok)
+		require.Equal(uint64(2), tx.ethTx.Nonce())
+		require.Nil(tx.blockHash)
+		tx, ok = content["queued"][senderHex]["4"].(*getTransactionResult)
+		require.True(ok)
+		require.Nil(tx.to)
+	})
+
+	t.Run("inspect", func(t *testing.T) {
+		ret, err := web3svr.txpoolInspect()
+		require.NoError(err)
+		data, err := json.Marshal(ret)
+		require.NoError(err)
+		require.JSONEq(`{
+			"pending":{"`+senderHex+`":{
+				"1":"`+to+`: 10 wei + 21000 gas × 1 wei",
+				"2":"`+to+`: 10 wei + 21000 gas × 1 wei"
+			}},
+			"queued":{"`+senderHex+`":{
+				"4":"contract creation: 0 wei + 100000 gas × 2 wei"
+			}}
+		}`, string(data))
+	})
 }
 
 func TestNewfilter(t *testing.T) {
@@ -919,7 +1318,7 @@ func TestNewfilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit, false, nil}
 
 	ret, err := web3svr.newFilter(&filterObject{
 		FromBlock: "1",
@@ -936,7 +1335,7 @@ func TestNewBlockFilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().TipHeight().Return(uint64(123))
 
 	ret, err := web3svr.newBlockFilter()
@@ -949,7 +1348,7 @@ func TestUninstallFilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit, false, nil}
 
 	require.NoError(web3svr.cache.Set("123456789abc", []byte("test")))
 
@@ -972,7 +1371,7 @@ func TestGetFilterChanges(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit, false, nil}
 	core.EXPECT().TipHeight().Return(uint64(0)).Times(3)
 
 	t.Run("log filterType", func(t *testing.T) {
@@ -1057,7 +1456,7 @@ func TestGetFilterLogs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, newAPICache(1*time.Second, ""), _defaultBatchRequestLimit, false, nil}
 
 	logs := []*action.Log{
 		{
@@ -1113,7 +1512,7 @@ func TestSubscribe(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	listener := mock_apitypes.NewMockListener(ctrl)
 	listener.EXPECT().AddResponder(gomock.Any()).Return("streamid_1", nil).Times(3)
@@ -1159,7 +1558,7 @@ func TestUnsubscribe(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	listener := mock_apitypes.NewMockListener(ctrl)
 	listener.EXPECT().RemoveResponder(gomock.Any()).Return(true, nil)
@@ -1199,7 +1598,7 @@ func TestDebugTraceTransaction(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	ctx := context.Background()
 	tsf, err := action.SignedExecution(identityset.Address(29).String(),
@@ -1238,7 +1637,7 @@ func TestDebugTraceCall(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	ctx := context.Background()
 	tsf, err := action.SignedExecution(identityset.Address(29).String(),
@@ -1250,9 +1649,10 @@ func TestDebugTraceCall(t *testing.T) {
 	receipt := &action.Receipt{Status: 1, BlockHeight: 1, ActionHash: tsfhash, GasConsumed: 100000}
 	structLogger := &logger.StructLogger{}
 
-	core.EXPECT().TraceCall(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]byte{0x01}, receipt, structLogger, nil)
+	core.EXPECT().TipHeight().Return(uint64(2)).AnyTimes()
+	core.EXPECT().TraceCall(ctx, gomock.Any(), uint64(1), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]byte{0x01}, receipt, structLogger, nil)
 
-	in := gjson.Parse(`{"method":"debug_traceCall","params":[{"from":null,"to":"0x6b175474e89094c44da98b954eedeac495271d0f","data":"0x70a082310000000000000000000000006E0d01A76C3Cf4288372a29124A26D4353EE51BE"}, {"blockNumber":1}],"id":1,"jsonrpc":"2.0"}`)
+	in := gjson.Parse(`{"method":"debug_traceCall","params":[{"from":null,"to":"0x6b175474e89094c44da98b954eedeac495271d0f","data":"0x70a082310000000000000000000000006E0d01A76C3Cf4288372a29124A26D4353EE51BE"}, {"blockNumber":"0x1"}],"id":1,"jsonrpc":"2.0"}`)
 	ret, err := web3svr.traceCall(ctx, &in)
 	require.NoError(err)
 	rlt, ok := ret.(*debugTraceTransactionResult)
@@ -1262,6 +1662,77 @@ func TestDebugTraceCall(t *testing.T) {
 	require.Equal(uint64(100000), rlt.Gas)
 	require.Empty(rlt.Revert)
 	require.Equal(0, len(rlt.StructLogs))
+
+	// the block is beyond the tip
+	in = gjson.Parse(`{"method":"debug_traceCall","params":[{"from":null,"to":"0x6b175474e89094c44da98b954eedeac495271d0f","data":"0x"}, "0x3"],"id":1,"jsonrpc":"2.0"}`)
+	_, err = web3svr.traceCall(ctx, &in)
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestDebugTraceBlock(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, true, nil}
+
+	ctx := context.Background()
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetTimeStamp(testutil.TimestampNow()).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	blkHash := blk.HashBlock()
+	receipts := []*action.Receipt{
+		{Status: 1, BlockHeight: 1, ActionHash: hash.Hash256b([]byte("exec")), GasConsumed: 100000},
+		{Status: 1, BlockHeight: 1, ActionHash: hash.Hash256b([]byte("call")), GasConsumed: 10000},
+		{Status: 1, BlockHeight: 1, ActionHash: hash.Hash256b([]byte("transfer")), GasConsumed: 10000},
+	}
+	callTracer, err := tracers.DefaultDirectory.New("callTracer", new(tracers.Context), nil)
+	require.NoError(err)
+	core.EXPECT().TipHeight().Return(uint64(1)).AnyTimes()
+	core.EXPECT().BlockByHeight(uint64(1)).Return(&apitypes.BlockWithReceipts{Block: &blk}, nil).AnyTimes()
+	core.EXPECT().BlockByHash(hex.EncodeToString(blkHash[:])).Return(&apitypes.BlockWithReceipts{Block: &blk}, nil).AnyTimes()
+	core.EXPECT().TraceBlock(ctx, &blk, gomock.Any()).Return(receipts, []any{&logger.StructLogger{}, callTracer, nil}, nil).AnyTimes()
+
+	t.Run("debug api disabled", func(t *testing.T) {
+		svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+		in := gjson.Parse(`{"params":["0x1"]}`)
+		_, err := svr.debugTrace(ctx, "debug_traceBlockByNumber", &in)
+		require.ErrorIs(err, errDebugAPIDisabled)
+	})
+
+	t.Run("nil params", func(t *testing.T) {
+		inNil := gjson.Parse(`{"params":[]}`)
+		_, err := web3svr.debugTrace(ctx, "debug_traceBlockByNumber", &inNil)
+		require.EqualError(err, errInvalidFormat.Error())
+		_, err = web3svr.debugTrace(ctx, "debug_traceBlockByHash", &inNil)
+		require.EqualError(err, errInvalidFormat.Error())
+	})
+
+	for _, in := range []gjson.Result{
+		gjson.Parse(`{"method":"debug_traceBlockByNumber","params":["latest"]}`),
+		gjson.Parse(`{"method":"debug_traceBlockByHash","params":["0x` + hex.EncodeToString(blkHash[:]) + `", {"tracer":"callTracer"}]}`),
+	} {
+		ret, err := web3svr.debugTrace(ctx, in.Get("method").String(), &in)
+		require.NoError(err)
+		rlts, ok := ret.([]*debugTraceBlockResult)
+		require.True(ok)
+		require.Len(rlts, 3)
+		require.Equal("0x"+hex.EncodeToString(receipts[0].ActionHash[:]), rlts[0].TxHash)
+		rlt, ok := rlts[0].Result.(*debugTraceTransactionResult)
+		require.True(ok)
+		require.False(rlt.Failed)
+		require.Equal(uint64(100000), rlt.Gas)
+		require.Empty(rlts[0].Error)
+		require.Equal("0x"+hex.EncodeToString(receipts[1].ActionHash[:]), rlts[1].TxHash)
+		require.NotNil(rlts[1].Result)
+		require.Empty(rlts[1].Error)
+		// failure of tracing an action is reported in its own result
+		require.Equal("0x"+hex.EncodeToString(receipts[2].ActionHash[:]), rlts[2].TxHash)
+		require.Nil(rlts[2].Result)
+		require.Equal("unknown tracer type: <nil>", rlts[2].Error)
+	}
 }
 
 func TestResponseIDMatchTypeWithRequest(t *testing.T) {
@@ -1297,3 +1768,152 @@ func TestResponseIDMatchTypeWithRequest(t *testing.T) {
 		require.Contains(string(bodyBytes), tt.sub)
 	}
 }
+
+func TestGetTokenTransfers(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+
+	var (
+		owner     = identityset.Address(1)
+		token     = identityset.Address(2)
+		toHex     = func(addr address.Address) string { return common.BytesToAddress(addr.Bytes()).Hex() }
+		actHash   = hash.Hash256b([]byte("test"))
+		transfers = []*blockindex.TokenTransfer{
+			{
+				Standard:    blockindex.XRC721,
+				Token:       token,
+				From:        owner,
+				To:          identityset.Address(3),
+				TokenID:     big.NewInt(7),
+				Amount:      big.NewInt(1),
+				BlockHeight: 10,
+				ActionHash:  actHash,
+				LogIndex:    2,
+			},
+		}
+	)
+
+	t.Run("byAddress", func(t *testing.T) {
+		core.EXPECT().TokenTransfersByAddress(gomock.Any(), gomock.Any(), uint64(5), uint64(_defaultTokenTransferCount)).DoAndReturn(
+			func(addr, tokenAddr address.Address, start, count uint64) ([]*blockindex.TokenTransfer, uint64, error) {
+				require.Equal(owner.String(), addr.String())
+				require.Equal(token.String(), tokenAddr.String())
+				return transfers, 6, nil
+			})
+		in := gjson.Parse(fmt.Sprintf(`{"params":[{"address":"%s","token":"%s","start":"0x5"}]}`, toHex(owner), toHex(token)))
+		ret, err := web3svr.getTokenTransfers(&in)
+		require.NoError(err)
+		data, err := json.Marshal(ret)
+		require.NoError(err)
+		require.JSONEq(fmt.Sprintf(`{"total":"0x6","transfers":[{"standard":"xrc721","token":"%s","from":"%s","to":"%s","tokenId":"0x7","value":"0x1","blockNumber":"0xa","transactionHash":"0x%x","logIndex":"0x2"}]}`,
+			toHex(token), toHex(owner), toHex(identityset.Address(3)), actHash[:]), string(data))
+	})
+
+	t.Run("byToken", func(t *testing.T) {
+		core.EXPECT().TokenTransfersByToken(gomock.Any(), uint64(0), uint64(1)).Return(nil, uint64(0), nil)
+		in := gjson.Parse(fmt.Sprintf(`{"params":[{"token":"%s","count":"0x1"}]}`, toHex(token)))
+		ret, err := web3svr.getTokenTransfers(&in)
+		require.NoError(err)
+		data, err := json.Marshal(ret)
+		require.NoError(err)
+		require.JSONEq(`{"total":"0x0","transfers":[]}`, string(data))
+	})
+
+	t.Run("invalidParams", func(t *testing.T) {
+		for _, params := range []string{
+			`{"params":[]}`,
+			`{"params":[{}]}`,
+			`{"params":[{"address":"invalid"}]}`,
+		} {
+			in := gjson.Parse(params)
+			_, err := web3svr.getTokenTransfers(&in)
+			require.Error(err)
+		}
+	})
+}
+
+func TestIndexerStatus(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+
+	core.EXPECT().IndexerStatus().Return([]*apitypes.IndexerStatus{
+		{Name: "blockindexer", Height: 100, Lag: 0},
+		{Name: "tokentransfer", Height: 90, Lag: 10},
+	})
+	ret, err := web3svr.indexerStatus()
+	require.NoError(err)
+	raw, err := json.Marshal(ret)
+	require.NoError(err)
+	require.JSONEq(`[
+		{"name":"blockindexer","height":"0x64","lag":"0x0"},
+		{"name":"tokentransfer","height":"0x5a","lag":"0xa"}
+	]`, string(raw))
+}
+
+func TestGetInternalTransfers(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+
+	var (
+		owner     = identityset.Address(1)
+		contract  = identityset.Address(2)
+		toHex     = func(addr address.Address) string { return common.BytesToAddress(addr.Bytes()).Hex() }
+		actHash   = hash.Hash256b([]byte("test"))
+		transfers = []*blockindex.InternalTransfer{
+			{
+				BlockHeight: 10,
+				ActionHash:  actHash,
+				Type:        iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER,
+				Amount:      big.NewInt(100),
+				Sender:      contract.String(),
+				Recipient:   owner.String(),
+			},
+			{
+				BlockHeight: 10,
+				ActionHash:  actHash,
+				Type:        iotextypes.TransactionLogType_GAS_FEE,
+				Amount:      big.NewInt(1),
+				Sender:      owner.String(),
+				Recipient:   address.RewardingPoolAddr,
+			},
+		}
+	)
+
+	t.Run("byAddress", func(t *testing.T) {
+		core.EXPECT().InternalTransfersByAddress(gomock.Any(), uint64(2), uint64(_defaultTokenTransferCount)).DoAndReturn(
+			func(addr address.Address, start, count uint64) ([]*blockindex.InternalTransfer, uint64, error) {
+				require.Equal(owner.String(), addr.String())
+				return transfers, 4, nil
+			})
+		in := gjson.Parse(fmt.Sprintf(`{"params":[{"address":"%s","start":"0x2"}]}`, toHex(owner)))
+		ret, err := web3svr.getInternalTransfers(&in)
+		require.NoError(err)
+		data, err := json.Marshal(ret)
+		require.NoError(err)
+		require.JSONEq(fmt.Sprintf(`{"total":"0x4","transfers":[`+
+			`{"type":"IN_CONTRACT_TRANSFER","from":"%s","to":"%s","value":"0x64","blockNumber":"0xa","transactionHash":"0x%x"},`+
+			`{"type":"GAS_FEE","from":"%s","to":"%s","value":"0x1","blockNumber":"0xa","transactionHash":"0x%x"}]}`,
+			toHex(contract), toHex(owner), actHash[:], toHex(owner), address.RewardingPoolAddr, actHash[:]), string(data))
+	})
+
+	t.Run("invalidParams", func(t *testing.T) {
+		for _, params := range []string{
+			`{"params":[]}`,
+			`{"params":[{}]}`,
+			`{"params":[{"address":"invalid"}]}`,
+		} {
+			in := gjson.Parse(params)
+			_, err := web3svr.getInternalTransfers(&in)
+			require.Error(err)
+		}
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -23,8 +24,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	logfilter "github.com/iotexproject/iotex-core/api/logfilter"
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -145,6 +149,169 @@ func (svr *web3Handler) parseBlockNumber(str string) (uint64, error) {
 	}
 }
 
+// parseBlockParam resolves the block parameter of a state query to a height, the parameter is a block number or tag,
+// or an EIP-1898 object with the block hash or number, and defaults to the tip
+func (svr *web3Handler) parseBlockParam(param gjson.Result) (uint64, error) {
+	if !param.Exists() {
+		return svr.coreService.TipHeight(), nil
+	}
+	blkNum := param.String()
+	if param.IsObject() {
+		if blkHash := param.Get("blockHash"); blkHash.Exists() {
+			blk, err := svr.coreService.BlockByHash(util.Remove0xPrefix(blkHash.String()))
+			if err != nil {
+				return 0, err
+			}
+			return blk.Block.Height(), nil
+		}
+		blkNum = param.Get("blockNumber").String()
+	}
+	height, err := svr.parseBlockNumber(blkNum)
+	if err != nil {
+		return 0, errors.Wrapf(errUnkownType, "block: %s", param.String())
+	}
+	if tip := svr.coreService.TipHeight(); height > tip {
+		return 0, status.Errorf(codes.NotFound, "block %d not found, tip height is %d", height, tip)
+	}
+	return height, nil
+}
+
+// parseSimulationOverride parses the state override set and the block overrides of eth_call and eth_estimateGas
+func parseSimulationOverride(stateOverride, blockOverride gjson.Result) (*evm.SimulationOverride, error) {
+	var (
+		accounts map[common.Address]*struct {
+			Balance   *hexutil.Big                `json:"balance"`
+			Nonce     *hexutil.Uint64             `json:"nonce"`
+			Code      *hexutil.Bytes              `json:"code"`
+			State     map[common.Hash]common.Hash `json:"state"`
+			StateDiff map[common.Hash]common.Hash `json:"stateDiff"`
+		}
+		blk *struct {
+			Number       *hexutil.Uint64 `json:"number"`
+			Time         *hexutil.Uint64 `json:"time"`
+			GasLimit     *hexutil.Uint64 `json:"gasLimit"`
+			FeeRecipient *common.Address `json:"feeRecipient"`
+			Coinbase     *common.Address `json:"coinbase"`
+		}
+	)
+	if stateOverride.Exists() {
+		if err := json.Unmarshal([]byte(stateOverride.Raw), &accounts); err != nil {
+			return nil, errors.Wrapf(errInvalidFormat, "state override: %s", err.Error())
+		}
+	}
+	if blockOverride.Exists() {
+		if err := json.Unmarshal([]byte(blockOverride.Raw), &blk); err != nil {
+			return nil, errors.Wrapf(errInvalidFormat, "block override: %s", err.Error())
+		}
+	}
+	if len(accounts) == 0 && blk == nil {
+		return nil, nil
+	}
+	override := &evm.SimulationOverride{
+		State: make(evm.StateOverride, len(accounts)),
+	}
+	for addr, acct := range accounts {
+		if acct == nil {
+			continue
+		}
+		if acct.State != nil && acct.StateDiff != nil {
+			return nil, errors.Wrapf(errInvalidFormat, "account %s has both state and stateDiff", addr.Hex())
+		}
+		o := &evm.AccountOverride{
+			Balance:   (*big.Int)(acct.Balance),
+			Nonce:     (*uint64)(acct.Nonce),
+			State:     acct.State,
+			StateDiff: acct.StateDiff,
+		}
+		if acct.Code != nil {
+			o.Code = []byte(*acct.Code)
+		}
+		override.State[addr] = o
+	}
+	if blk != nil {
+		override.Block = &evm.BlockOverride{
+			Number:   (*uint64)(blk.Number),
+			Time:     (*uint64)(blk.Time),
+			GasLimit: (*uint64)(blk.GasLimit),
+		}
+		coinbase := blk.FeeRecipient
+		if coinbase == nil {
+			coinbase = blk.Coinbase
+		}
+		if coinbase != nil {
+			addr, err := address.FromBytes(coinbase.Bytes())
+			if err != nil {
+				return nil, err
+			}
+			override.Block.Coinbase = addr
+		}
+	}
+	return override, nil
+}
+
+// parseSimulatedAction parses an entry of eth_callBundle, which is either a call object or a raw signed transaction
+func (svr *web3Handler) parseSimulatedAction(call gjson.Result, height uint64) (*action.SimulatedAction, error) {
+	cs := svr.coreService
+	if call.Type == gjson.String {
+		tx, err := action.DecodeEtherTx(call.String())
+		if err != nil {
+			return nil, err
+		}
+		if tx.Protected() && tx.ChainId().Uint64() != uint64(cs.EVMNetworkID()) {
+			return nil, errors.Wrapf(errInvalidEvmChainID, "expect chainID = %d, got %d", cs.EVMNetworkID(), tx.ChainId().Uint64())
+		}
+		encoding, sig, pubkey, err := action.ExtractTypeSigPubkey(tx)
+		if err != nil {
+			return nil, err
+		}
+		elp, err := svr.ethTxToEnvelope(tx)
+		if err != nil {
+			return nil, err
+		}
+		selp, err := (&action.Deserializer{}).SetEvmNetworkID(cs.EVMNetworkID()).ActionToSealedEnvelope(&iotextypes.Action{
+			Core:         elp.Proto(),
+			SenderPubKey: pubkey.Bytes(),
+			Signature:    sig,
+			Encoding:     encoding,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return action.NewSimulatedSealedAction(selp), nil
+	}
+	if !call.IsObject() {
+		return nil, errInvalidFormat
+	}
+	in := gjson.Parse(`{"params":[` + call.Raw + `]}`)
+	from, to, gasLimit, gasPrice, value, data, err := parseCallObject(&in)
+	if err != nil {
+		return nil, err
+	}
+	if gasLimit == 0 {
+		g := cs.Genesis()
+		gasLimit = g.BlockGasLimitByHeight(height)
+	}
+	var toAddr *common.Address
+	if len(to) != 0 {
+		addr, err := addrutil.IoAddrToEvmAddr(to)
+		if err != nil {
+			return nil, err
+		}
+		toAddr = &addr
+	}
+	elp, err := svr.ethTxToEnvelope(types.NewTx(&types.LegacyTx{
+		GasPrice: gasPrice,
+		Gas:      gasLimit,
+		To:       toAddr,
+		Value:    value,
+		Data:     data,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return action.NewSimulatedCall(from, elp), nil
+}
+
 func (svr *web3Handler) parseBlockRange(fromStr string, toStr string) (from uint64, to uint64, err error) {
 	from, err = svr.parseBlockNumber(fromStr)
 	if err != nil {
@@ -161,12 +328,20 @@ func (svr *web3Handler) ethTxToEnvelope(tx *types.Transaction) (action.Envelope,
 		to = ioAddr.String()
 	}
 	elpBuilder := (&action.EnvelopeBuilder{}).SetChainID(svr.coreService.ChainID())
-	if to == address.StakingProtocolAddr {
-		return elpBuilder.BuildStakingAction(tx)
-	}
-	if to == address.RewardingProtocol {
+	if to == address.StakingProtocolAddr || to == address.RewardingProtocol {
+		if len(tx.AccessList()) > 0 {
+			// native staking and rewarding actions cannot carry the access list
+			return nil, errors.Wrapf(action.ErrInvalidAct, "access list is not supported by %s", to)
+		}
+		if to == address.StakingProtocolAddr {
+			return elpBuilder.BuildStakingAction(tx)
+		}
 		return elpBuilder.BuildRewardingAction(tx)
 	}
+	if len(tx.AccessList()) > 0 {
+		// access list is only carried by execution
+		return elpBuilder.BuildExecution(tx)
+	}
 	isContract, err := svr.checkContractAddr(to)
 	if err != nil {
 		return nil, err
@@ -477,12 +652,11 @@ func newGetTransactionResult(
 	receipt *action.Receipt,
 	evmChainID uint32,
 ) (*getTransactionResult, error) {
-	act, ok := selp.Action().(action.EthCompatibleAction)
-	if !ok {
+	if _, ok := selp.Action().(action.EthCompatibleAction); !ok {
 		actHash, _ := selp.Hash()
 		return nil, errors.Wrapf(errUnsupportedAction, "actHash: %s", hex.EncodeToString(actHash[:]))
 	}
-	ethTx, err := act.ToEthTx(0)
+	ethTx, err := selp.ToEthTx()
 	if err != nil {
 		return nil, err
 	}
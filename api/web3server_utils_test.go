@@ -4,8 +4,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/test/mock/mock_apicoreservice"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
@@ -110,12 +113,62 @@ func TestParseCallObject(t *testing.T) {
 
 }
 
+func TestParseSimulationOverride(t *testing.T) {
+	require := require.New(t)
+
+	t.Run("no override", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[{}, "latest"]}`)
+		o, err := parseSimulationOverride(in.Get("params.2"), in.Get("params.3"))
+		require.NoError(err)
+		require.Nil(o)
+	})
+
+	t.Run("state and block override", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[{}, "latest", {
+				"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {
+					"balance": "0x10",
+					"nonce":   "0x2",
+					"code":    "0x6000",
+					"stateDiff": {
+						"0x0000000000000000000000000000000000000000000000000000000000000001": "0x000000000000000000000000000000000000000000000000000000000000002a"
+					}
+				}
+			}, {
+				"number":       "0x64",
+				"time":         "0x5",
+				"gasLimit":     "0x4e20",
+				"feeRecipient": "0x7c13866F9253DEf79e20034eDD011e1d69E67fe5"
+			}]}`)
+		o, err := parseSimulationOverride(in.Get("params.2"), in.Get("params.3"))
+		require.NoError(err)
+		acct, ok := o.State[common.HexToAddress("0x7c13866F9253DEf79e20034eDD011e1d69E67fe5")]
+		require.True(ok)
+		require.Equal(big.NewInt(16), acct.Balance)
+		require.Equal(uint64(2), *acct.Nonce)
+		require.Equal([]byte{0x60, 0x00}, acct.Code)
+		require.Nil(acct.State)
+		require.Equal(common.BigToHash(big.NewInt(42)), acct.StateDiff[common.BigToHash(big.NewInt(1))])
+		require.Equal(uint64(100), *o.Block.Number)
+		require.Equal(uint64(5), *o.Block.Time)
+		require.Equal(uint64(20000), *o.Block.GasLimit)
+		require.Equal("io10sfcvmuj2000083qqd8d6qg7r457vll9gly090", o.Block.Coinbase.String())
+	})
+
+	t.Run("both state and stateDiff", func(t *testing.T) {
+		in := gjson.Parse(`{"params":[{}, "latest", {
+				"0x7c13866F9253DEf79e20034eDD011e1d69E67fe5": {"state": {}, "stateDiff": {}}
+			}]}`)
+		_, err := parseSimulationOverride(in.Get("params.2"), in.Get("params.3"))
+		require.ErrorIs(err, errInvalidFormat)
+	})
+}
+
 func TestParseBlockNumber(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	core := mock_apicoreservice.NewMockCoreService(ctrl)
-	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit}
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
 
 	t.Run("earliest block number", func(t *testing.T) {
 		num, _ := web3svr.parseBlockNumber("earliest")
@@ -140,3 +193,53 @@ func TestParseBlockNumber(t *testing.T) {
 		require.Equal(num, uint64(0x1))
 	})
 }
+
+func TestEthTxToEnvelope(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	core := mock_apicoreservice.NewMockCoreService(ctrl)
+	web3svr := &web3Handler{core, nil, _defaultBatchRequestLimit, false, nil}
+	core.EXPECT().ChainID().Return(uint32(1)).AnyTimes()
+
+	stake, err := action.NewCreateStake(1, "cand", "100", 1, true, nil, 10000, big.NewInt(1))
+	require.NoError(err)
+	data, err := stake.EncodeABIBinary()
+	require.NoError(err)
+	stakingAddr := common.BytesToAddress(address.StakingProtocolAddrHash[:])
+	eoa := common.HexToAddress("0x7c13866F9253DEf79e20034eDD011e1d69E67fe5")
+	accessList := types.AccessList{{Address: eoa, StorageKeys: []common.Hash{}}}
+
+	t.Run("staking action", func(t *testing.T) {
+		elp, err := web3svr.ethTxToEnvelope(types.NewTx(&types.AccessListTx{
+			Gas:  10000,
+			To:   &stakingAddr,
+			Data: data,
+		}))
+		require.NoError(err)
+		require.IsType(&action.CreateStake{}, elp.Action())
+	})
+
+	t.Run("staking action with access list", func(t *testing.T) {
+		_, err := web3svr.ethTxToEnvelope(types.NewTx(&types.AccessListTx{
+			Gas:        10000,
+			To:         &stakingAddr,
+			Data:       data,
+			AccessList: accessList,
+		}))
+		require.ErrorIs(err, action.ErrInvalidAct)
+	})
+
+	t.Run("transfer with access list", func(t *testing.T) {
+		elp, err := web3svr.ethTxToEnvelope(types.NewTx(&types.AccessListTx{
+			Gas:        10000,
+			To:         &eoa,
+			Value:      big.NewInt(1),
+			AccessList: accessList,
+		}))
+		require.NoError(err)
+		exec, ok := elp.Action().(*action.Execution)
+		require.True(ok)
+		require.Equal(accessList, exec.AccessList())
+	})
+}
@@ -96,7 +96,7 @@ func (wsSvr *WebsocketHandler) ServeHTTP(w http.ResponseWriter, req *http.Reques
 		return
 	}
 
-	wsSvr.handleConnection(req.Context(), ws)
+	wsSvr.handleConnection(withRequestCaller(req), ws)
 }
 
 func (wsSvr *WebsocketHandler) handleConnection(ctx context.Context, ws *websocket.Conn) {
@@ -6,14 +6,15 @@
 package block
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/iotexproject/go-pkgs/bloom"
-	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 	"github.com/iotexproject/iotex-core/pkg/version"
 )
 
@@ -83,8 +84,22 @@ func (b *Builder) SetLogsBloom(f bloom.BloomFilter) *Builder {
 	return b
 }
 
+// SetGasUsed sets the gas used by all actions included in this building block.
+func (b *Builder) SetGasUsed(g uint64) *Builder {
+	b.blk.Header.gasUsed = g
+	return b
+}
+
+// SetBaseFee sets the EIP-1559 base fee of this building block.
+func (b *Builder) SetBaseFee(f *big.Int) *Builder {
+	if f != nil {
+		b.blk.Header.baseFee = new(big.Int).Set(f)
+	}
+	return b
+}
+
 // SignAndBuild signs and then builds a block.
-func (b *Builder) SignAndBuild(signerPrvKey crypto.PrivateKey) (Block, error) {
+func (b *Builder) SignAndBuild(signerPrvKey signer.Signer) (Block, error) {
 	b.blk.Header.pubkey = signerPrvKey.PublicKey()
 	h := b.blk.Header.HashHeaderCore()
 	sig, err := signerPrvKey.Sign(h[:])
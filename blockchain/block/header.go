@@ -6,6 +6,7 @@
 package block
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/iotexproject/go-pkgs/bloom"
@@ -32,6 +33,8 @@ type Header struct {
 	deltaStateDigest hash.Hash256      // digest of state change by this block
 	receiptRoot      hash.Hash256      // root of receipt trie
 	logsBloom        bloom.BloomFilter // bloom filter for all contract events in this block
+	gasUsed          uint64            // gas used by all actions in this block
+	baseFee          *big.Int          // EIP-1559 base fee of this block
 	blockSig         []byte            // block signature
 	pubkey           crypto.PublicKey  // block producer's public key
 }
@@ -41,6 +44,8 @@ var (
 	ErrTxRootMismatch      = errors.New("transaction merkle root does not match")
 	ErrDeltaStateMismatch  = errors.New("delta state digest doesn't match")
 	ErrReceiptRootMismatch = errors.New("receipt root hash does not match")
+	ErrGasUsedMismatch     = errors.New("gas used does not match")
+	ErrBaseFeeMismatch     = errors.New("base fee does not match")
 )
 
 // Version returns the version of this block.
@@ -73,6 +78,17 @@ func (h *Header) HashBlock() hash.Hash256 { return h.HashHeader() }
 // LogsBloomfilter return the bloom filter for all contract log events
 func (h *Header) LogsBloomfilter() bloom.BloomFilter { return h.logsBloom }
 
+// GasUsed returns the gas used by all actions in this block.
+func (h *Header) GasUsed() uint64 { return h.gasUsed }
+
+// BaseFee returns the base fee of this block, nil if the block is before Vanuatu
+func (h *Header) BaseFee() *big.Int {
+	if h.baseFee == nil {
+		return nil
+	}
+	return new(big.Int).Set(h.baseFee)
+}
+
 // Proto returns BlockHeader proto.
 func (h *Header) Proto() *iotextypes.BlockHeader {
 	header := iotextypes.BlockHeader{
@@ -101,6 +117,12 @@ func (h *Header) BlockHeaderCoreProto() *iotextypes.BlockHeaderCore {
 	if h.logsBloom != nil {
 		header.LogsBloom = h.logsBloom.Bytes()
 	}
+	// gas used and base fee are only in the header since base fee is enabled,
+	// so the header of earlier blocks serializes the same as before
+	if h.baseFee != nil {
+		header.GasUsed = h.gasUsed
+		header.BaseFee = h.baseFee.Bytes()
+	}
 	return &header
 }
 
@@ -142,7 +164,12 @@ func (h *Header) loadFromBlockHeaderCoreProto(pb *iotextypes.BlockHeaderCore) er
 			return err
 		}
 	}
-	return err
+	h.gasUsed, h.baseFee = 0, nil
+	if baseFee := pb.GetBaseFee(); len(baseFee) > 0 {
+		h.gasUsed = pb.GetGasUsed()
+		h.baseFee = new(big.Int).SetBytes(baseFee)
+	}
+	return nil
 }
 
 // SerializeCore returns byte stream for header core.
@@ -7,6 +7,7 @@ package block
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 	"time"
@@ -59,6 +60,30 @@ func TestSerDesHeadrer(t *testing.T) {
 	require.NotNil(header.BlockHeaderCoreProto())
 	require.Equal("io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms", header.ProducerAddress())
 }
+func TestHeaderBaseFee(t *testing.T) {
+	require := require.New(t)
+	h := getHeader()
+	require.Nil(h.BaseFee())
+	require.Zero(h.GasUsed())
+	hashWithoutFee := h.HashBlock()
+
+	h.gasUsed = 21000
+	require.Zero(h.BlockHeaderCoreProto().GetGasUsed())
+	require.Equal(hashWithoutFee, h.HashBlock())
+	h.baseFee = big.NewInt(1000000000000)
+	require.NotEqual(hashWithoutFee, h.HashBlock())
+	core := h.BlockHeaderCoreProto()
+	require.Equal(uint64(21000), core.GetGasUsed())
+	require.Equal(h.baseFee.Bytes(), core.GetBaseFee())
+	ser, err := h.Serialize()
+	require.NoError(err)
+	header := &Header{}
+	require.NoError(header.Deserialize(ser))
+	require.Equal(uint64(21000), header.GasUsed())
+	require.Equal(big.NewInt(1000000000000), header.BaseFee())
+	require.Equal(h.HashBlock(), header.HashBlock())
+}
+
 func getHeader() *Header {
 	ti, err := time.Parse("2006-Jan-02", "2019-Feb-03")
 	if err != nil {
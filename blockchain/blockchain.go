@@ -28,6 +28,7 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 )
 
 // const
@@ -119,6 +120,7 @@ type (
 		clk            clock.Clock
 		pubSubManager  PubSubManager
 		timerFactory   *prometheustimer.TimerFactory
+		signer         signer.Signer
 
 		// used by account-based model
 		bbf BlockBuilderFactory
@@ -151,6 +153,14 @@ func BlockValidatorOption(blockValidator block.Validator) Option {
 	}
 }
 
+// SignerOption sets the signer of the producer key, by default the key in config is used
+func SignerOption(s signer.Signer) Option {
+	return func(bc *blockchain) error {
+		bc.signer = s
+		return nil
+	}
+}
+
 // ClockOption overrides the default clock
 func ClockOption(clk clock.Clock) Option {
 	return func(bc *blockchain) error {
@@ -311,6 +321,7 @@ func (bc *blockchain) ValidateBlock(blk *block.Block) error {
 			BlockTimeStamp: blk.Timestamp(),
 			GasLimit:       bc.genesis.BlockGasLimitByHeight(blk.Height()),
 			Producer:       producerAddr,
+			BaseFee:        blk.BaseFee(),
 		},
 	)
 	ctx = protocol.WithFeatureCtx(ctx)
@@ -329,14 +340,16 @@ func (bc *blockchain) Context(ctx context.Context) (context.Context, error) {
 }
 
 func (bc *blockchain) contextWithBlock(ctx context.Context, producer address.Address, height uint64, timestamp time.Time) context.Context {
-	return protocol.WithBlockCtx(
-		ctx,
-		protocol.BlockCtx{
-			BlockHeight:    height,
-			BlockTimeStamp: timestamp,
-			Producer:       producer,
-			GasLimit:       bc.genesis.BlockGasLimitByHeight(height),
-		})
+	blkCtx := protocol.BlockCtx{
+		BlockHeight:    height,
+		BlockTimeStamp: timestamp,
+		Producer:       producer,
+		GasLimit:       bc.genesis.BlockGasLimitByHeight(height),
+	}
+	if bcCtx, ok := protocol.GetBlockchainCtx(ctx); ok {
+		blkCtx.BaseFee = protocol.CalcBaseFee(bc.genesis.Blockchain, &bcCtx.Tip)
+	}
+	return protocol.WithBlockCtx(ctx, blkCtx)
 }
 
 func (bc *blockchain) context(ctx context.Context, tipInfoFlag bool) (context.Context, error) {
@@ -380,17 +393,20 @@ func (bc *blockchain) MintNewBlock(timestamp time.Time) (*block.Block, error) {
 	ctx = bc.contextWithBlock(ctx, bc.config.ProducerAddress(), newblockHeight, timestamp)
 	ctx = protocol.WithFeatureCtx(ctx)
 	// run execution and update state trie root hash
-	minterPrivateKey := bc.config.ProducerPrivateKey()
+	minter := bc.signer
+	if minter == nil {
+		minter = bc.config.ProducerPrivateKey()
+	}
 	blockBuilder, err := bc.bbf.NewBlockBuilder(
 		ctx,
 		func(elp action.Envelope) (*action.SealedEnvelope, error) {
-			return action.Sign(elp, minterPrivateKey)
+			return action.Sign(elp, minter)
 		},
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create block builder at new block height %d", newblockHeight)
 	}
-	blk, err := blockBuilder.SignAndBuild(minterPrivateKey)
+	blk, err := blockBuilder.SignAndBuild(minter)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create block")
 	}
@@ -451,8 +467,10 @@ func (bc *blockchain) tipInfo() (*protocol.TipInfo, error) {
 
 	return &protocol.TipInfo{
 		Height:    tipHeight,
+		GasUsed:   header.GasUsed(),
 		Hash:      header.HashBlock(),
 		Timestamp: header.Timestamp(),
+		BaseFee:   header.BaseFee(),
 	}, nil
 }
 
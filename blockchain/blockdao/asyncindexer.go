@@ -0,0 +1,247 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+var (
+	_asyncIndexerLagMtc = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_async_indexer_lag",
+			Help: "Number of blocks the async indexer is behind the block dao.",
+		},
+		[]string{"indexer"},
+	)
+	_asyncIndexerFailureMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_async_indexer_failure",
+			Help: "Number of failures of the async indexer.",
+		},
+		[]string{"indexer"},
+	)
+
+	// DefaultAsyncIndexerConfig is the default config of async indexer
+	DefaultAsyncIndexerConfig = AsyncIndexerConfig{
+		Enabled:          false,
+		RetryInterval:    time.Second,
+		MaxRetryInterval: time.Minute,
+	}
+)
+
+func init() {
+	prometheus.MustRegister(_asyncIndexerLagMtc)
+	prometheus.MustRegister(_asyncIndexerFailureMtc)
+}
+
+type (
+	// AsyncIndexerConfig is the config of async indexer
+	AsyncIndexerConfig struct {
+		// Enabled feeds the non-consensus-critical indexers asynchronously
+		Enabled bool `yaml:"enabled"`
+		// RetryInterval is the interval to retry after the indexer fails, which doubles on each failure
+		RetryInterval time.Duration `yaml:"retryInterval"`
+		// MaxRetryInterval is the upper limit of the retry interval
+		MaxRetryInterval time.Duration `yaml:"maxRetryInterval"`
+	}
+
+	// AsyncIndexer feeds blocks to an indexer in its own goroutine, so that a slow or failing indexer does not delay
+	// block commit. The indexer catches up from its own tip to the tip of block dao independently.
+	AsyncIndexer struct {
+		name    string
+		indexer BlockIndexer
+		cfg     AsyncIndexerConfig
+		// targetHeight is the tip height of block dao
+		targetHeight uint64
+		height       uint64
+		notify       chan struct{}
+		mu           sync.Mutex
+		heightCh     chan struct{} // closed and renewed when height is updated
+		cancel       context.CancelFunc
+		wg           sync.WaitGroup
+	}
+)
+
+// NewAsyncIndexer creates a new async indexer of the given name
+func NewAsyncIndexer(name string, indexer BlockIndexer, cfg AsyncIndexerConfig) *AsyncIndexer {
+	return &AsyncIndexer{
+		name:     name,
+		indexer:  indexer,
+		cfg:      cfg,
+		notify:   make(chan struct{}, 1),
+		heightCh: make(chan struct{}),
+	}
+}
+
+// Name returns the name of the indexer
+func (ai *AsyncIndexer) Name() string {
+	return ai.name
+}
+
+// Indexer returns the underlying indexer
+func (ai *AsyncIndexer) Indexer() BlockIndexer {
+	return ai.indexer
+}
+
+// Start starts the underlying indexer, blocks are not fed until the block dao runs it
+func (ai *AsyncIndexer) Start(ctx context.Context) error {
+	if err := ai.indexer.Start(ctx); err != nil {
+		return err
+	}
+	height, err := ai.indexer.Height()
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint64(&ai.height, height)
+	return nil
+}
+
+// Stop stops feeding blocks and the underlying indexer
+func (ai *AsyncIndexer) Stop(ctx context.Context) error {
+	ai.stopFeeding()
+	return ai.indexer.Stop(ctx)
+}
+
+// Height returns the height of the underlying indexer
+func (ai *AsyncIndexer) Height() (uint64, error) {
+	return atomic.LoadUint64(&ai.height), nil
+}
+
+// Lag returns the number of blocks the indexer is behind the block dao
+func (ai *AsyncIndexer) Lag() uint64 {
+	target, height := atomic.LoadUint64(&ai.targetHeight), atomic.LoadUint64(&ai.height)
+	if target < height {
+		return 0
+	}
+	return target - height
+}
+
+// PutBlock notifies the indexer to catch up to the block, it returns without waiting for the indexing
+func (ai *AsyncIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	ai.setTarget(blk.Height())
+	return nil
+}
+
+// DeleteTipBlock deletes the tip block from the underlying indexer
+func (ai *AsyncIndexer) DeleteTipBlock(ctx context.Context, blk *block.Block) error {
+	if err := ai.indexer.DeleteTipBlock(ctx, blk); err != nil {
+		return err
+	}
+	ai.setHeight(blk.Height() - 1)
+	return nil
+}
+
+// WaitForHeight waits until the indexer reaches the height or ctx is done
+func (ai *AsyncIndexer) WaitForHeight(ctx context.Context, height uint64) error {
+	for {
+		ai.mu.Lock()
+		ch := ai.heightCh
+		ai.mu.Unlock()
+		current := atomic.LoadUint64(&ai.height)
+		if current >= height {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "indexer %s is at height %d, behind height %d", ai.name, current, height)
+		case <-ch:
+		}
+	}
+}
+
+// run starts feeding blocks from dao in the background, the indexer is caught up to the tip of dao first
+func (ai *AsyncIndexer) run(ctx context.Context, dao BlockDAO) error {
+	tip, err := dao.Height()
+	if err != nil {
+		return err
+	}
+	ai.setTarget(tip)
+	// ctx carries the blockchain and genesis context for indexing, but the feeding outlives it
+	ctx, ai.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	checker := NewBlockIndexerChecker(dao)
+	ai.wg.Add(1)
+	go func() {
+		defer ai.wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ai.notify:
+			}
+			if !ai.catchUp(ctx, checker) {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// catchUp indexes blocks up to the tip of dao, retrying with backoff on failure. It returns false if ctx is done.
+func (ai *AsyncIndexer) catchUp(ctx context.Context, checker *BlockIndexerChecker) bool {
+	retryInterval := ai.cfg.RetryInterval
+	for {
+		err := checker.CheckIndexer(ctx, ai.indexer, 0, ai.setHeight)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		_asyncIndexerFailureMtc.WithLabelValues(ai.name).Inc()
+		log.L().Error("Async indexer failed to catch up.",
+			zap.String("indexer", ai.name),
+			zap.Uint64("height", atomic.LoadUint64(&ai.height)),
+			zap.Duration("retryInterval", retryInterval),
+			zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryInterval):
+		}
+		retryInterval *= 2
+		if retryInterval > ai.cfg.MaxRetryInterval {
+			retryInterval = ai.cfg.MaxRetryInterval
+		}
+	}
+}
+
+func (ai *AsyncIndexer) stopFeeding() {
+	if ai.cancel == nil {
+		return
+	}
+	ai.cancel()
+	ai.wg.Wait()
+	ai.cancel = nil
+}
+
+func (ai *AsyncIndexer) setTarget(height uint64) {
+	atomic.StoreUint64(&ai.targetHeight, height)
+	_asyncIndexerLagMtc.WithLabelValues(ai.name).Set(float64(ai.Lag()))
+	select {
+	case ai.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (ai *AsyncIndexer) setHeight(height uint64) {
+	atomic.StoreUint64(&ai.height, height)
+	_asyncIndexerLagMtc.WithLabelValues(ai.name).Set(float64(ai.Lag()))
+	ai.mu.Lock()
+	close(ai.heightCh)
+	ai.heightCh = make(chan struct{})
+	ai.mu.Unlock()
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockdao
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockdao"
+)
+
+func TestAsyncIndexer(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	var (
+		daoHeight     uint64 = 3
+		indexerHeight uint64
+		failures      int32 = 2
+	)
+	newBlock := func(height uint64) *block.Block {
+		pb := &iotextypes.BlockHeader{
+			Core: &iotextypes.BlockHeaderCore{
+				Height:    height,
+				Timestamp: timestamppb.Now(),
+			},
+			ProducerPubkey: identityset.PrivateKey(1).PublicKey().Bytes(),
+		}
+		blk := &block.Block{}
+		require.NoError(blk.LoadFromBlockHeaderProto(pb))
+		return blk
+	}
+	mockDao := mock_blockdao.NewMockBlockDAO(ctrl)
+	mockDao.EXPECT().Height().DoAndReturn(func() (uint64, error) {
+		return atomic.LoadUint64(&daoHeight), nil
+	}).AnyTimes()
+	mockDao.EXPECT().GetBlockByHeight(gomock.Any()).DoAndReturn(func(height uint64) (*block.Block, error) {
+		return newBlock(height), nil
+	}).AnyTimes()
+	mockDao.EXPECT().GetReceipts(gomock.Any()).Return(nil, nil).AnyTimes()
+	indexer := mock_blockdao.NewMockBlockIndexer(ctrl)
+	indexer.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
+	indexer.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
+	indexer.EXPECT().Height().DoAndReturn(func() (uint64, error) {
+		return atomic.LoadUint64(&indexerHeight), nil
+	}).AnyTimes()
+	indexer.EXPECT().PutBlock(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, blk *block.Block) error {
+		// the indexer fails at height 2 for a few times
+		if blk.Height() == 2 && atomic.AddInt32(&failures, -1) >= 0 {
+			return errors.New("failed to index")
+		}
+		atomic.StoreUint64(&indexerHeight, blk.Height())
+		return nil
+	}).AnyTimes()
+
+	ai := NewAsyncIndexer("test", indexer, AsyncIndexerConfig{
+		Enabled:          true,
+		RetryInterval:    10 * time.Millisecond,
+		MaxRetryInterval: 20 * time.Millisecond,
+	})
+	ctx := protocol.WithBlockchainCtx(context.Background(), protocol.BlockchainCtx{})
+	ctx = genesis.WithGenesisContext(ctx, genesis.Default)
+	require.NoError(ai.Start(ctx))
+	height, err := ai.Height()
+	require.NoError(err)
+	require.Zero(height)
+
+	// catch up to the tip of dao with retries
+	require.NoError(ai.run(ctx, mockDao))
+	waitCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(ai.WaitForHeight(waitCtx, 3))
+	require.Zero(ai.Lag())
+	require.Equal(int32(-1), atomic.LoadInt32(&failures))
+
+	// new block is indexed in the background
+	atomic.StoreUint64(&daoHeight, 4)
+	require.NoError(ai.PutBlock(ctx, newBlock(4)))
+	require.NoError(ai.WaitForHeight(waitCtx, 4))
+	height, err = ai.Height()
+	require.NoError(err)
+	require.Equal(uint64(4), height)
+
+	// wait for a height not reached yet
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer shortCancel()
+	err = ai.WaitForHeight(shortCtx, 5)
+	require.ErrorIs(err, context.DeadlineExceeded)
+	require.Contains(err.Error(), "indexer test is at height 4")
+
+	require.NoError(ai.Stop(ctx))
+}
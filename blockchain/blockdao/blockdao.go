@@ -7,6 +7,7 @@ package blockdao
 
 import (
 	"context"
+	"sync"
 	"sync/atomic"
 
 	"github.com/iotexproject/go-pkgs/cache"
@@ -53,22 +54,63 @@ type (
 		FooterByHeight(uint64) (*block.Footer, error)
 	}
 
+	// BlockDAOWithBottom is a block dao which may not keep the blocks below its bottom
+	BlockDAOWithBottom interface {
+		BlockDAO
+		// Bottom returns the lowest height of blocks available
+		Bottom() (uint64, error)
+	}
+
+	// BlockStoreWithPrune is a block store which is able to delete the history blocks
+	BlockStoreWithPrune interface {
+		BlockDAOWithBottom
+		// PrunableHeight returns the highest height below the horizon, that the blocks up to it can be deleted
+		PrunableHeight(horizon uint64) (uint64, error)
+		// Prune deletes the blocks up to the height
+		Prune(height uint64) error
+	}
+
 	blockDAO struct {
-		blockStore   BlockDAO
-		indexers     []BlockIndexer
-		timerFactory *prometheustimer.TimerFactory
-		lifecycle    lifecycle.Lifecycle
-		headerCache  cache.LRUCache
-		footerCache  cache.LRUCache
-		receiptCache cache.LRUCache
-		blockCache   cache.LRUCache
-		tipHeight    uint64
+		blockStore    BlockDAO
+		indexers      []BlockIndexer
+		asyncIndexers []*AsyncIndexer
+		timerFactory  *prometheustimer.TimerFactory
+		lifecycle     lifecycle.Lifecycle
+		headerCache   cache.LRUCache
+		footerCache   cache.LRUCache
+		receiptCache  cache.LRUCache
+		blockCache    cache.LRUCache
+		tipHeight     uint64
+		// retention is the number of recent blocks kept in block store, 0 means all blocks are kept
+		retention      uint64
+		pruneIndexers  []BlockIndexerWithPrune
+		pruning        int32
+		pruneWaitGroup sync.WaitGroup
 	}
+
+	// Option sets block dao construction parameter
+	Option func(*blockDAO)
 )
 
+// AsyncIndexersOption adds indexers which are fed asynchronously, they should not be relied on by consensus
+func AsyncIndexersOption(indexers ...*AsyncIndexer) Option {
+	return func(dao *blockDAO) {
+		dao.asyncIndexers = append(dao.asyncIndexers, indexers...)
+	}
+}
+
+// BlockRetentionOption keeps the recent blocks in block store and prunes the older ones, along with the index of them
+// in indexers. The extra indexers are those fed outside of block dao
+func BlockRetentionOption(retention uint64, extra ...BlockIndexerWithPrune) Option {
+	return func(dao *blockDAO) {
+		dao.retention = retention
+		dao.pruneIndexers = append(dao.pruneIndexers, extra...)
+	}
+}
+
 // NewBlockDAOWithIndexersAndCache returns a BlockDAO with indexers which will consume blocks appended, and
 // caches which will speed up reading
-func NewBlockDAOWithIndexersAndCache(blkStore BlockDAO, indexers []BlockIndexer, cacheSize int) BlockDAO {
+func NewBlockDAOWithIndexersAndCache(blkStore BlockDAO, indexers []BlockIndexer, cacheSize int, opts ...Option) BlockDAO {
 	if blkStore == nil {
 		return nil
 	}
@@ -77,11 +119,28 @@ func NewBlockDAOWithIndexersAndCache(blkStore BlockDAO, indexers []BlockIndexer,
 		blockStore: blkStore,
 		indexers:   indexers,
 	}
+	for _, opt := range opts {
+		opt(blockDAO)
+	}
+	if blockDAO.retention > 0 {
+		for _, indexer := range indexers {
+			if pi, ok := indexer.(BlockIndexerWithPrune); ok {
+				blockDAO.pruneIndexers = append(blockDAO.pruneIndexers, pi)
+			}
+		}
+		for _, indexer := range blockDAO.asyncIndexers {
+			if pi, ok := indexer.indexer.(BlockIndexerWithPrune); ok {
+				blockDAO.pruneIndexers = append(blockDAO.pruneIndexers, pi)
+			}
+		}
+	}
 
-	blockDAO.lifecycle.Add(blkStore)
 	for _, indexer := range indexers {
 		blockDAO.lifecycle.Add(indexer)
 	}
+	for _, indexer := range blockDAO.asyncIndexers {
+		blockDAO.lifecycle.Add(indexer)
+	}
 	if cacheSize > 0 {
 		blockDAO.headerCache = cache.NewThreadSafeLruCache(cacheSize)
 		blockDAO.footerCache = cache.NewThreadSafeLruCache(cacheSize)
@@ -103,6 +162,10 @@ func NewBlockDAOWithIndexersAndCache(blkStore BlockDAO, indexers []BlockIndexer,
 
 // Start starts block DAO and initiates the top height if it doesn't exist
 func (dao *blockDAO) Start(ctx context.Context) error {
+	// block store is started ahead of indexers, which may read it on start
+	if err := dao.blockStore.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start block store")
+	}
 	err := dao.lifecycle.OnStart(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to start child services")
@@ -113,7 +176,16 @@ func (dao *blockDAO) Start(ctx context.Context) error {
 		return err
 	}
 	atomic.StoreUint64(&dao.tipHeight, tipHeight)
-	return dao.checkIndexers(ctx)
+	if err := dao.checkIndexers(ctx); err != nil {
+		return err
+	}
+	// async indexers catch up in the background
+	for _, indexer := range dao.asyncIndexers {
+		if err := indexer.run(ctx, dao); err != nil {
+			return errors.Wrapf(err, "failed to run async indexer %s", indexer.Name())
+		}
+	}
+	return nil
 }
 
 func (dao *blockDAO) checkIndexers(ctx context.Context) error {
@@ -139,7 +211,15 @@ func (dao *blockDAO) checkIndexers(ctx context.Context) error {
 }
 
 func (dao *blockDAO) Stop(ctx context.Context) error {
-	return dao.lifecycle.OnStop(ctx)
+	// stop feeding async indexers before block store is stopped
+	for _, indexer := range dao.asyncIndexers {
+		indexer.stopFeeding()
+	}
+	dao.pruneWaitGroup.Wait()
+	if err := dao.lifecycle.OnStop(ctx); err != nil {
+		return err
+	}
+	return dao.blockStore.Stop(ctx)
 }
 
 func (dao *blockDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
@@ -213,6 +293,14 @@ func (dao *blockDAO) Height() (uint64, error) {
 	return dao.blockStore.Height()
 }
 
+// Bottom returns the lowest height of blocks available
+func (dao *blockDAO) Bottom() (uint64, error) {
+	if store, ok := dao.blockStore.(BlockDAOWithBottom); ok {
+		return store.Bottom()
+	}
+	return 1, nil
+}
+
 func (dao *blockDAO) Header(h hash.Hash256) (*block.Header, error) {
 	if header, ok := lruCacheGet(dao.headerCache, h); ok {
 		_cacheMtc.WithLabelValues("hit_header").Inc()
@@ -275,6 +363,87 @@ func (dao *blockDAO) PutBlock(ctx context.Context, blk *block.Block) error {
 			return err
 		}
 	}
+	for _, indexer := range dao.asyncIndexers {
+		if err := indexer.PutBlock(ctx, blk); err != nil {
+			return err
+		}
+	}
+	if dao.retention > 0 && atomic.CompareAndSwapInt32(&dao.pruning, 0, 1) {
+		// prune in the background, as deleting the index of a whole file takes a while
+		dao.pruneWaitGroup.Add(1)
+		go func(ctx context.Context, tip uint64) {
+			defer dao.pruneWaitGroup.Done()
+			defer atomic.StoreInt32(&dao.pruning, 0)
+			if err := dao.prune(ctx, tip); err != nil {
+				log.L().Error("Failed to prune block history.", zap.Uint64("tip", tip), zap.Error(err))
+			}
+		}(context.WithoutCancel(ctx), blk.Height())
+	}
+	return nil
+}
+
+// prune deletes the blocks below tip-retention, which have been indexed by all indexers
+func (dao *blockDAO) prune(ctx context.Context, tip uint64) error {
+	store, ok := dao.blockStore.(BlockStoreWithPrune)
+	if !ok || tip <= dao.retention {
+		return nil
+	}
+	horizon := tip - dao.retention
+	for _, indexer := range dao.pruneIndexers {
+		height, err := indexer.Height()
+		if err != nil {
+			return err
+		}
+		if height < horizon {
+			horizon = height
+		}
+	}
+	for _, indexer := range dao.asyncIndexers {
+		height, err := indexer.Height()
+		if err != nil {
+			return err
+		}
+		if height < horizon {
+			horizon = height
+		}
+	}
+	height, err := store.PrunableHeight(horizon)
+	if err != nil {
+		return err
+	}
+	bottom, err := store.Bottom()
+	if err != nil {
+		return err
+	}
+	if height < bottom {
+		return nil
+	}
+	log.L().Info("Pruning block history.", zap.Uint64("bottom", bottom), zap.Uint64("height", height))
+	hashes := make([]hash.Hash256, 0, height-bottom+1)
+	for h := bottom; h <= height; h++ {
+		blk, err := store.GetBlockByHeight(h)
+		if err != nil {
+			return err
+		}
+		for _, indexer := range dao.pruneIndexers {
+			if err := indexer.PruneBlock(ctx, blk); err != nil {
+				return err
+			}
+		}
+		hashes = append(hashes, blk.HashBlock())
+	}
+	if err := store.Prune(height); err != nil {
+		return err
+	}
+	// the pruned blocks must not be served from the caches either
+	for i, h := range hashes {
+		height := bottom + uint64(i)
+		lruCacheRemove(dao.headerCache, height)
+		lruCacheRemove(dao.headerCache, h)
+		lruCacheRemove(dao.footerCache, height)
+		lruCacheRemove(dao.receiptCache, height)
+		lruCacheRemove(dao.blockCache, h)
+	}
 	return nil
 }
 
@@ -290,3 +459,9 @@ func lruCachePut(c cache.LRUCache, k, v interface{}) {
 		c.Add(k, v)
 	}
 }
+
+func lruCacheRemove(c cache.LRUCache, k interface{}) {
+	if c != nil {
+		c.Remove(k)
+	}
+}
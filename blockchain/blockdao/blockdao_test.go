@@ -79,10 +79,18 @@ func Test_blockDAO_Start(t *testing.T) {
 		blockStore: mockblockdao,
 	}
 
+	t.Run("FailedToStartBlockStore", func(t *testing.T) {
+		mockblockdao.EXPECT().Start(gomock.Any()).Return(errors.New(t.Name())).Times(1)
+
+		err := blockdao.Start(context.Background())
+		r.ErrorContains(err, t.Name())
+	})
+
 	t.Run("FailedToStartLifeCycle", func(t *testing.T) {
 		p := gomonkey.NewPatches()
 		defer p.Reset()
 
+		mockblockdao.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
 		p.ApplyMethodReturn(&lifecycle.Lifecycle{}, "OnStart", errors.New(t.Name()))
 
 		err := blockdao.Start(context.Background())
@@ -93,6 +101,7 @@ func Test_blockDAO_Start(t *testing.T) {
 		p := gomonkey.NewPatches()
 		defer p.Reset()
 
+		mockblockdao.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
 		p.ApplyMethodReturn(&lifecycle.Lifecycle{}, "OnStart", nil)
 		mockblockdao.EXPECT().Height().Return(uint64(0), errors.New(t.Name())).Times(1)
 
@@ -106,6 +115,7 @@ func Test_blockDAO_Start(t *testing.T) {
 
 		expectedHeight := uint64(1)
 
+		mockblockdao.EXPECT().Start(gomock.Any()).Return(nil).Times(1)
 		p.ApplyMethodReturn(&lifecycle.Lifecycle{}, "OnStart", nil)
 		mockblockdao.EXPECT().Height().Return(expectedHeight, nil).Times(1)
 		p.ApplyPrivateMethod(&blockDAO{}, "checkIndexers", func(*blockDAO, context.Context) error { return nil })
@@ -166,7 +176,11 @@ func Test_blockDAO_checkIndexers(t *testing.T) {
 func Test_blockDAO_Stop(t *testing.T) {
 	r := require.New(t)
 
-	dao := &blockDAO{lifecycle: lifecycle.Lifecycle{}}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockblockdao := mock_blockdao.NewMockBlockDAO(ctrl)
+	dao := &blockDAO{lifecycle: lifecycle.Lifecycle{}, blockStore: mockblockdao}
 
 	t.Run("FailedToStopLifecycle", func(t *testing.T) {
 		p := gomonkey.NewPatches()
@@ -184,6 +198,7 @@ func Test_blockDAO_Stop(t *testing.T) {
 		defer p.Reset()
 
 		p = p.ApplyMethodReturn(&lifecycle.Lifecycle{}, "OnStop", nil)
+		mockblockdao.EXPECT().Stop(gomock.Any()).Return(nil).Times(1)
 
 		err := dao.Stop(context.Background())
 
@@ -1112,3 +1127,91 @@ func receiptByActionHash(receipts []*action.Receipt, h hash.Hash256) (*action.Re
 	}
 	return nil, errors.Errorf("receipt of action %x isn't found", h)
 }
+
+type testPruneIndexer struct {
+	height uint64
+	pruned []uint64
+}
+
+func (p *testPruneIndexer) Start(context.Context) error { return nil }
+
+func (p *testPruneIndexer) Stop(context.Context) error { return nil }
+
+func (p *testPruneIndexer) Height() (uint64, error) { return p.height, nil }
+
+func (p *testPruneIndexer) PutBlock(context.Context, *block.Block) error { return nil }
+
+func (p *testPruneIndexer) DeleteTipBlock(context.Context, *block.Block) error { return nil }
+
+func (p *testPruneIndexer) PruneBlock(_ context.Context, blk *block.Block) error {
+	p.pruned = append(p.pruned, blk.Height())
+	return nil
+}
+
+func Test_blockDAO_prune(t *testing.T) {
+	r := require.New(t)
+
+	ctx := context.Background()
+	cfg := db.DefaultConfig
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = t.TempDir() + "/chain.db"
+	store, err := createFileDAO(false, false, compress.Snappy, cfg)
+	r.NoError(err)
+	r.NoError(store.Start(ctx))
+	defer func() {
+		r.NoError(store.Stop(ctx))
+	}()
+	putBlocks := func(start, end uint64) {
+		for h := start; h <= end; h++ {
+			blk, err := block.NewTestingBuilder().
+				SetHeight(h).
+				SetTimeStamp(testutil.TimestampNow()).
+				SignAndBuild(identityset.PrivateKey(27))
+			r.NoError(err)
+			r.NoError(store.PutBlock(ctx, &blk))
+		}
+	}
+	indexer := &testPruneIndexer{height: 35}
+	dao := NewBlockDAOWithIndexersAndCache(store, nil, 16, BlockRetentionOption(12, indexer)).(*blockDAO)
+
+	// blocks are stored in files [1, 10], [11, 20], [21, 30], [31, 35], keep the blocks above 23
+	putBlocks(1, 35)
+	header, err := dao.HeaderByHeight(20)
+	r.NoError(err)
+	_, err = dao.GetBlock(header.HashBlock())
+	r.NoError(err)
+	_, err = dao.FooterByHeight(20)
+	r.NoError(err)
+	r.NoError(dao.prune(ctx, 35))
+	bottom, err := dao.Bottom()
+	r.NoError(err)
+	r.EqualValues(21, bottom)
+	r.Len(indexer.pruned, 20)
+	r.EqualValues(20, indexer.pruned[19])
+	_, err = dao.GetBlockByHeight(20)
+	r.ErrorIs(err, filedao.ErrBlockPruned)
+	// the pruned blocks are purged from the caches
+	_, err = dao.HeaderByHeight(20)
+	r.ErrorIs(err, filedao.ErrBlockPruned)
+	_, err = dao.Header(header.HashBlock())
+	r.Error(err)
+	_, err = dao.GetBlock(header.HashBlock())
+	r.Error(err)
+	_, err = dao.FooterByHeight(20)
+	r.ErrorIs(err, filedao.ErrBlockPruned)
+
+	// indexer lagging behind holds back the pruning
+	indexer.height = 25
+	putBlocks(36, 45)
+	r.NoError(dao.prune(ctx, 45))
+	bottom, err = dao.Bottom()
+	r.NoError(err)
+	r.EqualValues(21, bottom)
+	r.Len(indexer.pruned, 20)
+	indexer.height = 45
+	r.NoError(dao.prune(ctx, 45))
+	bottom, err = dao.Bottom()
+	r.NoError(err)
+	r.EqualValues(31, bottom)
+	r.Len(indexer.pruned, 30)
+}
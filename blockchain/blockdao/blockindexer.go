@@ -35,6 +35,13 @@ type (
 		StartHeight() uint64
 	}
 
+	// BlockIndexerWithPrune defines an interface to delete the index of history blocks
+	BlockIndexerWithPrune interface {
+		BlockIndexer
+		// PruneBlock deletes the index of the block, blocks are pruned in ascending order of height
+		PruneBlock(context.Context, *block.Block) error
+	}
+
 	// BlockIndexerChecker defines a checker of block indexer
 	BlockIndexerChecker struct {
 		dao BlockDAO
@@ -108,6 +115,8 @@ func (bic *BlockIndexerChecker) CheckIndexer(ctx context.Context, indexer BlockI
 		if bcCtx.Tip.Height > 0 {
 			bcCtx.Tip.Hash = tipBlk.HashHeader()
 			bcCtx.Tip.Timestamp = tipBlk.Timestamp()
+			bcCtx.Tip.GasUsed = tipBlk.GasUsed()
+			bcCtx.Tip.BaseFee = tipBlk.BaseFee()
 		} else {
 			bcCtx.Tip.Hash = g.Hash()
 			bcCtx.Tip.Timestamp = time.Unix(g.Timestamp, 0)
@@ -120,6 +129,7 @@ func (bic *BlockIndexerChecker) CheckIndexer(ctx context.Context, indexer BlockI
 					BlockTimeStamp: blk.Timestamp(),
 					Producer:       producer,
 					GasLimit:       g.BlockGasLimitByHeight(i),
+					BaseFee:        blk.BaseFee(),
 				},
 			), blk); err == nil {
 				break
@@ -6,6 +6,7 @@
 package blockchain
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"os"
 	"time"
@@ -17,32 +18,38 @@ import (
 	"go.uber.org/config"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 )
 
 type (
 	// Config is the config struct for blockchain package
 	Config struct {
-		ChainDBPath                string           `yaml:"chainDBPath"`
-		TrieDBPatchFile            string           `yaml:"trieDBPatchFile"`
-		TrieDBPath                 string           `yaml:"trieDBPath"`
-		StakingPatchDir            string           `yaml:"stakingPatchDir"`
-		IndexDBPath                string           `yaml:"indexDBPath"`
-		BloomfilterIndexDBPath     string           `yaml:"bloomfilterIndexDBPath"`
-		CandidateIndexDBPath       string           `yaml:"candidateIndexDBPath"`
-		StakingIndexDBPath         string           `yaml:"stakingIndexDBPath"`
-		SGDIndexDBPath             string           `yaml:"sgdIndexDBPath"`
-		ContractStakingIndexDBPath string           `yaml:"contractStakingIndexDBPath"`
-		ID                         uint32           `yaml:"id"`
-		EVMNetworkID               uint32           `yaml:"evmNetworkID"`
-		Address                    string           `yaml:"address"`
-		ProducerPrivKey            string           `yaml:"producerPrivKey"`
-		ProducerPrivKeySchema      string           `yaml:"producerPrivKeySchema"`
-		SignatureScheme            []string         `yaml:"signatureScheme"`
-		EmptyGenesis               bool             `yaml:"emptyGenesis"`
-		GravityChainDB             db.Config        `yaml:"gravityChainDB"`
-		Committee                  committee.Config `yaml:"committee"`
+		ChainDBPath                 string `yaml:"chainDBPath"`
+		TrieDBPatchFile             string `yaml:"trieDBPatchFile"`
+		TrieDBPath                  string `yaml:"trieDBPath"`
+		StakingPatchDir             string `yaml:"stakingPatchDir"`
+		IndexDBPath                 string `yaml:"indexDBPath"`
+		BloomfilterIndexDBPath      string `yaml:"bloomfilterIndexDBPath"`
+		CandidateIndexDBPath        string `yaml:"candidateIndexDBPath"`
+		StakingIndexDBPath          string `yaml:"stakingIndexDBPath"`
+		SGDIndexDBPath              string `yaml:"sgdIndexDBPath"`
+		ContractStakingIndexDBPath  string `yaml:"contractStakingIndexDBPath"`
+		TokenTransferIndexDBPath    string `yaml:"tokenTransferIndexDBPath"`
+		InternalTransferIndexDBPath string `yaml:"internalTransferIndexDBPath"`
+		ID                          uint32 `yaml:"id"`
+		EVMNetworkID                uint32 `yaml:"evmNetworkID"`
+		Address                     string `yaml:"address"`
+		ProducerPrivKey             string `yaml:"producerPrivKey"`
+		ProducerPrivKeySchema       string `yaml:"producerPrivKeySchema"`
+		// RemoteSigner is the external signing process holding the producer key, instead of ProducerPrivKey
+		RemoteSigner    signer.Config    `yaml:"remoteSigner"`
+		SignatureScheme []string         `yaml:"signatureScheme"`
+		EmptyGenesis    bool             `yaml:"emptyGenesis"`
+		GravityChainDB  db.Config        `yaml:"gravityChainDB"`
+		Committee       committee.Config `yaml:"committee"`
 
 		EnableTrielessStateDB bool `yaml:"enableTrielessStateDB"`
 		// EnableStateDBCaching enables cachedStateDBOption
@@ -57,6 +64,10 @@ type (
 		EnableStakingProtocol bool `yaml:"enableStakingProtocol"`
 		// EnableStakingIndexer enables staking indexer
 		EnableStakingIndexer bool `yaml:"enableStakingIndexer"`
+		// EnableTokenTransferIndexer enables indexing XRC20/XRC721/XRC1155 transfers by address and token
+		EnableTokenTransferIndexer bool `yaml:"enableTokenTransferIndexer"`
+		// EnableInternalTransferIndexer enables indexing the internal transfers in transaction logs by address
+		EnableInternalTransferIndexer bool `yaml:"enableInternalTransferIndexer"`
 		// AllowedBlockGasResidue is the amount of gas remained when block producer could stop processing more actions
 		AllowedBlockGasResidue uint64 `yaml:"allowedBlockGasResidue"`
 		// MaxCacheSize is the max number of blocks that will be put into an LRU cache. 0 means disabled
@@ -71,29 +82,34 @@ type (
 		StreamingBlockBufferSize uint64 `yaml:"streamingBlockBufferSize"`
 		// PersistStakingPatchBlock is the block to persist staking patch
 		PersistStakingPatchBlock uint64 `yaml:"persistStakingPatchBlock"`
+		// AsyncIndexer is the config of feeding the non-consensus-critical indexers asynchronously
+		AsyncIndexer blockdao.AsyncIndexerConfig `yaml:"asyncIndexer"`
 	}
 )
 
 var (
 	// DefaultConfig is the default config of chain
 	DefaultConfig = Config{
-		ChainDBPath:                "/var/data/chain.db",
-		TrieDBPatchFile:            "/var/data/trie.db.patch",
-		TrieDBPath:                 "/var/data/trie.db",
-		StakingPatchDir:            "/var/data",
-		IndexDBPath:                "/var/data/index.db",
-		BloomfilterIndexDBPath:     "/var/data/bloomfilter.index.db",
-		CandidateIndexDBPath:       "/var/data/candidate.index.db",
-		StakingIndexDBPath:         "/var/data/staking.index.db",
-		SGDIndexDBPath:             "/var/data/sgd.index.db",
-		ContractStakingIndexDBPath: "/var/data/contractstaking.index.db",
-		ID:                         1,
-		EVMNetworkID:               4689,
-		Address:                    "",
-		ProducerPrivKey:            generateRandomKey(SigP256k1),
-		SignatureScheme:            []string{SigP256k1},
-		EmptyGenesis:               false,
-		GravityChainDB:             db.Config{DbPath: "/var/data/poll.db", NumRetries: 10},
+		ChainDBPath:                 "/var/data/chain.db",
+		TrieDBPatchFile:             "/var/data/trie.db.patch",
+		TrieDBPath:                  "/var/data/trie.db",
+		StakingPatchDir:             "/var/data",
+		IndexDBPath:                 "/var/data/index.db",
+		BloomfilterIndexDBPath:      "/var/data/bloomfilter.index.db",
+		CandidateIndexDBPath:        "/var/data/candidate.index.db",
+		StakingIndexDBPath:          "/var/data/staking.index.db",
+		SGDIndexDBPath:              "/var/data/sgd.index.db",
+		ContractStakingIndexDBPath:  "/var/data/contractstaking.index.db",
+		TokenTransferIndexDBPath:    "/var/data/tokentransfer.index.db",
+		InternalTransferIndexDBPath: "/var/data/internaltransfer.index.db",
+		ID:                          1,
+		EVMNetworkID:                4689,
+		Address:                     "",
+		ProducerPrivKey:             generateRandomKey(SigP256k1),
+		SignatureScheme:             []string{SigP256k1},
+		RemoteSigner:                signer.DefaultConfig,
+		EmptyGenesis:                false,
+		GravityChainDB:              db.Config{DbPath: "/var/data/poll.db", NumRetries: 10},
 		Committee: committee.Config{
 			GravityChainAPIs: []string{},
 		},
@@ -104,6 +120,8 @@ var (
 		EnableSystemLogIndexer:        false,
 		EnableStakingProtocol:         true,
 		EnableStakingIndexer:          false,
+		EnableTokenTransferIndexer:    false,
+		EnableInternalTransferIndexer: false,
 		AllowedBlockGasResidue:        10000,
 		MaxCacheSize:                  0,
 		PollInitialCandidatesInterval: 10 * time.Second,
@@ -111,6 +129,7 @@ var (
 		WorkingSetCacheSize:           20,
 		StreamingBlockBufferSize:      200,
 		PersistStakingPatchBlock:      19778037,
+		AsyncIndexer:                  blockdao.DefaultAsyncIndexerConfig,
 	}
 
 	// ErrConfig config error
@@ -119,14 +138,33 @@ var (
 
 // ProducerAddress returns the configured producer address derived from key
 func (cfg *Config) ProducerAddress() address.Address {
-	sk := cfg.ProducerPrivateKey()
-	addr := sk.PublicKey().Address()
+	addr := cfg.ProducerPublicKey().Address()
 	if addr == nil {
 		log.L().Panic("Error when constructing producer address")
 	}
 	return addr
 }
 
+// ProducerPublicKey returns the public key of the producer, which is held by the remote signer if configured
+func (cfg *Config) ProducerPublicKey() crypto.PublicKey {
+	if !cfg.RemoteSigner.IsRemote() {
+		return cfg.ProducerPrivateKey().PublicKey()
+	}
+	pk, err := crypto.HexStringToPublicKey(cfg.RemoteSigner.PublicKey)
+	if err != nil {
+		log.L().Panic("Error when decoding producer public key", zap.Error(err))
+	}
+	return pk
+}
+
+// ProducerSigner returns the signer of the producer key, the remote signer should be stopped after use
+func (cfg *Config) ProducerSigner(ctx context.Context) (signer.Signer, error) {
+	if !cfg.RemoteSigner.IsRemote() {
+		return signer.NewLocalSigner(cfg.ProducerPrivateKey()), nil
+	}
+	return signer.NewRemoteSigner(ctx, cfg.RemoteSigner)
+}
+
 // ProducerPrivateKey returns the configured private key
 func (cfg *Config) ProducerPrivateKey() crypto.PrivateKey {
 	sk, err := crypto.HexStringToPrivateKey(cfg.ProducerPrivKey)
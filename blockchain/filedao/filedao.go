@@ -8,7 +8,9 @@ package filedao
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -42,6 +44,7 @@ var (
 	ErrAlreadyExist     = errors.New("block already exist")
 	ErrInvalidTipHeight = errors.New("invalid tip height")
 	ErrDataCorruption   = errors.New("data is corrupted")
+	ErrBlockPruned      = errors.New("block has been pruned")
 )
 
 type (
@@ -71,7 +74,7 @@ type (
 
 	// fileDAO implements FileDAO
 	fileDAO struct {
-		lock              sync.Mutex
+		lock              sync.RWMutex
 		topIndex          uint64
 		splitHeight       uint64
 		cfg               db.Config
@@ -79,12 +82,13 @@ type (
 		legacyFd          FileDAO
 		v2Fd              *FileV2Manager // a collection of v2 db files
 		blockDeserializer *block.Deserializer
+		bottom            uint64 // the lowest height of blocks not pruned
 	}
 )
 
 // NewFileDAO creates an instance of FileDAO
 func NewFileDAO(cfg db.Config, deser *block.Deserializer) (FileDAO, error) {
-	header, err := readFileHeader(cfg.DbPath, FileAll)
+	header, err := readFileHeader(cfg, cfg.DbPath, FileAll)
 	if err != nil {
 		if err != ErrFileNotExist {
 			return nil, err
@@ -133,6 +137,13 @@ func (fd *fileDAO) Start(ctx context.Context) error {
 	} else {
 		fd.currFd = fd.legacyFd
 	}
+	bottom := uint64(1)
+	if fd.legacyFd == nil {
+		bottom = fd.v2Fd.Bottom()
+	} else if fd.cfg.BlockHistoryRetention > 0 {
+		log.L().Warn("Block history pruning is not supported on legacy chain db.")
+	}
+	atomic.StoreUint64(&fd.bottom, bottom)
 	return nil
 }
 
@@ -149,10 +160,17 @@ func (fd *fileDAO) Stop(ctx context.Context) error {
 }
 
 func (fd *fileDAO) Height() (uint64, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	return fd.currFd.Height()
 }
 
 func (fd *fileDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	if err := fd.checkPruned(height); err != nil {
+		return hash.ZeroHash256, err
+	}
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.GetBlockHash(height)
@@ -166,6 +184,8 @@ func (fd *fileDAO) GetBlockHash(height uint64) (hash.Hash256, error) {
 }
 
 func (fd *fileDAO) GetBlockHeight(hash hash.Hash256) (uint64, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	var (
 		height uint64
 		err    error
@@ -183,6 +203,8 @@ func (fd *fileDAO) GetBlockHeight(hash hash.Hash256) (uint64, error) {
 }
 
 func (fd *fileDAO) GetBlock(hash hash.Hash256) (*block.Block, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	var (
 		blk *block.Block
 		err error
@@ -200,6 +222,11 @@ func (fd *fileDAO) GetBlock(hash hash.Hash256) (*block.Block, error) {
 }
 
 func (fd *fileDAO) GetBlockByHeight(height uint64) (*block.Block, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	if err := fd.checkPruned(height); err != nil {
+		return nil, err
+	}
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.GetBlockByHeight(height)
@@ -213,6 +240,8 @@ func (fd *fileDAO) GetBlockByHeight(height uint64) (*block.Block, error) {
 }
 
 func (fd *fileDAO) Header(hash hash.Hash256) (*block.Header, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	var (
 		blk *block.Block
 		err error
@@ -230,6 +259,11 @@ func (fd *fileDAO) Header(hash hash.Hash256) (*block.Header, error) {
 }
 
 func (fd *fileDAO) HeaderByHeight(height uint64) (*block.Header, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	if err := fd.checkPruned(height); err != nil {
+		return nil, err
+	}
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			blk, err := v2.GetBlockByHeight(height)
@@ -247,6 +281,11 @@ func (fd *fileDAO) HeaderByHeight(height uint64) (*block.Header, error) {
 }
 
 func (fd *fileDAO) FooterByHeight(height uint64) (*block.Footer, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	if err := fd.checkPruned(height); err != nil {
+		return nil, err
+	}
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			blk, err := v2.GetBlockByHeight(height)
@@ -264,6 +303,11 @@ func (fd *fileDAO) FooterByHeight(height uint64) (*block.Footer, error) {
 }
 
 func (fd *fileDAO) GetReceipts(height uint64) ([]*action.Receipt, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	if err := fd.checkPruned(height); err != nil {
+		return nil, err
+	}
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.GetReceipts(height)
@@ -277,11 +321,18 @@ func (fd *fileDAO) GetReceipts(height uint64) ([]*action.Receipt, error) {
 }
 
 func (fd *fileDAO) ContainsTransactionLog() bool {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	// TODO: change to ContainsTransactionLog(uint64)
 	return fd.currFd.ContainsTransactionLog()
 }
 
 func (fd *fileDAO) TransactionLogs(height uint64) (*iotextypes.TransactionLogs, error) {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
+	if err := fd.checkPruned(height); err != nil {
+		return nil, err
+	}
 	if fd.v2Fd != nil {
 		if v2 := fd.v2Fd.FileDAOByHeight(height); v2 != nil {
 			return v2.TransactionLogs(height)
@@ -308,10 +359,15 @@ func (fd *fileDAO) PutBlock(ctx context.Context, blk *block.Block) error {
 			return err
 		}
 	}
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	return fd.currFd.PutBlock(ctx, blk)
 }
 
 func (fd *fileDAO) prepNextDbFile(height uint64) error {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+
 	tip, err := fd.currFd.Height()
 	if err != nil {
 		return err
@@ -320,9 +376,6 @@ func (fd *fileDAO) prepNextDbFile(height uint64) error {
 		return ErrInvalidTipHeight
 	}
 
-	fd.lock.Lock()
-	defer fd.lock.Unlock()
-
 	if height > fd.splitHeight && height-fd.splitHeight >= fd.cfg.V2BlocksToSplitDB {
 		return fd.addNewV2File(height)
 	}
@@ -363,21 +416,109 @@ func (fd *fileDAO) addNewV2File(height uint64) error {
 }
 
 func (fd *fileDAO) DeleteTipBlock() error {
+	fd.lock.RLock()
+	defer fd.lock.RUnlock()
 	return fd.currFd.DeleteTipBlock()
 }
 
+// Bottom returns the lowest height of blocks which are not pruned
+func (fd *fileDAO) Bottom() (uint64, error) {
+	return atomic.LoadUint64(&fd.bottom), nil
+}
+
+// PrunableHeight returns the tip height of the highest v2 file whose blocks are all below the horizon, the file
+// being written to is never pruned. It returns 0 if no file can be pruned
+func (fd *fileDAO) PrunableHeight(horizon uint64) (uint64, error) {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+
+	if fd.legacyFd != nil {
+		// pruning the legacy files is not supported, and the v2 files after them are kept as well
+		return 0, nil
+	}
+	return fd.v2Fd.PrunableHeight(horizon), nil
+}
+
+// Prune deletes the v2 files whose blocks are all at or below the height. The master file identifies the chain db,
+// so it is replaced by an empty file instead of being deleted. The files are closed under the write lock, so no read
+// is in flight on them
+func (fd *fileDAO) Prune(height uint64) error {
+	fd.lock.Lock()
+	defer fd.lock.Unlock()
+
+	if fd.legacyFd != nil {
+		return ErrNotSupported
+	}
+	var (
+		ctx     = context.Background()
+		indices = fd.v2Fd.Indices
+		n       int
+	)
+	for ; n < len(indices)-1; n++ {
+		if end, _ := indices[n].fd.Height(); end > height {
+			break
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	// blocks below the new bottom are not served from now on
+	bottom, err := indices[n].fd.Bottom()
+	if err != nil {
+		return err
+	}
+	atomic.StoreUint64(&fd.bottom, bottom)
+
+	kept := make([]*fileV2Index, 0, len(indices)-n+1)
+	for _, idx := range indices[:n] {
+		start, _ := idx.fd.Bottom()
+		if end, _ := idx.fd.Height(); end < start {
+			// empty file, which is the master file already pruned
+			kept = append(kept, idx)
+			continue
+		}
+		if err := idx.fd.Stop(ctx); err != nil {
+			return err
+		}
+		if err := os.RemoveAll(idx.fd.filename); err != nil {
+			return errors.Wrapf(err, "failed to delete file %s", idx.fd.filename)
+		}
+		log.L().Info("Pruned chain db file.", zap.String("file", idx.fd.filename), zap.Uint64("start", start))
+		if idx.fd.filename != fd.cfg.DbPath {
+			continue
+		}
+		master, err := newFileDAOv2(1, fd.cfg, fd.blockDeserializer)
+		if err != nil {
+			return err
+		}
+		if err := master.Start(ctx); err != nil {
+			return err
+		}
+		kept = append(kept, &fileV2Index{start: 1, end: 0, fd: master})
+	}
+	fd.v2Fd.Indices = append(kept, indices[n:]...)
+	return nil
+}
+
+func (fd *fileDAO) checkPruned(height uint64) error {
+	if bottom := atomic.LoadUint64(&fd.bottom); height > 0 && height < bottom {
+		return errors.Wrapf(ErrBlockPruned, "block %d is below the lowest available height %d", height, bottom)
+	}
+	return nil
+}
+
 // CreateFileDAO creates FileDAO according to master file
 func CreateFileDAO(legacy bool, cfg db.Config, deser *block.Deserializer) (FileDAO, error) {
 	fd := fileDAO{splitHeight: 1, cfg: cfg, blockDeserializer: deser}
 	fds := []*fileDAOv2{}
-	v2Top, v2Files := checkAuxFiles(cfg.DbPath, FileV2)
+	v2Top, v2Files := checkAuxFiles(cfg, cfg.DbPath, FileV2)
 	if legacy {
 		legacyFd, err := newFileDAOLegacy(cfg, deser)
 		if err != nil {
 			return nil, err
 		}
 		fd.legacyFd = legacyFd
-		fd.topIndex, _ = checkAuxFiles(cfg.DbPath, FileLegacyAuxiliary)
+		fd.topIndex, _ = checkAuxFiles(cfg, cfg.DbPath, FileLegacyAuxiliary)
 
 		// legacy master file with no v2 files, early exit
 		if len(v2Files) == 0 {
@@ -385,14 +526,22 @@ func CreateFileDAO(legacy bool, cfg db.Config, deser *block.Deserializer) (FileD
 		}
 	} else {
 		// v2 master file
-		fds = append(fds, openFileDAOv2(cfg, deser))
+		v2Fd, err := openFileDAOv2(cfg, deser)
+		if err != nil {
+			return nil, err
+		}
+		fds = append(fds, v2Fd)
 	}
 
 	// populate v2 files into v2 manager
 	if len(v2Files) > 0 {
 		for _, name := range v2Files {
 			cfg.DbPath = name
-			fds = append(fds, openFileDAOv2(cfg, deser))
+			v2Fd, err := openFileDAOv2(cfg, deser)
+			if err != nil {
+				return nil, err
+			}
+			fds = append(fds, v2Fd)
 		}
 
 		// v2 file's top index overrides v1's top
@@ -40,7 +40,11 @@ type (
 )
 
 // ReadHeaderLegacy reads header from KVStore
-func ReadHeaderLegacy(kv *db.BoltDB) (*FileHeader, error) {
+func ReadHeaderLegacy(kvStore db.KVStore) (*FileHeader, error) {
+	kv, ok := kvStore.(interface{ BucketExists(string) bool })
+	if !ok {
+		return nil, ErrFileInvalid
+	}
 	// legacy file has these 6 buckets
 	if !kv.BucketExists(_receiptsNS) || !kv.BucketExists(_blockHeaderNS) ||
 		!kv.BucketExists(_blockBodyNS) || !kv.BucketExists(_blockFooterNS) {
@@ -48,7 +52,7 @@ func ReadHeaderLegacy(kv *db.BoltDB) (*FileHeader, error) {
 	}
 
 	// check tip height stored in master file
-	_, err := getValueMustBe8Bytes(kv, _blockNS, _topHeightKey)
+	_, err := getValueMustBe8Bytes(kvStore, _blockNS, _topHeightKey)
 	if err == nil && kv.BucketExists(_blockHashHeightMappingNS) {
 		return &FileHeader{Version: FileLegacyMaster}, nil
 	}
@@ -61,10 +61,14 @@ type (
 
 // newFileDAOLegacy creates a new legacy file
 func newFileDAOLegacy(cfg db.Config, deser *block.Deserializer) (FileDAO, error) {
+	kvStore, err := db.CreateKVStore(cfg, cfg.DbPath)
+	if err != nil {
+		return nil, err
+	}
 	return &fileDAOLegacy{
 		compressBlock: cfg.CompressLegacy,
 		cfg:           cfg,
-		kvStore:       db.NewBoltDB(cfg),
+		kvStore:       kvStore,
 		kvStores:      cache.NewThreadSafeLruCache(0),
 		deser:         deser,
 	}, nil
@@ -89,7 +93,7 @@ func (fd *fileDAOLegacy) Start(ctx context.Context) error {
 
 	// loop thru all legacy files
 	base := fd.cfg.DbPath
-	_, files := checkAuxFiles(base, FileLegacyAuxiliary)
+	_, files := checkAuxFiles(fd.cfg, base, FileLegacyAuxiliary)
 	var maxN uint64
 	for _, file := range files {
 		index, ok := isAuxFile(file, base)
@@ -602,7 +606,9 @@ func (fd *fileDAOLegacy) openDB(idx uint64) (kvStore db.KVStore, index uint64, e
 		newFile = true
 	}
 
-	kvStore = db.NewBoltDB(cfg)
+	if kvStore, err = db.CreateKVStore(cfg, cfg.DbPath); err != nil {
+		return
+	}
 	fd.kvStores.Add(idx, kvStore)
 	err = kvStore.Start(context.Background())
 	if err != nil {
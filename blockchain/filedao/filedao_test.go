@@ -9,10 +9,13 @@ import (
 	"context"
 	"encoding/hex"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -62,9 +65,9 @@ func TestReadFileHeader(t *testing.T) {
 	cfg.DbPath = "./filedao_v2.db"
 
 	// test non-existing file
-	_, err := readFileHeader(cfg.DbPath, FileLegacyMaster)
+	_, err := readFileHeader(cfg, cfg.DbPath, FileLegacyMaster)
 	r.Equal(ErrFileNotExist, err)
-	_, err = readFileHeader(cfg.DbPath, FileAll)
+	_, err = readFileHeader(cfg, cfg.DbPath, FileAll)
 	r.Equal(ErrFileNotExist, err)
 
 	// empty legacy file is invalid
@@ -74,9 +77,9 @@ func TestReadFileHeader(t *testing.T) {
 	ctx := context.Background()
 	r.NoError(legacy.Start(ctx))
 	r.NoError(legacy.Stop(ctx))
-	_, err = readFileHeader(cfg.DbPath, FileLegacyMaster)
+	_, err = readFileHeader(cfg, cfg.DbPath, FileLegacyMaster)
 	r.Equal(ErrFileInvalid, err)
-	_, err = readFileHeader(cfg.DbPath, FileAll)
+	_, err = readFileHeader(cfg, cfg.DbPath, FileAll)
 	r.Equal(ErrFileInvalid, err)
 
 	// commit 1 block to make it a valid legacy file
@@ -102,7 +105,7 @@ func TestReadFileHeader(t *testing.T) {
 		{FileAll, FileLegacyMaster, nil},
 	}
 	for _, v := range test1 {
-		h, err := readFileHeader(cfg.DbPath, v.checkType)
+		h, err := readFileHeader(cfg, cfg.DbPath, v.checkType)
 		r.Equal(v.err, err)
 		if err == nil {
 			r.Equal(v.version, h.Version)
@@ -120,14 +123,14 @@ func TestReadFileHeader(t *testing.T) {
 		{FileAll, FileV2, nil},
 	}
 	for _, v := range test2 {
-		h, err := readFileHeader(cfg.DbPath, v.checkType)
+		h, err := readFileHeader(cfg, cfg.DbPath, v.checkType)
 		r.Equal(v.err, err)
 		if err == nil {
 			r.Equal(v.version, h.Version)
 		}
 	}
 
-	r.Panics(func() { readFileHeader(cfg.DbPath, "") })
+	r.Panics(func() { readFileHeader(cfg, cfg.DbPath, "") })
 }
 
 func TestNewFileDAOSplitV2(t *testing.T) {
@@ -139,7 +142,7 @@ func TestNewFileDAOSplitV2(t *testing.T) {
 	defer os.RemoveAll(cfg.DbPath)
 
 	// test non-existing file
-	_, err := readFileHeader(cfg.DbPath, FileAll)
+	_, err := readFileHeader(cfg, cfg.DbPath, FileAll)
 	r.Equal(ErrFileNotExist, err)
 
 	// test empty db file, this will create new v2 file
@@ -147,7 +150,7 @@ func TestNewFileDAOSplitV2(t *testing.T) {
 	fd, err := NewFileDAO(cfg, deser)
 	r.NoError(err)
 	r.NotNil(fd)
-	h, err := readFileHeader(cfg.DbPath, FileAll)
+	h, err := readFileHeader(cfg, cfg.DbPath, FileAll)
 	r.NoError(err)
 	r.Equal(FileV2, h.Version)
 	ctx := context.Background()
@@ -175,7 +178,7 @@ func TestNewFileDAOSplitV2(t *testing.T) {
 	r.EqualValues(21, fm.splitHeight)
 	testVerifyChainDB(t, fd, 1, 25)
 	r.NoError(fd.Stop(ctx))
-	top, files := checkAuxFiles(cfg.DbPath, FileV2)
+	top, files := checkAuxFiles(cfg, cfg.DbPath, FileV2)
 	r.EqualValues(2, top)
 	r.Equal(2, len(files))
 	file1 := kthAuxFileName("./filedao_v2.db", 1)
@@ -186,6 +189,223 @@ func TestNewFileDAOSplitV2(t *testing.T) {
 	os.RemoveAll(file2)
 }
 
+func TestNewFileDAOSplitV2Pebble(t *testing.T) {
+	r := require.New(t)
+
+	cfg := db.DefaultConfig
+	cfg.Backend = db.BackendPebble
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = filepath.Join(t.TempDir(), "filedao_v2.db")
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	ctx := context.Background()
+
+	fd, err := NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	r.NoError(testCommitBlocks(t, fd, 1, 25, hash.ZeroHash256))
+	testVerifyChainDB(t, fd, 1, 25)
+	r.NoError(fd.Stop(ctx))
+
+	// the chain db and split files are pebble dbs
+	for _, name := range []string{cfg.DbPath, kthAuxFileName(cfg.DbPath, 1), kthAuxFileName(cfg.DbPath, 2)} {
+		h, err := readFileHeader(cfg, name, FileV2)
+		r.NoError(err)
+		r.Equal(FileV2, h.Version)
+	}
+	boltCfg := cfg
+	boltCfg.Backend = db.BackendBolt
+	_, err = readFileHeader(boltCfg, cfg.DbPath, FileAll)
+	r.Equal(ErrFileNotExist, err)
+	top, files := checkAuxFiles(cfg, cfg.DbPath, FileV2)
+	r.EqualValues(2, top)
+	r.Equal(2, len(files))
+	allFiles, err := ChainDBFiles(cfg)
+	r.NoError(err)
+	r.Equal(map[uint64]string{
+		0: cfg.DbPath,
+		1: kthAuxFileName(cfg.DbPath, 1),
+		2: kthAuxFileName(cfg.DbPath, 2),
+	}, allFiles)
+
+	// reopen the split pebble files
+	fd, err = NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	defer fd.Stop(ctx)
+	r.EqualValues(2, fd.(*fileDAO).topIndex)
+	testVerifyChainDB(t, fd, 1, 25)
+}
+
+func TestNewFileDAOMixedCompressors(t *testing.T) {
+	r := require.New(t)
+
+	dir := t.TempDir()
+	cfg := db.DefaultConfig
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = filepath.Join(dir, "filedao_v2.db")
+	cfg.Compressor = compress.Snappy
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	ctx := context.Background()
+
+	// the master file is compressed with snappy
+	fd, err := NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	r.NoError(testCommitBlocks(t, fd, 1, 10, hash.ZeroHash256))
+	r.NoError(fd.Stop(ctx))
+
+	// new files are compressed with zstd and the dictionary
+	blk := createTestingBlock(block.NewTestingBuilder(), 1, hash.ZeroHash256)
+	dict, err := (&block.Store{Block: blk, Receipts: blk.Receipts}).Serialize()
+	r.NoError(err)
+	cfg.ZstdDictionary = filepath.Join(dir, "zstd.dict")
+	r.NoError(os.WriteFile(cfg.ZstdDictionary, dict, 0600))
+	cfg.Compressor = compress.Zstd
+	cfg.ZstdLevel = 19
+	fd, err = NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	r.NoError(testCommitBlocks(t, fd, 11, 25, hash.ZeroHash256))
+	testVerifyChainDB(t, fd, 1, 25)
+	r.NoError(fd.Stop(ctx))
+	for i, comp := range []string{compress.Snappy, compress.Zstd, compress.Zstd} {
+		name := cfg.DbPath
+		if i > 0 {
+			name = kthAuxFileName(cfg.DbPath, uint64(i))
+		}
+		h, err := readFileHeader(cfg, name, FileV2)
+		r.NoError(err)
+		r.Equal(comp, h.Compressor)
+	}
+
+	// the dictionary is read from the file itself
+	r.NoError(os.Remove(cfg.ZstdDictionary))
+	cfg.Compressor = compress.Gzip
+	cfg.ZstdDictionary = ""
+	fd, err = NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	testVerifyChainDB(t, fd, 1, 25)
+	r.NoError(fd.Stop(ctx))
+
+	// missing dictionary fails to create new file
+	cfg.Compressor = compress.Zstd
+	cfg.ZstdDictionary = filepath.Join(dir, "notexist.dict")
+	_, err = newFileDAOv2(26, cfg, deser)
+	r.Error(err)
+}
+
+func TestFileDAOPrune(t *testing.T) {
+	r := require.New(t)
+
+	cfg := db.DefaultConfig
+	cfg.V2BlocksToSplitDB = 10
+	cfg.DbPath = filepath.Join(t.TempDir(), "filedao_v2.db")
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	ctx := context.Background()
+
+	fd, err := NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	fm := fd.(*fileDAO)
+	bottom, err := fm.Bottom()
+	r.NoError(err)
+	r.EqualValues(1, bottom)
+	// the file being written to is not pruned
+	r.NoError(testCommitBlocks(t, fd, 1, 8, hash.ZeroHash256))
+	height, err := fm.PrunableHeight(100)
+	r.NoError(err)
+	r.Zero(height)
+
+	// blocks are stored in 4 files: [1, 10], [11, 20], [21, 30], [31, 35]
+	r.NoError(testCommitBlocks(t, fd, 9, 35, hash.ZeroHash256))
+	for _, v := range []struct {
+		horizon, height uint64
+	}{
+		{5, 0}, {10, 0}, {11, 10}, {25, 20}, {35, 30}, {100, 30},
+	} {
+		height, err = fm.PrunableHeight(v.horizon)
+		r.NoError(err)
+		r.Equal(v.height, height)
+	}
+	r.NoError(fm.Prune(20))
+	bottom, err = fm.Bottom()
+	r.NoError(err)
+	r.EqualValues(21, bottom)
+	testVerifyChainDB(t, fd, 21, 35)
+	for _, h := range []uint64{1, 10, 20} {
+		_, err = fd.GetBlockByHeight(h)
+		r.ErrorIs(err, ErrBlockPruned)
+		_, err = fd.GetReceipts(h)
+		r.ErrorIs(err, ErrBlockPruned)
+		_, err = fd.GetBlockHash(h)
+		r.ErrorIs(err, ErrBlockPruned)
+	}
+	_, err = os.Stat(kthAuxFileName(cfg.DbPath, 1))
+	r.True(os.IsNotExist(err))
+	height, err = fm.PrunableHeight(25)
+	r.NoError(err)
+	r.Zero(height)
+	r.NoError(fm.Prune(20))
+	r.NoError(fd.Stop(ctx))
+	// the master file is kept without blocks
+	header, err := readFileHeader(cfg, cfg.DbPath, FileAll)
+	r.NoError(err)
+	r.Equal(FileV2, header.Version)
+
+	// reopen the pruned chain db
+	fd, err = NewFileDAO(cfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	fm = fd.(*fileDAO)
+	bottom, err = fm.Bottom()
+	r.NoError(err)
+	r.EqualValues(21, bottom)
+	r.NoError(testCommitBlocks(t, fd, 36, 45, hash.ZeroHash256))
+	testVerifyChainDB(t, fd, 21, 45)
+	// reads racing with the pruning either get the block or see it pruned
+	var (
+		wg, started sync.WaitGroup
+		stop        = make(chan struct{})
+	)
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		started.Add(1)
+		go func() {
+			var once sync.Once
+			defer wg.Done()
+			defer once.Do(started.Done)
+			for h, n := uint64(21), 0; ; h, n = 21+(h-20)%25, n+1 {
+				if n == 1 {
+					once.Do(started.Done)
+				}
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if _, err := fd.GetBlockByHeight(h); err != nil && !errors.Is(err, ErrBlockPruned) {
+					t.Errorf("failed to read block %d: %v", h, err)
+					return
+				}
+				if _, err := fd.GetReceipts(h); err != nil && !errors.Is(err, ErrBlockPruned) {
+					t.Errorf("failed to read receipts %d: %v", h, err)
+					return
+				}
+			}
+		}()
+	}
+	started.Wait()
+	r.NoError(fm.Prune(40))
+	close(stop)
+	wg.Wait()
+	bottom, err = fm.Bottom()
+	r.NoError(err)
+	r.EqualValues(41, bottom)
+	testVerifyChainDB(t, fd, 41, 45)
+	r.NoError(fd.Stop(ctx))
+}
+
 func TestNewFileDAOSplitLegacy(t *testing.T) {
 	r := require.New(t)
 
@@ -240,26 +460,26 @@ func TestNewFileDAOSplitLegacy(t *testing.T) {
 	defer os.RemoveAll(file2)
 	defer os.RemoveAll(file3)
 	defer os.RemoveAll(file4)
-	h, err := readFileHeader(cfg.DbPath, FileAll)
+	h, err := readFileHeader(cfg, cfg.DbPath, FileAll)
 	r.NoError(err)
 	r.Equal(FileLegacyMaster, h.Version)
-	h, err = readFileHeader(file1, FileLegacyAuxiliary)
+	h, err = readFileHeader(cfg, file1, FileLegacyAuxiliary)
 	r.NoError(err)
 	r.Equal(FileLegacyAuxiliary, h.Version)
-	h, err = readFileHeader(file2, FileV2)
+	h, err = readFileHeader(cfg, file2, FileV2)
 	r.NoError(err)
 	r.Equal(FileV2, h.Version)
-	h, err = readFileHeader(file3, FileV2)
+	h, err = readFileHeader(cfg, file3, FileV2)
 	r.NoError(err)
 	r.Equal(FileV2, h.Version)
-	h, err = readFileHeader(file4, FileV2)
+	h, err = readFileHeader(cfg, file4, FileV2)
 	r.NoError(err)
 	r.Equal(FileV2, h.Version)
-	top, files := checkAuxFiles(cfg.DbPath, FileLegacyAuxiliary)
+	top, files := checkAuxFiles(cfg, cfg.DbPath, FileLegacyAuxiliary)
 	r.EqualValues(1, top)
 	r.Equal(1, len(files))
 	r.Equal(files[0], file1)
-	top, files = checkAuxFiles(cfg.DbPath, FileV2)
+	top, files = checkAuxFiles(cfg, cfg.DbPath, FileV2)
 	r.EqualValues(4, top)
 	r.Equal(3, len(files))
 	r.Equal(files[0], file2)
@@ -311,9 +531,9 @@ func TestCheckFiles(t *testing.T) {
 
 	cfg := db.DefaultConfig
 	cfg.DbPath = "./filedao_v2.db"
-	_, files := checkAuxFiles(cfg.DbPath, FileLegacyAuxiliary)
+	_, files := checkAuxFiles(cfg, cfg.DbPath, FileLegacyAuxiliary)
 	r.Nil(files)
-	_, files = checkAuxFiles(cfg.DbPath, FileV2)
+	_, files = checkAuxFiles(cfg, cfg.DbPath, FileV2)
 	r.Nil(files)
 
 	deser := block.NewDeserializer(_defaultEVMNetworkID)
@@ -327,7 +547,7 @@ func TestCheckFiles(t *testing.T) {
 			os.RemoveAll(kthAuxFileName("./filedao_v2.db", uint64(i)))
 		}
 	}()
-	top, files := checkAuxFiles("./filedao_v2.db", FileV2)
+	top, files := checkAuxFiles(cfg, "./filedao_v2.db", FileV2)
 	r.EqualValues(3, top)
 	r.Equal(3, len(files))
 	for i := 1; i <= 3; i++ {
@@ -19,12 +19,15 @@ import (
 	"github.com/iotexproject/iotex-core/db"
 )
 
-func readFileHeader(filename, fileType string) (*FileHeader, error) {
-	if err := fileExists(filename); err != nil {
+func readFileHeader(cfg db.Config, filename, fileType string) (*FileHeader, error) {
+	if err := fileExists(filename, cfg.Backend == db.BackendPebble); err != nil {
 		return nil, err
 	}
 
-	file := db.NewBoltDB(db.Config{DbPath: filename, NumRetries: 3})
+	file, err := db.CreateKVStore(db.Config{NumRetries: 3, Backend: cfg.Backend}, filename)
+	if err != nil {
+		return nil, err
+	}
 	ctx := context.Background()
 	if err := file.Start(ctx); err != nil {
 		// not a valid db file
@@ -53,9 +56,10 @@ func readFileHeader(filename, fileType string) (*FileHeader, error) {
 	}
 }
 
-func fileExists(name string) error {
+// fileExists checks the db file, a pebble db is stored in a directory
+func fileExists(name string, isDir bool) error {
 	info, err := os.Stat(name)
-	if err != nil || info.IsDir() || info.Size() == 0 {
+	if err != nil || info.IsDir() != isDir || (!isDir && info.Size() == 0) {
 		return ErrFileNotExist
 	}
 	err = syscall.Access(name, syscall.O_RDWR)
@@ -65,7 +69,7 @@ func fileExists(name string) error {
 	return nil
 }
 
-func checkAuxFiles(filename, fileType string) (uint64, []string) {
+func checkAuxFiles(cfg db.Config, filename, fileType string) (uint64, []string) {
 	file := path.Base(filename)
 	if file == "/" {
 		return 0, nil
@@ -82,7 +86,7 @@ func checkAuxFiles(filename, fileType string) (uint64, []string) {
 		possible []string
 	)
 	for _, v := range files {
-		if v.IsDir() {
+		if v.IsDir() != (cfg.Backend == db.BackendPebble) {
 			continue
 		}
 		index, ok := isAuxFile(v.Name(), file)
@@ -90,7 +94,7 @@ func checkAuxFiles(filename, fileType string) (uint64, []string) {
 			continue
 		}
 		name := dir + "/" + v.Name()
-		header, err := readFileHeader(name, fileType)
+		header, err := readFileHeader(cfg, name, fileType)
 		if err == nil && header.Version == fileType {
 			possible = append(possible, name)
 			if index > top {
@@ -130,6 +134,33 @@ func kthAuxFileName(file string, k uint64) string {
 	return file + fmt.Sprintf("-%08d", k) + ext
 }
 
+// AuxFileName returns the k-th auxiliary filename of the chain db file
+func AuxFileName(file string, k uint64) string {
+	return kthAuxFileName(file, k)
+}
+
+// ChainDBFiles returns all files of the chain db at cfg.DbPath keyed by their index, the master file has index 0
+// and is followed by the auxiliary files found the same way as the chain db is opened
+func ChainDBFiles(cfg db.Config) (map[uint64]string, error) {
+	header, err := readFileHeader(cfg, cfg.DbPath, FileAll)
+	if err != nil {
+		return nil, err
+	}
+	fileTypes := []string{FileV2}
+	if header.Version == FileLegacyMaster {
+		fileTypes = append(fileTypes, FileLegacyAuxiliary)
+	}
+	files := map[uint64]string{0: cfg.DbPath}
+	for _, fileType := range fileTypes {
+		_, names := checkAuxFiles(cfg, cfg.DbPath, fileType)
+		for _, name := range names {
+			index, _ := isAuxFile(path.Base(name), path.Base(cfg.DbPath))
+			files[index] = name
+		}
+	}
+	return files, nil
+}
+
 func hashKey(h hash.Hash256) []byte {
 	return append(_hashPrefix, h[:]...)
 }
@@ -7,6 +7,7 @@ package filedao
 
 import (
 	"context"
+	"os"
 	"sync/atomic"
 	"unsafe"
 
@@ -20,6 +21,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/compress"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 )
 
@@ -32,6 +34,7 @@ const (
 
 var (
 	_fileHeaderKey = []byte("fh")
+	_zstdDictKey   = []byte("zd")
 )
 
 type (
@@ -48,6 +51,9 @@ type (
 		blkStore        db.CountingIndex // store raw blocks
 		sysStore        db.CountingIndex // store transaction log
 		deser           *block.Deserializer
+		zstdLevel       int
+		zstdDict        []byte // dictionary of a new file, stored in the file itself
+		zstd            *compress.ZstdCompressor
 	}
 )
 
@@ -57,6 +63,10 @@ func newFileDAOv2(bottom uint64, cfg db.Config, deser *block.Deserializer) (*fil
 		return nil, ErrNotSupported
 	}
 
+	kvStore, err := db.CreateKVStore(cfg, cfg.DbPath)
+	if err != nil {
+		return nil, err
+	}
 	fd := fileDAOv2{
 		filename: cfg.DbPath,
 		header: &FileHeader{
@@ -69,22 +79,35 @@ func newFileDAOv2(bottom uint64, cfg db.Config, deser *block.Deserializer) (*fil
 			Height: bottom - 1,
 		},
 		blkStorePbCache: cache.NewThreadSafeLruCache(16),
-		kvStore:         db.NewBoltDB(cfg),
+		kvStore:         kvStore,
 		batch:           batch.NewBatch(),
 		deser:           deser,
+		zstdLevel:       cfg.ZstdLevel,
+	}
+	if cfg.Compressor == compress.Zstd && cfg.ZstdDictionary != "" {
+		dict, err := os.ReadFile(cfg.ZstdDictionary)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read zstd dictionary")
+		}
+		fd.zstdDict = dict
 	}
 	return &fd, nil
 }
 
 // openFileDAOv2 opens an existing v2 file
-func openFileDAOv2(cfg db.Config, deser *block.Deserializer) *fileDAOv2 {
+func openFileDAOv2(cfg db.Config, deser *block.Deserializer) (*fileDAOv2, error) {
+	kvStore, err := db.CreateKVStore(cfg, cfg.DbPath)
+	if err != nil {
+		return nil, err
+	}
 	return &fileDAOv2{
 		filename:        cfg.DbPath,
 		blkStorePbCache: cache.NewThreadSafeLruCache(16),
-		kvStore:         db.NewBoltDB(cfg),
+		kvStore:         kvStore,
 		batch:           batch.NewBatch(),
 		deser:           deser,
-	}
+		zstdLevel:       cfg.ZstdLevel,
+	}, nil
 }
 
 func (fd *fileDAOv2) Start(ctx context.Context) error {
@@ -105,12 +128,31 @@ func (fd *fileDAOv2) Start(ctx context.Context) error {
 		if err = WriteTip(fd.kvStore, _headerDataNs, _topHeightKey, fd.tip); err != nil {
 			return err
 		}
+		if len(fd.zstdDict) > 0 {
+			if err = fd.kvStore.Put(_headerDataNs, _zstdDictKey, fd.zstdDict); err != nil {
+				return errors.Wrap(err, "failed to write zstd dictionary")
+			}
+		}
 	} else {
 		fd.header = header
 		// read file tip
 		if fd.tip, err = ReadTip(fd.kvStore, _headerDataNs, _topHeightKey); err != nil {
 			return err
 		}
+		if fd.header.Compressor == compress.Zstd {
+			if fd.zstdDict, err = fd.kvStore.Get(_headerDataNs, _zstdDictKey); err != nil && errors.Cause(err) != db.ErrNotExist {
+				return errors.Wrap(err, "failed to read zstd dictionary")
+			}
+		}
+	}
+	if fd.header.Compressor == compress.Zstd {
+		level := fd.zstdLevel
+		if level == 0 {
+			level = compress.DefaultZstdLevel
+		}
+		if fd.zstd, err = compress.NewZstdCompressor(level, fd.zstdDict); err != nil {
+			return err
+		}
 	}
 
 	// create counting index for hash, blk, and transaction log
@@ -213,7 +255,7 @@ func (fd *fileDAOv2) TransactionLogs(height uint64) (*iotextypes.TransactionLogs
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get transaction log at height %d", height)
 	}
-	value, err = decompBytes(value, fd.header.Compressor)
+	value, err = fd.decompBytes(value)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get transaction log at height %d", height)
 	}
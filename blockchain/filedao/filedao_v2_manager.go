@@ -16,7 +16,7 @@ type (
 		fd         *fileDAOv2
 	}
 
-	// FileV2Manager manages collection of v2 files
+	// FileV2Manager manages collection of v2 files, it is not thread-safe and is guarded by the lock of fileDAO
 	FileV2Manager struct {
 		Indices []*fileV2Index
 	}
@@ -136,6 +136,35 @@ func (fm *FileV2Manager) AddFileDAO(fd *fileDAOv2, start uint64) error {
 	return nil
 }
 
+// Bottom returns the lowest height of blocks stored in the v2 files
+func (fm *FileV2Manager) Bottom() uint64 {
+	for _, file := range fm.Indices {
+		start, _ := file.fd.Bottom()
+		if end, _ := file.fd.Height(); end >= start {
+			return start
+		}
+	}
+	// no block is stored yet
+	start, _ := fm.Indices[len(fm.Indices)-1].fd.Bottom()
+	return start
+}
+
+// PrunableHeight returns the tip height of the highest file whose blocks are all below the horizon, the top file
+// is not taken into account
+func (fm *FileV2Manager) PrunableHeight(horizon uint64) uint64 {
+	var height uint64
+	for _, file := range fm.Indices[:len(fm.Indices)-1] {
+		end, _ := file.fd.Height()
+		if end >= horizon {
+			break
+		}
+		if end > height {
+			height = end
+		}
+	}
+	return height
+}
+
 // TopFd returns the top (with maximum height) v2 file
 func (fm *FileV2Manager) TopFd() (BaseFileDAO, uint64) {
 	top := fm.Indices[len(fm.Indices)-1]
@@ -18,10 +18,12 @@ import (
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/compress"
+	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/testutil"
 )
 
@@ -262,7 +264,7 @@ func TestNewFdInterface(t *testing.T) {
 	genesis.SetGenesisTimestamp(genesis.Default.Timestamp)
 	block.LoadGenesisHash(&genesis.Default)
 
-	for _, compress := range []string{"", compress.Snappy} {
+	for _, compress := range []string{"", compress.Snappy, compress.Zstd} {
 		for _, start := range []uint64{1, 5, _blockStoreBatchSize + 1, 4 * _blockStoreBatchSize} {
 			cfg.Compressor = compress
 			t.Run("test fileDAOv2 interface", func(t *testing.T) {
@@ -291,7 +293,8 @@ func TestNewFdStart(t *testing.T) {
 			r.NoError(fd.Stop(ctx))
 
 			// start from existing file
-			fd = openFileDAOv2(cfg, deser)
+			fd, err = openFileDAOv2(cfg, deser)
+			r.NoError(err)
 			r.NoError(fd.Start(ctx))
 			height, err = fd.Bottom()
 			r.NoError(err)
@@ -349,3 +352,91 @@ func TestNewFdStart(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkBlockStoreCompression(b *testing.B) {
+	r := require.New(b)
+	deser := block.NewDeserializer(_defaultEVMNetworkID)
+	batchSize := uint64(db.DefaultConfig.BlockStoreBatchSize)
+
+	// pack a batch of blocks with transfers as they are written to the block store
+	var (
+		buffer  = newStagingBuffer(batchSize, deser)
+		builder = block.NewTestingBuilder()
+		prev    = hash.ZeroHash256
+		nonce   uint64
+	)
+	for i := uint64(0); i < batchSize; i++ {
+		var (
+			acts     []*action.SealedEnvelope
+			receipts []*action.Receipt
+		)
+		for j := 0; j < 100; j++ {
+			nonce++
+			selp, err := action.SignedTransfer(identityset.Address(j%30).String(), identityset.PrivateKey(j%30+1), nonce, big.NewInt(int64(j)), nil, 10000, big.NewInt(1000000000000))
+			r.NoError(err)
+			h, err := selp.Hash()
+			r.NoError(err)
+			acts = append(acts, selp)
+			receipts = append(receipts, (&action.Receipt{
+				Status:          1,
+				BlockHeight:     i + 1,
+				ActionHash:      h,
+				GasConsumed:     10000,
+				ContractAddress: identityset.Address(j % 30).String(),
+			}).AddTransactionLogs(&action.TransactionLog{
+				Type:      iotextypes.TransactionLogType_NATIVE_TRANSFER,
+				Amount:    big.NewInt(int64(j)),
+				Sender:    identityset.Address(j%30 + 1).String(),
+				Recipient: identityset.Address(j % 30).String(),
+			}))
+		}
+		blk, err := builder.
+			SetHeight(i + 1).
+			SetPrevBlockHash(prev).
+			AddActions(acts...).
+			SetReceipts(receipts).
+			SetTimeStamp(testutil.TimestampNow().UTC()).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		prev = blk.HashBlock()
+		ser, err := (&block.Store{Block: &blk, Receipts: blk.Receipts}).Serialize()
+		r.NoError(err)
+		_, err = buffer.Put(i, ser)
+		r.NoError(err)
+	}
+	data, err := buffer.Serialize()
+	r.NoError(err)
+
+	zstd19, err := compress.NewZstdCompressor(19, nil)
+	r.NoError(err)
+	for _, c := range []struct {
+		name   string
+		comp   func([]byte) ([]byte, error)
+		decomp func([]byte) ([]byte, error)
+	}{
+		{compress.Gzip, compress.CompGzip, compress.DecompGzip},
+		{compress.Snappy, compress.CompSnappy, compress.DecompSnappy},
+		{compress.Zstd, compress.CompZstd, compress.DecompZstd},
+		{compress.Zstd + "-19", zstd19.Compress, zstd19.Decompress},
+	} {
+		compressed, err := c.comp(data)
+		r.NoError(err)
+		b.Run(c.name+"/compress", func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := c.comp(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(len(data))/float64(len(compressed)), "ratio")
+		})
+		b.Run(c.name+"/decompress", func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := c.decomp(compressed); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -6,14 +6,18 @@
 package genesis
 
 import (
+	"bytes"
+	"encoding/binary"
 	"math"
 	"math/big"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"go.uber.org/config"
 	"go.uber.org/zap"
@@ -77,10 +81,12 @@ func defaultConfig() Genesis {
 			SumatraBlockHeight:      28516681,
 			TsunamiBlockHeight:      29275561,
 			UpernavikBlockHeight:    39275561,
+			VanuatuBlockHeight:      math.MaxUint64,
 			ToBeEnabledBlockHeight:  math.MaxUint64,
 		},
 		Account: Account{
 			InitBalanceMap:          make(map[string]string),
+			AllocMap:                make(map[string]*AllocAccount),
 			ReplayDeployerWhitelist: []string{"0x3fab184622dc19b6109349b94811493bf2a45362"},
 		},
 		Poll: Poll{
@@ -121,6 +127,8 @@ func defaultConfig() Genesis {
 			MinStakeAmount:                   unit.ConvertIotxToRau(100).String(),
 			BootstrapCandidates:              []BootstrapCandidate{},
 			EndorsementWithdrawWaitingBlocks: 24 * 60 * 60 / 5,
+			EquivocationSlashRate:            10,
+			EquivocationEvidenceMaxAge:       7 * 24 * time.Hour,
 		},
 	}
 }
@@ -262,6 +270,10 @@ type (
 		// UpernavikBlockHeight is the start height to
 		// 1. enable Cancun EVM
 		UpernavikBlockHeight uint64 `yaml:"upernavikHeight"`
+		// VanuatuBlockHeight is the start height to
+		// 1. enable EIP-1559 base fee in block header
+		// 2. burn the base fee portion of gas fee
+		VanuatuBlockHeight uint64 `yaml:"vanuatuHeight"`
 		// ToBeEnabledBlockHeight is a fake height that acts as a gating factor for WIP features
 		// upon next release, change IsToBeEnabled() to IsNextHeight() for features to be released
 		ToBeEnabledBlockHeight uint64 `yaml:"toBeEnabledHeight"`
@@ -270,9 +282,23 @@ type (
 	Account struct {
 		// InitBalanceMap is the address and initial balance mapping before the first block.
 		InitBalanceMap map[string]string `yaml:"initBalances"`
+		// AllocMap is the address and initial state mapping before the first block, which allows to deploy contracts
+		// in genesis. The address is either an io address or a hex address
+		AllocMap map[string]*AllocAccount `yaml:"alloc"`
 		// ReplayDeployerWhitelist is the whitelist address for unprotected (pre-EIP155) transaction
 		ReplayDeployerWhitelist []string `yaml:"replayDeployerWhitelist"`
 	}
+	// AllocAccount defines the initial state of an account in the genesis alloc
+	AllocAccount struct {
+		// BalanceStr is the initial balance in decimal or 0x-prefixed hex string format
+		BalanceStr string `yaml:"balance"`
+		// Nonce is the nonce of the next transaction sent by the account
+		Nonce uint64 `yaml:"nonce"`
+		// CodeStr is the contract byte-code in 0x-prefixed hex string format
+		CodeStr string `yaml:"code"`
+		// StorageMap is the contract storage in 0x-prefixed hex string format
+		StorageMap map[string]string `yaml:"storage"`
+	}
 	// Poll contains the configs for poll protocol
 	Poll struct {
 		// PollMode is different based on chain type or poll input data source
@@ -364,6 +390,10 @@ type (
 		MinStakeAmount                   string               `yaml:"minStakeAmount"`
 		BootstrapCandidates              []BootstrapCandidate `yaml:"bootstrapCandidates"`
 		EndorsementWithdrawWaitingBlocks uint64               `yaml:"endorsementWithdrawWaitingBlocks"`
+		// EquivocationSlashRate is the percentage of the self-stake bucket burned for an equivocation
+		EquivocationSlashRate uint64 `yaml:"equivocationSlashRate"`
+		// EquivocationEvidenceMaxAge is the max age of an equivocation evidence accepted on chain
+		EquivocationEvidenceMaxAge time.Duration `yaml:"equivocationEvidenceMaxAge"`
 	}
 
 	// VoteWeightCalConsts contains the configs for calculating vote weight
@@ -491,6 +521,10 @@ func (g *Genesis) Hash() hash.Hash256 {
 	if err != nil {
 		log.L().Panic("Error when marshaling genesis proto", zap.Error(err))
 	}
+	// the alloc is not part of the genesis proto, it is appended only if present to keep the hash of existing chains
+	if len(g.AllocMap) > 0 {
+		b = append(b, g.allocBytes()...)
+	}
 	return hash.Hash256b(b)
 }
 
@@ -618,6 +652,11 @@ func (g *Blockchain) IsUpernavik(height uint64) bool {
 	return g.isPost(g.UpernavikBlockHeight, height)
 }
 
+// IsVanuatu checks whether height is equal to or larger than vanuatu height
+func (g *Blockchain) IsVanuatu(height uint64) bool {
+	return g.isPost(g.VanuatuBlockHeight, height)
+}
+
 // IsToBeEnabled checks whether height is equal to or larger than toBeEnabled height
 func (g *Blockchain) IsToBeEnabled(height uint64) bool {
 	return g.isPost(g.ToBeEnabledBlockHeight, height)
@@ -677,6 +716,139 @@ func (a *Account) InitBalances() ([]address.Address, []*big.Int) {
 	return addrs, amounts
 }
 
+// Allocs returns the addresses in the genesis alloc and the corresponding initial states. The list is ordered by
+// address
+func (a *Account) Allocs() ([]address.Address, []*AllocAccount) {
+	type alloc struct {
+		addr address.Address
+		acct *AllocAccount
+	}
+	allocs := make([]alloc, 0, len(a.AllocMap))
+	for addrStr, acct := range a.AllocMap {
+		var (
+			addr address.Address
+			err  error
+		)
+		if common.IsHexAddress(addrStr) {
+			addr, err = address.FromHex(addrStr)
+		} else {
+			addr, err = address.FromString(addrStr)
+		}
+		if err != nil {
+			log.L().Panic("Error when decoding the genesis alloc address from string.", zap.Error(err))
+		}
+		if acct == nil {
+			acct = &AllocAccount{}
+		}
+		allocs = append(allocs, alloc{addr, acct})
+	}
+	sort.Slice(allocs, func(i, j int) bool { return bytes.Compare(allocs[i].addr.Bytes(), allocs[j].addr.Bytes()) < 0 })
+	addrs := make([]address.Address, 0, len(allocs))
+	accts := make([]*AllocAccount, 0, len(allocs))
+	for i, al := range allocs {
+		if i > 0 && address.Equal(allocs[i-1].addr, al.addr) {
+			log.S().Panicf("Duplicate address %s in genesis alloc", al.addr.String())
+		}
+		addrs = append(addrs, al.addr)
+		accts = append(accts, al.acct)
+	}
+	return addrs, accts
+}
+
+func (a *Account) allocBytes() []byte {
+	var (
+		buf          bytes.Buffer
+		addrs, accts = a.Allocs()
+		writeBytes   = func(b []byte) {
+			_ = binary.Write(&buf, binary.BigEndian, uint64(len(b)))
+			buf.Write(b)
+		}
+	)
+	for i, addr := range addrs {
+		acct := accts[i]
+		buf.Write(addr.Bytes())
+		writeBytes(acct.Balance().Bytes())
+		_ = binary.Write(&buf, binary.BigEndian, acct.Nonce)
+		writeBytes(acct.Code())
+		storage := acct.Storage()
+		keys := make([]common.Hash, 0, len(storage))
+		for k := range storage {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+		_ = binary.Write(&buf, binary.BigEndian, uint64(len(keys)))
+		for _, k := range keys {
+			v := storage[k]
+			buf.Write(k[:])
+			buf.Write(v[:])
+		}
+	}
+	return buf.Bytes()
+}
+
+// Balance returns the initial balance of the account
+func (a *AllocAccount) Balance() *big.Int {
+	if a.BalanceStr == "" {
+		return big.NewInt(0)
+	}
+	var (
+		val *big.Int
+		ok  bool
+	)
+	if strings.HasPrefix(a.BalanceStr, "0x") || strings.HasPrefix(a.BalanceStr, "0X") {
+		val, ok = new(big.Int).SetString(a.BalanceStr[2:], 16)
+	} else {
+		val, ok = new(big.Int).SetString(a.BalanceStr, 10)
+	}
+	if !ok || val.Sign() < 0 {
+		log.S().Panicf("Error when casting alloc balance string %s into big int", a.BalanceStr)
+	}
+	return val
+}
+
+// Code returns the contract byte-code of the account, which is nil for a non-contract account
+func (a *AllocAccount) Code() []byte {
+	if a.CodeStr == "" {
+		return nil
+	}
+	code, err := hexutil.Decode(a.CodeStr)
+	if err != nil {
+		log.S().Panicf("Error when decoding alloc code string %s: %v", a.CodeStr, err)
+	}
+	return code
+}
+
+// Storage returns the contract storage of the account
+func (a *AllocAccount) Storage() map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash, len(a.StorageMap))
+	for k, v := range a.StorageMap {
+		key, err := decodeStorageWord(k)
+		if err != nil {
+			log.S().Panicf("Error when decoding alloc storage key %s: %v", k, err)
+		}
+		val, err := decodeStorageWord(v)
+		if err != nil {
+			log.S().Panicf("Error when decoding alloc storage value %s: %v", v, err)
+		}
+		if _, ok := storage[key]; ok {
+			log.S().Panicf("Duplicate alloc storage key %s", k)
+		}
+		storage[key] = val
+	}
+	return storage
+}
+
+func decodeStorageWord(s string) (common.Hash, error) {
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, errors.Errorf("length %d exceeds %d bytes", len(b), common.HashLength)
+	}
+	return common.BytesToHash(b), nil
+}
+
 // OperatorAddr is the address of operator
 func (d *Delegate) OperatorAddr() address.Address {
 	addr, err := address.FromString(d.OperatorAddrStr)
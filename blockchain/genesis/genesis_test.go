@@ -7,8 +7,10 @@ package genesis
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -75,6 +77,23 @@ func TestHash(t *testing.T) {
 	cfg.ProductivityThreshold = 85
 	hash := cfg.Hash()
 	require.Equal("b337983730981c2d50f114eed5da9dd20b83c8c5e130beefdb3001dc858cfe8b", hex.EncodeToString(hash[:]))
+
+	// the alloc is part of the hash, regardless of the address format
+	cfg.AllocMap = map[string]*AllocAccount{
+		"io1emxf8zzqckhgjde6dqd97ts0y3q496gm3fdrl6": {BalanceStr: "1", CodeStr: "0x00", StorageMap: map[string]string{"0x01": "0x02"}},
+	}
+	allocHash := cfg.Hash()
+	require.NotEqual(hash, allocHash)
+	addr, err := address.FromString("io1emxf8zzqckhgjde6dqd97ts0y3q496gm3fdrl6")
+	require.NoError(err)
+	cfg.AllocMap = map[string]*AllocAccount{
+		"0x" + hex.EncodeToString(addr.Bytes()): {BalanceStr: "0x1", CodeStr: "0x00", StorageMap: map[string]string{"0x0001": "0x02"}},
+	}
+	require.Equal(allocHash, cfg.Hash())
+	cfg.AllocMap[addr.Hex()].StorageMap["0x0001"] = "0x03"
+	require.NotEqual(allocHash, cfg.Hash())
+	cfg.AllocMap[addr.Hex()].StorageMap["0x01"] = "0x03"
+	require.Panics(func() { cfg.Hash() })
 }
 func TestAccount_InitBalances(t *testing.T) {
 	require := require.New(t)
@@ -89,6 +108,39 @@ func TestAccount_InitBalances(t *testing.T) {
 	require.Equal(InitBalanceMap["io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"], balances[1].Text(10))
 }
 
+func TestAccount_Allocs(t *testing.T) {
+	require := require.New(t)
+	acc := Account{AllocMap: map[string]*AllocAccount{
+		"io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms": {
+			BalanceStr: "0x10",
+			Nonce:      2,
+			CodeStr:    "0x6000",
+			StorageMap: map[string]string{"0x01": "0x0a"},
+		},
+		"0xcecc938840c5ae89373a681a5f2e0f244152e91b": nil,
+	}}
+	addrs, accts := acc.Allocs()
+	require.Len(addrs, 2)
+	require.Equal("io1emxf8zzqckhgjde6dqd97ts0y3q496gm3fdrl6", addrs[0].String())
+	require.Equal(big.NewInt(0), accts[0].Balance())
+	require.Nil(accts[0].Code())
+	require.Empty(accts[0].Storage())
+	require.Equal("io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms", addrs[1].String())
+	require.Equal(big.NewInt(16), accts[1].Balance())
+	require.Equal(uint64(2), accts[1].Nonce)
+	require.Equal([]byte{0x60, 0x00}, accts[1].Code())
+	require.Equal(map[common.Hash]common.Hash{
+		common.BigToHash(big.NewInt(1)): common.BigToHash(big.NewInt(10)),
+	}, accts[1].Storage())
+
+	// the same address in both formats
+	acc.AllocMap["0xda7e12ef57c236a06117c5e0d04a228e7181cf36"] = &AllocAccount{}
+	acc.AllocMap["io1mflp9m6hcgm2qcghchsdqj3z3eccrnekx9p0ms"] = &AllocAccount{}
+	require.Panics(func() { acc.Allocs() })
+	require.Panics(func() { (&AllocAccount{CodeStr: "6000"}).Code() })
+	require.Panics(func() { (&AllocAccount{BalanceStr: "-1"}).Balance() })
+}
+
 func TestTsunamiBlockGasLimit(t *testing.T) {
 	r := require.New(t)
 
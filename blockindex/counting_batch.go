@@ -0,0 +1,125 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// countingIndexBatch adds the entries of a block to counting indexes in one batch, and keeps the number of entries
+// added to each index, so that they can be reverted when the block is deleted
+type countingIndexBatch struct {
+	kvStore db.KVStore
+	batch   batch.KVStoreBatch
+	indexes map[string]db.CountingIndex
+	counts  map[string]uint64
+	// buckets keeps the order the indexes are touched, so that the revert record is deterministic
+	buckets []string
+}
+
+func newCountingIndexBatch(kv db.KVStore) *countingIndexBatch {
+	return &countingIndexBatch{
+		kvStore: kv,
+		batch:   batch.NewBatch(),
+		indexes: make(map[string]db.CountingIndex),
+		counts:  make(map[string]uint64),
+	}
+}
+
+// add appends the value to the counting index of bucket
+func (cb *countingIndexBatch) add(bucket []byte, value []byte) error {
+	index, ok := cb.indexes[string(bucket)]
+	if !ok {
+		var err error
+		index, err = db.NewCountingIndexNX(cb.kvStore, bucket)
+		if err != nil {
+			return err
+		}
+		if err = index.UseBatch(cb.batch); err != nil {
+			return err
+		}
+		cb.indexes[string(bucket)] = index
+		cb.buckets = append(cb.buckets, string(bucket))
+	}
+	cb.counts[string(bucket)]++
+	return index.Add(value, true)
+}
+
+// finalize updates the size of counting indexes in the batch, and returns the revert record of them
+//
+// the revert record is encoded as a list of: bucket length (1 byte) | bucket | count (8 bytes, big endian)
+func (cb *countingIndexBatch) finalize() ([]byte, error) {
+	var record bytes.Buffer
+	for _, bucket := range cb.buckets {
+		if err := cb.indexes[bucket].Finalize(); err != nil {
+			return nil, err
+		}
+		record.WriteByte(byte(len(bucket)))
+		record.WriteString(bucket)
+		record.Write(byteutil.Uint64ToBytesBigEndian(cb.counts[bucket]))
+	}
+	return record.Bytes(), nil
+}
+
+// revertCountingIndexes removes the entries recorded in the revert record from the counting indexes in the batch, so
+// that they are reverted atomically along with other changes of the block
+func revertCountingIndexes(kv db.KVStore, b batch.KVStoreBatch, record []byte) error {
+	for len(record) > 0 {
+		size := int(record[0])
+		if len(record) < 1+size+8 {
+			return errors.New("invalid revert record of counting indexes")
+		}
+		bucket := record[1 : 1+size]
+		count := byteutil.BytesToUint64BigEndian(record[1+size : 1+size+8])
+		record = record[1+size+8:]
+		index, err := db.GetCountingIndex(kv, bucket)
+		if err != nil {
+			return err
+		}
+		total := index.Size()
+		if count == 0 || count > total {
+			return errors.Wrapf(db.ErrInvalid, "cannot revert %d entries of index %x with size %d", count, bucket, total)
+		}
+		ns := string(bucket)
+		start := total - count
+		for i := start; i < total; i++ {
+			b.Delete(ns, byteutil.Uint64ToBytesBigEndian(i), fmt.Sprintf("failed to delete %d-th item", i))
+		}
+		b.Put(ns, db.CountKey, byteutil.Uint64ToBytesBigEndian(start), fmt.Sprintf("failed to update size = %d", start))
+	}
+	return nil
+}
+
+// rangeCountingIndex returns the values [start, start+count) of the counting index, and the size of it
+// it returns no value if the index does not exist or start is beyond the size
+func rangeCountingIndex(kv db.KVStore, bucket []byte, start, count uint64) ([][]byte, uint64, error) {
+	index, err := db.GetCountingIndex(kv, bucket)
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	total := index.Size()
+	if start >= total || count == 0 {
+		return nil, total, nil
+	}
+	if start+count > total {
+		count = total - start
+	}
+	values, err := index.Range(start, count)
+	if err != nil {
+		return nil, 0, err
+	}
+	return values, total, nil
+}
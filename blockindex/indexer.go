@@ -31,13 +31,18 @@ const (
 	_hashOffset          = 12
 	_blockHashToHeightNS = "hh"
 	_actionToBlockHashNS = "ab"
+	// _prunedNS stores the height of pruned blocks, and the number of pruned entries of each counting index
+	_prunedNS = "pr"
 )
 
 var (
 	_totalBlocksBucket  = []byte("bk")
 	_totalActionsBucket = []byte("ac")
+	_prunedHeightKey    = []byte("height")
 	// ErrActionIndexNA indicates action index is not supported
 	ErrActionIndexNA = errors.New("action index not supported")
+	// ErrActionPruned indicates the action index has been pruned along with the block
+	ErrActionPruned = errors.New("action index has been pruned")
 )
 
 type (
@@ -261,6 +266,9 @@ func (x *blockIndexer) GetActionHashFromIndex(start, count uint64) ([][]byte, er
 	x.mutex.RLock()
 	defer x.mutex.RUnlock()
 
+	if err := x.checkPruned(_totalActionsBucket, start); err != nil {
+		return nil, err
+	}
 	return x.tac.Range(start, count)
 }
 
@@ -292,6 +300,9 @@ func (x *blockIndexer) GetActionsByAddress(addrBytes hash.Hash160, start, count
 	if start >= total {
 		return nil, errors.Wrapf(db.ErrInvalid, "start = %d >= total = %d", start, total)
 	}
+	if err := x.checkPruned(addrBytes[:], start); err != nil {
+		return nil, err
+	}
 	if start+count > total {
 		count = total - start
 	}
@@ -401,51 +412,142 @@ func (x *blockIndexer) getIndexerForAddr(addr []byte, batch bool) (db.CountingIn
 
 // indexAction builds index for an action
 func (x *blockIndexer) indexAction(actHash hash.Hash256, elp *action.SealedEnvelope, insert, tolerateLegacyAddress bool) error {
-	// add to sender's index
-	callerAddrBytes := elp.SrcPubkey().Hash()
-	sender, err := x.getIndexerForAddr(callerAddrBytes, insert)
+	addrs, err := actionAddresses(elp, tolerateLegacyAddress)
 	if err != nil {
 		return err
 	}
-	if insert {
-		err = sender.Add(actHash[:], insert)
-	} else {
-		err = sender.Revert(1)
+	// add to sender's and recipient's index
+	for _, addrBytes := range addrs {
+		indexer, err := x.getIndexerForAddr(addrBytes, insert)
+		if err != nil {
+			return err
+		}
+		if insert {
+			err = indexer.Add(actHash[:], insert)
+		} else {
+			err = indexer.Revert(1)
+		}
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// PruneBlock deletes the index of actions in the block, the block index itself is kept
+func (x *blockIndexer) PruneBlock(ctx context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	pruned, err := x.prunedCount(_prunedHeightKey)
 	if err != nil {
 		return err
 	}
+	height := blk.Height()
+	if height <= pruned {
+		return nil
+	}
+	if height != pruned+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d to prune, expecting %d", height, pruned+1)
+	}
+	if tip := x.tbk.Size() - 1; height >= tip {
+		// the tip block is kept, so that it can be deleted
+		return errors.Wrapf(db.ErrInvalid, "cannot prune block %d, the tip is %d", height, tip)
+	}
+
+	// the actions of pruned blocks are always at the beginning of each counting index
+	var (
+		b      = batch.NewBatch()
+		counts = make(map[string]uint64)
+	)
+	deleteFirst := func(bucket []byte) error {
+		n, ok := counts[string(bucket)]
+		if !ok {
+			var err error
+			if n, err = x.prunedCount(bucket); err != nil {
+				return err
+			}
+		}
+		b.Delete(string(bucket), byteutil.Uint64ToBytesBigEndian(n), fmt.Sprintf("failed to delete %d-th item of %x", n, bucket))
+		counts[string(bucket)] = n + 1
+		return nil
+	}
+	fCtx := protocol.MustGetFeatureCtx(protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight: height,
+	})))
+	for _, selp := range blk.Actions {
+		actHash, err := selp.Hash()
+		if err != nil {
+			return err
+		}
+		b.Delete(_actionToBlockHashNS, actHash[_hashOffset:], fmt.Sprintf("failed to delete action hash %x", actHash))
+		if err := deleteFirst(_totalActionsBucket); err != nil {
+			return err
+		}
+		addrs, err := actionAddresses(selp, fCtx.TolerateLegacyAddress)
+		if err != nil {
+			return err
+		}
+		for _, addrBytes := range addrs {
+			if err := deleteFirst(addrBytes); err != nil {
+				return err
+			}
+		}
+	}
+	for bucket, n := range counts {
+		b.Put(_prunedNS, []byte(bucket), byteutil.Uint64ToBytesBigEndian(n), fmt.Sprintf("failed to put pruned count of %x", bucket))
+	}
+	b.Put(_prunedNS, _prunedHeightKey, byteutil.Uint64ToBytesBigEndian(height), "failed to put pruned height")
+	return x.kvStore.WriteBatch(b)
+}
+
+// prunedCount returns the number of pruned entries of the counting index, or the pruned height for _prunedHeightKey
+func (x *blockIndexer) prunedCount(key []byte) (uint64, error) {
+	v, err := x.kvStore.Get(_prunedNS, key)
+	if err != nil {
+		if errors.Cause(err) == db.ErrBucketNotExist || errors.Cause(err) == db.ErrNotExist {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return byteutil.BytesToUint64BigEndian(v), nil
+}
 
+func (x *blockIndexer) checkPruned(bucket []byte, start uint64) error {
+	pruned, err := x.prunedCount(bucket)
+	if err != nil {
+		return err
+	}
+	if start < pruned {
+		return errors.Wrapf(ErrActionPruned, "start = %d, the first %d actions are pruned", start, pruned)
+	}
+	return nil
+}
+
+// actionAddresses returns the sender, and the recipient if it is different from the sender
+func actionAddresses(elp *action.SealedEnvelope, tolerateLegacyAddress bool) ([][]byte, error) {
+	callerAddrBytes := elp.SrcPubkey().Hash()
 	dst, ok := elp.Destination()
 	if !ok || dst == "" {
-		return nil
+		return [][]byte{callerAddrBytes}, nil
 	}
 
-	var dstAddr address.Address
+	var (
+		dstAddr address.Address
+		err     error
+	)
 	if tolerateLegacyAddress {
 		dstAddr, err = address.FromStringLegacy(dst)
 	} else {
 		dstAddr, err = address.FromString(dst)
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 	dstAddrBytes := dstAddr.Bytes()
-
 	if bytes.Equal(dstAddrBytes, callerAddrBytes) {
 		// recipient is same as sender
-		return nil
-	}
-
-	// add to recipient's index
-	recipient, err := x.getIndexerForAddr(dstAddrBytes, insert)
-	if err != nil {
-		return err
-	}
-	if insert {
-		err = recipient.Add(actHash[:], insert)
-	} else {
-		err = recipient.Revert(1)
+		return [][]byte{callerAddrBytes}, nil
 	}
-	return err
+	return [][]byte{callerAddrBytes, dstAddrBytes}, nil
 }
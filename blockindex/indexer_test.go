@@ -333,3 +333,87 @@ func TestIndexer(t *testing.T) {
 		testDelete(db.NewBoltDB(cfg), t)
 	})
 }
+
+func TestIndexerPruneBlock(t *testing.T) {
+	require := require.New(t)
+
+	blks := getTestBlocks(t)
+	addr28 := hash.BytesToHash160(identityset.Address(28).Bytes())
+	addr31 := hash.BytesToHash160(identityset.Address(31).Bytes())
+	t6Hash, _ := blks[2].Actions[1].Hash()
+	e3Hash, _ := blks[2].Actions[2].Hash()
+
+	testPrune := func(kvStore db.KVStore, t *testing.T) {
+		ctx := genesis.WithGenesisContext(context.Background(), genesis.Default)
+		indexer, err := NewIndexer(kvStore, hash.ZeroHash256)
+		require.NoError(err)
+		require.NoError(indexer.Start(ctx))
+		defer func() {
+			require.NoError(indexer.Stop(ctx))
+		}()
+		for _, blk := range blks {
+			require.NoError(indexer.PutBlock(ctx, blk))
+		}
+		pi := indexer.(*blockIndexer)
+		// blocks are pruned in order, and the tip block is kept
+		require.ErrorIs(pi.PruneBlock(ctx, blks[1]), db.ErrInvalid)
+		require.NoError(pi.PruneBlock(ctx, blks[0]))
+		require.NoError(pi.PruneBlock(ctx, blks[1]))
+		// pruning a block again is a no-op
+		require.NoError(pi.PruneBlock(ctx, blks[0]))
+
+		for i := 0; i < 2; i++ {
+			for _, selp := range blks[i].Actions {
+				h, _ := selp.Hash()
+				_, err = indexer.GetActionIndex(h[:])
+				require.Error(err)
+			}
+			// the block index is kept
+			_, err = indexer.GetBlockIndex(blks[i].Height())
+			require.NoError(err)
+		}
+		actIndex, err := indexer.GetActionIndex(e3Hash[:])
+		require.NoError(err)
+		require.EqualValues(3, actIndex.BlockHeight())
+
+		// the count of actions is unchanged, the pruned ones can no longer be queried
+		total, err := indexer.GetTotalActions()
+		require.NoError(err)
+		require.EqualValues(9, total)
+		_, err = indexer.GetActionHashFromIndex(5, 4)
+		require.ErrorIs(err, ErrActionPruned)
+		actions, err := indexer.GetActionHashFromIndex(6, 3)
+		require.NoError(err)
+		require.Len(actions, 3)
+		require.Equal(e3Hash[:], actions[2])
+
+		count, err := indexer.GetActionCountByAddress(addr28)
+		require.NoError(err)
+		require.EqualValues(4, count)
+		_, err = indexer.GetActionsByAddress(addr28, 0, count)
+		require.ErrorIs(err, ErrActionPruned)
+		actions, err = indexer.GetActionsByAddress(addr28, 3, 1)
+		require.NoError(err)
+		require.Equal([][]byte{t6Hash[:]}, actions)
+		_, err = indexer.GetActionsByAddress(addr31, 1, 2)
+		require.ErrorIs(err, ErrActionPruned)
+		actions, err = indexer.GetActionsByAddress(addr31, 2, 1)
+		require.NoError(err)
+		require.Equal([][]byte{e3Hash[:]}, actions)
+
+		// the tip block cannot be pruned
+		require.ErrorIs(pi.PruneBlock(ctx, blks[2]), db.ErrInvalid)
+	}
+
+	t.Run("In-memory KV indexer", func(t *testing.T) {
+		testPrune(db.NewMemKVStore(), t)
+	})
+	testPath, err := testutil.PathOfTempFile("test-indexer-prune")
+	require.NoError(err)
+	defer testutil.CleanupPath(testPath)
+	cfg := db.DefaultConfig
+	cfg.DbPath = testPath
+	t.Run("Bolt DB indexer", func(t *testing.T) {
+		testPrune(db.NewBoltDB(cfg), t)
+	})
+}
@@ -0,0 +1,309 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// the NS/bucket names of internal transfer index, in a separate db from other indexers
+const (
+	_internalTransferHeightNS  = "ih"
+	_internalTransferRevertNS  = "ir"
+	_internalTransferByAddrPfx = "ia"
+)
+
+var (
+	_internalTransferCurrentHeight = []byte("currentHeight")
+
+	// ErrInternalTransferIndexNA indicates internal transfer index is not supported
+	ErrInternalTransferIndexNA = errors.New("internal transfer index not supported")
+)
+
+type (
+	// TransactionLogReader reads the transaction logs of a block stored by block dao
+	TransactionLogReader interface {
+		Height() (uint64, error)
+		ContainsTransactionLog() bool
+		TransactionLogs(uint64) (*iotextypes.TransactionLogs, error)
+	}
+
+	// InternalTransfer is a movement of IOTX recorded in the transaction log of an action, including the native
+	// transfer, the value of contract calls, the refund of selfdestruct, gas fees and staking deposits
+	InternalTransfer struct {
+		BlockHeight uint64
+		ActionHash  hash.Hash256
+		Type        iotextypes.TransactionLogType
+		Amount      *big.Int
+		Sender      string
+		Recipient   string
+	}
+
+	// InternalTransferIndexer is the indexer of internal transfers by address
+	InternalTransferIndexer interface {
+		blockdao.BlockIndexer
+		// TransfersByAddress returns the internal transfers [start, start+count) from or to the address, and the
+		// total number of them
+		TransfersByAddress(addr address.Address, start, count uint64) ([]*InternalTransfer, uint64, error)
+	}
+
+	internalTransferIndexer struct {
+		mutex   sync.RWMutex
+		kvStore db.KVStore
+		reader  TransactionLogReader
+		// disabled is set on start if the reader does not store transaction logs
+		disabled bool
+	}
+)
+
+// Serialize returns the byte representation of the internal transfer, which is the 8-byte big-endian block height,
+// followed by the 32-byte action hash and the transaction encoded in protobuf
+func (t *InternalTransfer) Serialize() []byte {
+	data := byteutil.Must(proto.Marshal(&iotextypes.TransactionLog_Transaction{
+		Amount:    t.Amount.String(),
+		Sender:    t.Sender,
+		Recipient: t.Recipient,
+		Type:      t.Type,
+	}))
+	return append(append(byteutil.Uint64ToBytesBigEndian(t.BlockHeight), t.ActionHash[:]...), data...)
+}
+
+// Deserialize loads the internal transfer from bytes
+func (t *InternalTransfer) Deserialize(data []byte) error {
+	if len(data) < 8+32 {
+		return errors.Wrapf(db.ErrInvalid, "invalid internal transfer of length %d", len(data))
+	}
+	pb := &iotextypes.TransactionLog_Transaction{}
+	if err := proto.Unmarshal(data[40:], pb); err != nil {
+		return err
+	}
+	amount, ok := new(big.Int).SetString(pb.Amount, 10)
+	if !ok {
+		return errors.Errorf("invalid amount %s of internal transfer", pb.Amount)
+	}
+	t.BlockHeight = byteutil.BytesToUint64BigEndian(data[:8])
+	t.ActionHash = hash.BytesToHash256(data[8:40])
+	t.Type = pb.Type
+	t.Amount = amount
+	t.Sender = pb.Sender
+	t.Recipient = pb.Recipient
+	return nil
+}
+
+// NewInternalTransferIndexer creates a new internal transfer indexer, which indexes the transaction logs read from reader
+func NewInternalTransferIndexer(kv db.KVStore, reader TransactionLogReader) (InternalTransferIndexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kvStore")
+	}
+	if _, ok := kv.(db.KVStoreWithRange); !ok {
+		return nil, errors.New("internal transfer indexer can only be created from KVStoreWithRange")
+	}
+	if reader == nil {
+		return nil, errors.New("empty transaction log reader")
+	}
+	return &internalTransferIndexer{
+		kvStore: kv,
+		reader:  reader,
+	}, nil
+}
+
+// Start starts the indexer, which is disabled if the reader does not store transaction logs, so the reader must be
+// started ahead of it
+func (x *internalTransferIndexer) Start(ctx context.Context) error {
+	if err := x.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	if x.disabled = !x.reader.ContainsTransactionLog(); x.disabled {
+		log.L().Warn("Internal transfer indexer is disabled, since block dao does not store transaction logs.")
+		return nil
+	}
+	if _, err := x.height(); err != nil {
+		if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == db.ErrBucketNotExist {
+			return x.kvStore.Put(_internalTransferHeightNS, _internalTransferCurrentHeight, byteutil.Uint64ToBytesBigEndian(0))
+		}
+		return err
+	}
+	return nil
+}
+
+// Stop stops the indexer
+func (x *internalTransferIndexer) Stop(ctx context.Context) error {
+	return x.kvStore.Stop(ctx)
+}
+
+// Height returns the height of the indexer, a disabled indexer follows the height of the reader as there is nothing
+// to index
+func (x *internalTransferIndexer) Height() (uint64, error) {
+	if x.disabled {
+		return x.reader.Height()
+	}
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.height()
+}
+
+// PutBlock indexes the internal transfers in the block
+func (x *internalTransferIndexer) PutBlock(_ context.Context, blk *block.Block) error {
+	if x.disabled {
+		return nil
+	}
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height <= tipHeight {
+		return nil
+	}
+	if height != tipHeight+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight+1)
+	}
+	logs, err := x.reader.TransactionLogs(height)
+	if err != nil {
+		if errors.Cause(err) != db.ErrNotExist {
+			return errors.Wrapf(err, "failed to read transaction logs of block %d", height)
+		}
+		// no transaction happened in the block
+		logs = &iotextypes.TransactionLogs{}
+	}
+	cb := newCountingIndexBatch(x.kvStore)
+	for _, log := range logs.GetLogs() {
+		actHash := hash.BytesToHash256(log.ActionHash)
+		for _, tx := range log.GetTransactions() {
+			amount, ok := new(big.Int).SetString(tx.Amount, 10)
+			if !ok {
+				return errors.Errorf("invalid amount %s in transaction log of action %x", tx.Amount, actHash)
+			}
+			t := &InternalTransfer{
+				BlockHeight: height,
+				ActionHash:  actHash,
+				Type:        tx.Type,
+				Amount:      amount,
+				Sender:      tx.Sender,
+				Recipient:   tx.Recipient,
+			}
+			value := t.Serialize()
+			for _, addr := range internalTransferParties(t) {
+				if err := cb.add(append([]byte(_internalTransferByAddrPfx), addr.Bytes()...), value); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	// record the number of entries added to each index, to revert them on deleting the block
+	revert, err := cb.finalize()
+	if err != nil {
+		return err
+	}
+	heightBytes := byteutil.Uint64ToBytesBigEndian(height)
+	cb.batch.Put(_internalTransferRevertNS, heightBytes, revert, fmt.Sprintf("failed to put revert record of block %d", height))
+	cb.batch.Put(_internalTransferHeightNS, _internalTransferCurrentHeight, heightBytes, "failed to put current height")
+	return x.kvStore.WriteBatch(cb.batch)
+}
+
+// DeleteTipBlock reverts the internal transfers in the tip block
+func (x *internalTransferIndexer) DeleteTipBlock(_ context.Context, blk *block.Block) error {
+	if x.disabled {
+		return nil
+	}
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight)
+	}
+	heightBytes := byteutil.Uint64ToBytesBigEndian(height)
+	revert, err := x.kvStore.Get(_internalTransferRevertNS, heightBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get revert record of block %d", height)
+	}
+	b := batch.NewBatch()
+	if err := revertCountingIndexes(x.kvStore, b, revert); err != nil {
+		return err
+	}
+	b.Delete(_internalTransferRevertNS, heightBytes, fmt.Sprintf("failed to delete revert record of block %d", height))
+	b.Put(_internalTransferHeightNS, _internalTransferCurrentHeight, byteutil.Uint64ToBytesBigEndian(height-1), "failed to put current height")
+	return x.kvStore.WriteBatch(b)
+}
+
+// TransfersByAddress returns the internal transfers from or to the address
+func (x *internalTransferIndexer) TransfersByAddress(addr address.Address, start, count uint64) ([]*InternalTransfer, uint64, error) {
+	if x.disabled {
+		return nil, 0, errors.Wrap(ErrInternalTransferIndexNA, "block dao does not store transaction logs")
+	}
+	if address.IsAddrV1Special(addr.String()) {
+		// special address is not indexed
+		return nil, 0, nil
+	}
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	values, total, err := rangeCountingIndex(x.kvStore, append([]byte(_internalTransferByAddrPfx), addr.Bytes()...), start, count)
+	if err != nil {
+		return nil, 0, err
+	}
+	transfers := make([]*InternalTransfer, 0, len(values))
+	for _, v := range values {
+		t := &InternalTransfer{}
+		if err := t.Deserialize(v); err != nil {
+			return nil, 0, err
+		}
+		transfers = append(transfers, t)
+	}
+	return transfers, total, nil
+}
+
+func (x *internalTransferIndexer) height() (uint64, error) {
+	h, err := x.kvStore.Get(_internalTransferHeightNS, _internalTransferCurrentHeight)
+	if err != nil {
+		return 0, err
+	}
+	return byteutil.BytesToUint64BigEndian(h), nil
+}
+
+// internalTransferParties returns the addresses to index the transfer by, the sender or recipient which is a special
+// address (e.g., the rewarding pool) or not a valid address is skipped
+func internalTransferParties(t *InternalTransfer) []address.Address {
+	var parties []address.Address
+	for _, s := range []string{t.Sender, t.Recipient} {
+		if address.IsAddrV1Special(s) {
+			continue
+		}
+		addr, err := address.FromString(s)
+		if err != nil {
+			continue
+		}
+		if len(parties) > 0 && parties[0].String() == addr.String() {
+			continue
+		}
+		parties = append(parties, addr)
+	}
+	return parties
+}
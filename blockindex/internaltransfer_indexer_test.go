@@ -0,0 +1,191 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+type testTransactionLogReader struct {
+	height      uint64
+	containsLog bool
+	logs        map[uint64]*iotextypes.TransactionLogs
+}
+
+func (r *testTransactionLogReader) Height() (uint64, error) {
+	return r.height, nil
+}
+
+func (r *testTransactionLogReader) ContainsTransactionLog() bool {
+	return r.containsLog
+}
+
+func (r *testTransactionLogReader) TransactionLogs(height uint64) (*iotextypes.TransactionLogs, error) {
+	logs, ok := r.logs[height]
+	if !ok {
+		return nil, errors.Wrapf(db.ErrNotExist, "no transaction log of block %d", height)
+	}
+	return logs, nil
+}
+
+func TestInternalTransferIndexer(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var (
+		alice, bob, carol = identityset.Address(1), identityset.Address(2), identityset.Address(3)
+		contract          = identityset.Address(10)
+		h1, h2            = hash.Hash256b([]byte("action1")), hash.Hash256b([]byte("action2"))
+	)
+	newBlock := func(height uint64) *block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(testutil.TimestampNow().UTC()).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		return &blk
+	}
+	tx := func(typ iotextypes.TransactionLogType, amount int64, sender, recipient string) *iotextypes.TransactionLog_Transaction {
+		return &iotextypes.TransactionLog_Transaction{
+			Type:      typ,
+			Amount:    big.NewInt(amount).String(),
+			Sender:    sender,
+			Recipient: recipient,
+		}
+	}
+	reader := &testTransactionLogReader{
+		containsLog: true,
+		logs: map[uint64]*iotextypes.TransactionLogs{
+			1: {Logs: []*iotextypes.TransactionLog{{
+				ActionHash: h1[:],
+				Transactions: []*iotextypes.TransactionLog_Transaction{
+					tx(iotextypes.TransactionLogType_GAS_FEE, 10, alice.String(), address.RewardingPoolAddr),
+					tx(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, 100, alice.String(), contract.String()),
+					tx(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, 40, contract.String(), bob.String()),
+				},
+			}}},
+			// no transaction log at height 2
+			3: {Logs: []*iotextypes.TransactionLog{{
+				ActionHash: h2[:],
+				Transactions: []*iotextypes.TransactionLog_Transaction{
+					tx(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, 60, contract.String(), carol.String()),
+					tx(iotextypes.TransactionLogType_NATIVE_TRANSFER, 5, bob.String(), bob.String()),
+				},
+			}}},
+		},
+	}
+	blk1, blk2, blk3 := newBlock(1), newBlock(2), newBlock(3)
+
+	indexer, err := NewInternalTransferIndexer(db.NewMemKVStore(), reader)
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+	require.ErrorIs(indexer.PutBlock(ctx, blk2), db.ErrInvalid)
+	for _, blk := range []*block.Block{blk1, blk2, blk3} {
+		require.NoError(indexer.PutBlock(ctx, blk))
+	}
+	height, err := indexer.Height()
+	require.NoError(err)
+	require.Equal(uint64(3), height)
+
+	list := func(transfers []*InternalTransfer, _ uint64, err error) []*InternalTransfer {
+		require.NoError(err)
+		return transfers
+	}
+	total := func(_ []*InternalTransfer, total uint64, err error) uint64 {
+		require.NoError(err)
+		return total
+	}
+	transfers := list(indexer.TransfersByAddress(contract, 0, 10))
+	require.Len(transfers, 3)
+	for i, expected := range []struct {
+		height    uint64
+		actHash   hash.Hash256
+		amount    int64
+		sender    string
+		recipient string
+	}{
+		{1, h1, 100, alice.String(), contract.String()},
+		{1, h1, 40, contract.String(), bob.String()},
+		{3, h2, 60, contract.String(), carol.String()},
+	} {
+		require.Equal(expected.height, transfers[i].BlockHeight)
+		require.Equal(expected.actHash, transfers[i].ActionHash)
+		require.Equal(iotextypes.TransactionLogType_IN_CONTRACT_TRANSFER, transfers[i].Type)
+		require.Equal(big.NewInt(expected.amount), transfers[i].Amount)
+		require.Equal(expected.sender, transfers[i].Sender)
+		require.Equal(expected.recipient, transfers[i].Recipient)
+	}
+	transfers = list(indexer.TransfersByAddress(alice, 0, 10))
+	require.Len(transfers, 2)
+	require.Equal(iotextypes.TransactionLogType_GAS_FEE, transfers[0].Type)
+	require.Equal(address.RewardingPoolAddr, transfers[0].Recipient)
+	// self transfer is indexed once
+	require.Equal(uint64(2), total(indexer.TransfersByAddress(bob, 0, 10)))
+	// pagination
+	transfers = list(indexer.TransfersByAddress(contract, 1, 1))
+	require.Len(transfers, 1)
+	require.Equal(big.NewInt(40), transfers[0].Amount)
+	require.Empty(list(indexer.TransfersByAddress(contract, 3, 10)))
+
+	// delete tip blocks
+	require.ErrorIs(indexer.DeleteTipBlock(ctx, blk2), db.ErrInvalid)
+	require.NoError(indexer.DeleteTipBlock(ctx, blk3))
+	require.NoError(indexer.DeleteTipBlock(ctx, blk2))
+	height, err = indexer.Height()
+	require.NoError(err)
+	require.Equal(uint64(1), height)
+	require.Equal(uint64(2), total(indexer.TransfersByAddress(contract, 0, 10)))
+	require.Equal(uint64(1), total(indexer.TransfersByAddress(bob, 0, 10)))
+	require.Equal(uint64(0), total(indexer.TransfersByAddress(carol, 0, 10)))
+
+	// index the blocks again
+	require.NoError(indexer.PutBlock(ctx, blk2))
+	require.NoError(indexer.PutBlock(ctx, blk3))
+	require.Equal(uint64(3), total(indexer.TransfersByAddress(contract, 0, 10)))
+	require.Equal(uint64(1), total(indexer.TransfersByAddress(carol, 0, 10)))
+}
+
+func TestInternalTransferIndexerDisabled(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	reader := &testTransactionLogReader{height: 5}
+	indexer, err := NewInternalTransferIndexer(db.NewMemKVStore(), reader)
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+	// the disabled indexer follows the height of the reader, and skips the blocks
+	height, err := indexer.Height()
+	require.NoError(err)
+	require.Equal(uint64(5), height)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(6).
+		SetTimeStamp(testutil.TimestampNow().UTC()).
+		SignAndBuild(identityset.PrivateKey(27))
+	require.NoError(err)
+	require.NoError(indexer.PutBlock(ctx, &blk))
+	require.NoError(indexer.DeleteTipBlock(ctx, &blk))
+	_, _, err = indexer.TransfersByAddress(identityset.Address(1), 0, 10)
+	require.ErrorIs(err, ErrInternalTransferIndexNA)
+}
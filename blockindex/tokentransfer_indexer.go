@@ -0,0 +1,485 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/blockdao"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+const (
+	_tokenEventsABI = `[
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "internalType": "address", "name": "from", "type": "address"},
+			{"indexed": true, "internalType": "address", "name": "to", "type": "address"},
+			{"indexed": false, "internalType": "uint256", "name": "value", "type": "uint256"}
+		],
+		"name": "Transfer",
+		"type": "event"
+	},
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "internalType": "address", "name": "operator", "type": "address"},
+			{"indexed": true, "internalType": "address", "name": "from", "type": "address"},
+			{"indexed": true, "internalType": "address", "name": "to", "type": "address"},
+			{"indexed": false, "internalType": "uint256", "name": "id", "type": "uint256"},
+			{"indexed": false, "internalType": "uint256", "name": "value", "type": "uint256"}
+		],
+		"name": "TransferSingle",
+		"type": "event"
+	},
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "internalType": "address", "name": "operator", "type": "address"},
+			{"indexed": true, "internalType": "address", "name": "from", "type": "address"},
+			{"indexed": true, "internalType": "address", "name": "to", "type": "address"},
+			{"indexed": false, "internalType": "uint256[]", "name": "ids", "type": "uint256[]"},
+			{"indexed": false, "internalType": "uint256[]", "name": "values", "type": "uint256[]"}
+		],
+		"name": "TransferBatch",
+		"type": "event"
+	}
+]`
+
+	// the NS/bucket names of token transfer index, in a separate db from other indexers
+	_tokenTransferHeightNS   = "th"
+	_tokenTransferRevertNS   = "tr"
+	_tokenTransferByAddrPfx  = "ta"
+	_tokenTransferByTokenPfx = "tt"
+	_tokenTransferByPairPfx  = "tp"
+)
+
+// token standards of the transfer
+const (
+	XRC20 TokenStandard = iota + 1
+	XRC721
+	XRC1155
+)
+
+var (
+	_tokenABI                   abi.ABI
+	_tokenTransferCurrentHeight = []byte("currentHeight")
+
+	// ErrTokenTransferIndexNA indicates token transfer index is not supported
+	ErrTokenTransferIndexNA = errors.New("token transfer index not supported")
+)
+
+func init() {
+	var err error
+	_tokenABI, err = abi.JSON(strings.NewReader(_tokenEventsABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+type (
+	// TokenStandard is the standard of a token contract
+	TokenStandard uint8
+
+	// TokenTransfer is a transfer of XRC20, XRC721 or XRC1155 token decoded from the log
+	TokenTransfer struct {
+		Standard TokenStandard
+		Token    address.Address
+		From     address.Address
+		To       address.Address
+		// TokenID is nil for XRC20 token
+		TokenID     *big.Int
+		Amount      *big.Int
+		BlockHeight uint64
+		ActionHash  hash.Hash256
+		LogIndex    uint32
+	}
+
+	// TokenTransferIndexer is the indexer of token transfers by address and by token
+	TokenTransferIndexer interface {
+		blockdao.BlockIndexer
+		// TransfersByAddress returns the transfers [start, start+count) from or to the address, and the total number of
+		// them. If token is not nil, only the transfers of the token are returned
+		TransfersByAddress(addr address.Address, token address.Address, start, count uint64) ([]*TokenTransfer, uint64, error)
+		// TransfersByToken returns the transfers [start, start+count) of the token, and the total number of them
+		TransfersByToken(token address.Address, start, count uint64) ([]*TokenTransfer, uint64, error)
+	}
+
+	tokenTransferIndexer struct {
+		mutex   sync.RWMutex
+		kvStore db.KVStoreWithRange
+	}
+)
+
+// String returns the name of token standard
+func (s TokenStandard) String() string {
+	switch s {
+	case XRC20:
+		return "xrc20"
+	case XRC721:
+		return "xrc721"
+	case XRC1155:
+		return "xrc1155"
+	default:
+		return "unknown"
+	}
+}
+
+// Serialize returns the byte representation of the transfer
+func (t *TokenTransfer) Serialize() []byte {
+	var buf bytes.Buffer
+	buf.WriteByte(byte(t.Standard))
+	buf.Write(byteutil.Uint64ToBytesBigEndian(t.BlockHeight))
+	buf.Write(t.ActionHash[:])
+	buf.Write(byteutil.Uint32ToBytesBigEndian(t.LogIndex))
+	buf.Write(t.Token.Bytes())
+	buf.Write(t.From.Bytes())
+	buf.Write(t.To.Bytes())
+	writeBigInt(&buf, t.TokenID)
+	writeBigInt(&buf, t.Amount)
+	return buf.Bytes()
+}
+
+// Deserialize loads the transfer from bytes
+func (t *TokenTransfer) Deserialize(data []byte) error {
+	var (
+		r      = bytes.NewReader(data)
+		header [1 + 8 + 32 + 4]byte
+		err    error
+	)
+	if _, err = io.ReadFull(r, header[:]); err != nil {
+		return errors.Wrap(err, "failed to read token transfer")
+	}
+	t.Standard = TokenStandard(header[0])
+	t.BlockHeight = binary.BigEndian.Uint64(header[1:9])
+	t.ActionHash = hash.BytesToHash256(header[9:41])
+	t.LogIndex = binary.BigEndian.Uint32(header[41:45])
+	for _, addr := range []*address.Address{&t.Token, &t.From, &t.To} {
+		var b [20]byte
+		if _, err = io.ReadFull(r, b[:]); err != nil {
+			return errors.Wrap(err, "failed to read address of token transfer")
+		}
+		if *addr, err = address.FromBytes(b[:]); err != nil {
+			return err
+		}
+	}
+	if t.TokenID, err = readBigInt(r); err != nil {
+		return err
+	}
+	t.Amount, err = readBigInt(r)
+	return err
+}
+
+// writeBigInt writes a length-prefixed big int, where length 0xff stands for nil
+func writeBigInt(buf *bytes.Buffer, v *big.Int) {
+	if v == nil {
+		buf.WriteByte(0xff)
+		return
+	}
+	b := v.Bytes()
+	buf.WriteByte(byte(len(b)))
+	buf.Write(b)
+}
+
+func readBigInt(r *bytes.Reader) (*big.Int, error) {
+	size, err := r.ReadByte()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read big int")
+	}
+	if size == 0xff {
+		return nil, nil
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, errors.Wrap(err, "failed to read big int")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// NewTokenTransferIndexer creates a new token transfer indexer
+func NewTokenTransferIndexer(kv db.KVStore) (TokenTransferIndexer, error) {
+	if kv == nil {
+		return nil, errors.New("empty kvStore")
+	}
+	kvRange, ok := kv.(db.KVStoreWithRange)
+	if !ok {
+		return nil, errors.New("token transfer indexer can only be created from KVStoreWithRange")
+	}
+	return &tokenTransferIndexer{
+		kvStore: kvRange,
+	}, nil
+}
+
+// Start starts the indexer
+func (x *tokenTransferIndexer) Start(ctx context.Context) error {
+	if err := x.kvStore.Start(ctx); err != nil {
+		return err
+	}
+	if _, err := x.height(); err != nil {
+		if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == db.ErrBucketNotExist {
+			return x.kvStore.Put(_tokenTransferHeightNS, _tokenTransferCurrentHeight, byteutil.Uint64ToBytesBigEndian(0))
+		}
+		return err
+	}
+	return nil
+}
+
+// Stop stops the indexer
+func (x *tokenTransferIndexer) Stop(ctx context.Context) error {
+	return x.kvStore.Stop(ctx)
+}
+
+// Height returns the height of the indexer
+func (x *tokenTransferIndexer) Height() (uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+	return x.height()
+}
+
+// PutBlock indexes the token transfers in the block
+func (x *tokenTransferIndexer) PutBlock(ctx context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height <= tipHeight {
+		return nil
+	}
+	if height != tipHeight+1 {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight+1)
+	}
+	cb := newCountingIndexBatch(x.kvStore)
+	for _, r := range blk.Receipts {
+		if r.Status != uint64(iotextypes.ReceiptStatus_Success) {
+			continue
+		}
+		for _, log := range r.Logs() {
+			transfers, err := decodeTokenTransfers(log)
+			if err != nil {
+				return err
+			}
+			for _, t := range transfers {
+				value := t.Serialize()
+				if err := cb.add(tokenTransferBucket(_tokenTransferByTokenPfx, t.Token), value); err != nil {
+					return err
+				}
+				for _, addr := range tokenTransferParties(t) {
+					if err := cb.add(tokenTransferBucket(_tokenTransferByAddrPfx, addr), value); err != nil {
+						return err
+					}
+					if err := cb.add(tokenTransferBucket(_tokenTransferByPairPfx, addr, t.Token), value); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	// record the number of entries added to each index, to revert them on deleting the block
+	revert, err := cb.finalize()
+	if err != nil {
+		return err
+	}
+	heightBytes := byteutil.Uint64ToBytesBigEndian(height)
+	cb.batch.Put(_tokenTransferRevertNS, heightBytes, revert, fmt.Sprintf("failed to put revert record of block %d", height))
+	cb.batch.Put(_tokenTransferHeightNS, _tokenTransferCurrentHeight, heightBytes, "failed to put current height")
+	return x.kvStore.WriteBatch(cb.batch)
+}
+
+// DeleteTipBlock reverts the token transfers in the tip block
+func (x *tokenTransferIndexer) DeleteTipBlock(_ context.Context, blk *block.Block) error {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+
+	tipHeight, err := x.height()
+	if err != nil {
+		return err
+	}
+	height := blk.Height()
+	if height != tipHeight {
+		return errors.Wrapf(db.ErrInvalid, "wrong block height %d, expecting %d", height, tipHeight)
+	}
+	heightBytes := byteutil.Uint64ToBytesBigEndian(height)
+	revert, err := x.kvStore.Get(_tokenTransferRevertNS, heightBytes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get revert record of block %d", height)
+	}
+	b := batch.NewBatch()
+	if err := revertCountingIndexes(x.kvStore, b, revert); err != nil {
+		return err
+	}
+	b.Delete(_tokenTransferRevertNS, heightBytes, fmt.Sprintf("failed to delete revert record of block %d", height))
+	b.Put(_tokenTransferHeightNS, _tokenTransferCurrentHeight, byteutil.Uint64ToBytesBigEndian(height-1), "failed to put current height")
+	return x.kvStore.WriteBatch(b)
+}
+
+// TransfersByAddress returns the transfers from or to the address
+func (x *tokenTransferIndexer) TransfersByAddress(addr address.Address, token address.Address, start, count uint64) ([]*TokenTransfer, uint64, error) {
+	if token == nil {
+		return x.transfers(tokenTransferBucket(_tokenTransferByAddrPfx, addr), start, count)
+	}
+	return x.transfers(tokenTransferBucket(_tokenTransferByPairPfx, addr, token), start, count)
+}
+
+// TransfersByToken returns the transfers of the token
+func (x *tokenTransferIndexer) TransfersByToken(token address.Address, start, count uint64) ([]*TokenTransfer, uint64, error) {
+	return x.transfers(tokenTransferBucket(_tokenTransferByTokenPfx, token), start, count)
+}
+
+func (x *tokenTransferIndexer) transfers(bucket []byte, start, count uint64) ([]*TokenTransfer, uint64, error) {
+	x.mutex.RLock()
+	defer x.mutex.RUnlock()
+
+	values, total, err := rangeCountingIndex(x.kvStore, bucket, start, count)
+	if err != nil {
+		return nil, 0, err
+	}
+	transfers := make([]*TokenTransfer, 0, len(values))
+	for _, v := range values {
+		t := &TokenTransfer{}
+		if err := t.Deserialize(v); err != nil {
+			return nil, 0, err
+		}
+		transfers = append(transfers, t)
+	}
+	return transfers, total, nil
+}
+
+func (x *tokenTransferIndexer) height() (uint64, error) {
+	h, err := x.kvStore.Get(_tokenTransferHeightNS, _tokenTransferCurrentHeight)
+	if err != nil {
+		return 0, err
+	}
+	return byteutil.BytesToUint64BigEndian(h), nil
+}
+
+// decodeTokenTransfers decodes the token transfers from a log, the log of other events is skipped
+func decodeTokenTransfers(log *action.Log) ([]*TokenTransfer, error) {
+	if len(log.Topics) == 0 {
+		return nil, nil
+	}
+	var (
+		topic    = log.Topics[0]
+		newEntry = func(standard TokenStandard, from, to hash.Hash256, id, amount *big.Int) (*TokenTransfer, error) {
+			t := &TokenTransfer{
+				Standard:    standard,
+				TokenID:     id,
+				Amount:      amount,
+				BlockHeight: log.BlockHeight,
+				ActionHash:  log.ActionHash,
+				LogIndex:    log.Index,
+			}
+			var err error
+			if t.Token, err = address.FromString(log.Address); err != nil {
+				return nil, err
+			}
+			if t.From, err = address.FromBytes(from[12:]); err != nil {
+				return nil, err
+			}
+			if t.To, err = address.FromBytes(to[12:]); err != nil {
+				return nil, err
+			}
+			return t, nil
+		}
+	)
+	switch {
+	case topic == hash.Hash256(_tokenABI.Events["Transfer"].ID):
+		switch {
+		case len(log.Topics) == 3 && len(log.Data) == 32:
+			// Transfer(address indexed from, address indexed to, uint256 value)
+			t, err := newEntry(XRC20, log.Topics[1], log.Topics[2], nil, new(big.Int).SetBytes(log.Data))
+			if err != nil {
+				return nil, err
+			}
+			return []*TokenTransfer{t}, nil
+		case len(log.Topics) == 4 && len(log.Data) == 0:
+			// Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+			t, err := newEntry(XRC721, log.Topics[1], log.Topics[2], new(big.Int).SetBytes(log.Topics[3][:]), big.NewInt(1))
+			if err != nil {
+				return nil, err
+			}
+			return []*TokenTransfer{t}, nil
+		}
+	case topic == hash.Hash256(_tokenABI.Events["TransferSingle"].ID):
+		if len(log.Topics) != 4 || len(log.Data) != 64 {
+			return nil, nil
+		}
+		t, err := newEntry(XRC1155, log.Topics[2], log.Topics[3], new(big.Int).SetBytes(log.Data[:32]), new(big.Int).SetBytes(log.Data[32:]))
+		if err != nil {
+			return nil, err
+		}
+		return []*TokenTransfer{t}, nil
+	case topic == hash.Hash256(_tokenABI.Events["TransferBatch"].ID):
+		if len(log.Topics) != 4 {
+			return nil, nil
+		}
+		var event struct {
+			Ids    []*big.Int
+			Values []*big.Int
+		}
+		if err := _tokenABI.UnpackIntoInterface(&event, "TransferBatch", log.Data); err != nil || len(event.Ids) != len(event.Values) {
+			// not a standard event, skip it
+			return nil, nil
+		}
+		transfers := make([]*TokenTransfer, 0, len(event.Ids))
+		for i := range event.Ids {
+			t, err := newEntry(XRC1155, log.Topics[2], log.Topics[3], event.Ids[i], event.Values[i])
+			if err != nil {
+				return nil, err
+			}
+			transfers = append(transfers, t)
+		}
+		return transfers, nil
+	}
+	return nil, nil
+}
+
+// tokenTransferParties returns the addresses to index the transfer by, the zero address of mint and burn is skipped
+func tokenTransferParties(t *TokenTransfer) []address.Address {
+	var (
+		zero    [20]byte
+		parties []address.Address
+	)
+	for _, addr := range []address.Address{t.From, t.To} {
+		if bytes.Equal(addr.Bytes(), zero[:]) {
+			continue
+		}
+		if len(parties) > 0 && bytes.Equal(parties[0].Bytes(), addr.Bytes()) {
+			continue
+		}
+		parties = append(parties, addr)
+	}
+	return parties
+}
+
+func tokenTransferBucket(prefix string, addrs ...address.Address) []byte {
+	bucket := []byte(prefix)
+	for _, addr := range addrs {
+		bucket = append(bucket, addr.Bytes()...)
+	}
+	return bucket
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package blockindex
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestTokenTransferIndexer(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var (
+		alice, bob, carol = identityset.Address(1), identityset.Address(2), identityset.Address(3)
+		xrc20, xrc721     = identityset.Address(10), identityset.Address(11)
+		xrc1155           = identityset.Address(12)
+		zero, _           = address.FromBytes(make([]byte, 20))
+	)
+	topic := func(addr address.Address) hash.Hash256 {
+		return hash.BytesToHash256(addr.Bytes())
+	}
+	word := func(v int64) []byte {
+		h := hash.BytesToHash256(big.NewInt(v).Bytes())
+		return h[:]
+	}
+	transferID := hash.Hash256(_tokenABI.Events["Transfer"].ID)
+	singleID := hash.Hash256(_tokenABI.Events["TransferSingle"].ID)
+	batchID := hash.Hash256(_tokenABI.Events["TransferBatch"].ID)
+	batchData, err := _tokenABI.Events["TransferBatch"].Inputs.NonIndexed().Pack(
+		[]*big.Int{big.NewInt(1), big.NewInt(2)}, []*big.Int{big.NewInt(10), big.NewInt(20)})
+	require.NoError(err)
+
+	newBlock := func(height uint64, status uint64, logs ...*action.Log) *block.Block {
+		exec, err := action.SignedExecution(xrc20.String(), identityset.PrivateKey(27), height, big.NewInt(0), 100000, big.NewInt(0), nil)
+		require.NoError(err)
+		h, err := exec.Hash()
+		require.NoError(err)
+		for i, log := range logs {
+			log.BlockHeight = height
+			log.ActionHash = h
+			log.Index = uint32(i)
+		}
+		r := &action.Receipt{
+			Status:      status,
+			BlockHeight: height,
+			ActionHash:  h,
+		}
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetPrevBlockHash(h).
+			AddActions(exec).
+			SetReceipts([]*action.Receipt{r.AddLogs(logs...)}).
+			SetTimeStamp(testutil.TimestampNow().UTC()).
+			SignAndBuild(identityset.PrivateKey(27))
+		require.NoError(err)
+		return &blk
+	}
+	blk1 := newBlock(1, 1,
+		// xrc20 transfer from alice to bob
+		&action.Log{Address: xrc20.String(), Topics: action.Topics{transferID, topic(alice), topic(bob)}, Data: word(100)},
+		// xrc721 mint of token 7 to alice
+		&action.Log{Address: xrc721.String(), Topics: action.Topics{transferID, topic(zero), topic(alice), hash.BytesToHash256(word(7))}},
+		// unrelated event
+		&action.Log{Address: xrc20.String(), Topics: action.Topics{hash.Hash256b([]byte("Approval"))}, Data: word(1)},
+	)
+	blk2 := newBlock(2, 1,
+		// xrc1155 batch transfer from alice to carol
+		&action.Log{Address: xrc1155.String(), Topics: action.Topics{batchID, topic(alice), topic(alice), topic(carol)}, Data: batchData},
+		// xrc1155 single transfer from bob to alice
+		&action.Log{Address: xrc1155.String(), Topics: action.Topics{singleID, topic(bob), topic(bob), topic(alice)}, Data: append(word(3), word(30)...)},
+	)
+	// failed receipt is skipped
+	blk3 := newBlock(3, 0,
+		&action.Log{Address: xrc20.String(), Topics: action.Topics{transferID, topic(alice), topic(bob)}, Data: word(100)},
+	)
+
+	kv := &failWriteBatchKVStore{KVStoreWithRange: db.NewMemKVStore().(db.KVStoreWithRange)}
+	indexer, err := NewTokenTransferIndexer(kv)
+	require.NoError(err)
+	require.NoError(indexer.Start(ctx))
+	defer func() {
+		require.NoError(indexer.Stop(ctx))
+	}()
+	require.ErrorIs(indexer.PutBlock(ctx, blk2), db.ErrInvalid)
+	for _, blk := range []*block.Block{blk1, blk2, blk3} {
+		require.NoError(indexer.PutBlock(ctx, blk))
+	}
+	// indexed block is skipped
+	require.NoError(indexer.PutBlock(ctx, blk1))
+	height, err := indexer.Height()
+	require.NoError(err)
+	require.Equal(uint64(3), height)
+
+	list := func(transfers []*TokenTransfer, _ uint64, err error) []*TokenTransfer {
+		require.NoError(err)
+		return transfers
+	}
+	total := func(_ []*TokenTransfer, total uint64, err error) uint64 {
+		require.NoError(err)
+		return total
+	}
+	require.Equal(uint64(5), total(indexer.TransfersByAddress(alice, nil, 0, 10)))
+	transfers := list(indexer.TransfersByAddress(alice, nil, 0, 10))
+	require.Len(transfers, 5)
+	require.Equal(XRC20, transfers[0].Standard)
+	require.Equal(xrc20.String(), transfers[0].Token.String())
+	require.Equal(alice.String(), transfers[0].From.String())
+	require.Equal(bob.String(), transfers[0].To.String())
+	require.Nil(transfers[0].TokenID)
+	require.Equal(big.NewInt(100), transfers[0].Amount)
+	require.Equal(uint64(1), transfers[0].BlockHeight)
+	require.Equal(blk1.Receipts[0].ActionHash, transfers[0].ActionHash)
+	require.Equal(XRC721, transfers[1].Standard)
+	require.Equal(zero.String(), transfers[1].From.String())
+	require.Equal(big.NewInt(7), transfers[1].TokenID)
+	require.Equal(big.NewInt(1), transfers[1].Amount)
+	for i, expected := range []struct {
+		id, amount int64
+		to         address.Address
+	}{
+		{1, 10, carol}, {2, 20, carol}, {3, 30, alice},
+	} {
+		transfer := transfers[2+i]
+		require.Equal(XRC1155, transfer.Standard)
+		require.Equal(big.NewInt(expected.id), transfer.TokenID)
+		require.Equal(big.NewInt(expected.amount), transfer.Amount)
+		require.Equal(expected.to.String(), transfer.To.String())
+		require.Equal(uint32(i/2), transfer.LogIndex)
+	}
+
+	// pagination
+	transfers = list(indexer.TransfersByAddress(alice, nil, 3, 10))
+	require.Len(transfers, 2)
+	require.Equal(big.NewInt(2), transfers[0].TokenID)
+	transfers = list(indexer.TransfersByAddress(alice, nil, 5, 10))
+	require.Empty(transfers)
+	// filter by token
+	require.Equal(uint64(3), total(indexer.TransfersByAddress(alice, xrc1155, 0, 2)))
+	transfers = list(indexer.TransfersByAddress(alice, xrc1155, 0, 2))
+	require.Len(transfers, 2)
+	require.Equal(uint64(1), total(indexer.TransfersByAddress(bob, xrc20, 0, 10)))
+	require.Equal(uint64(0), total(indexer.TransfersByAddress(carol, xrc20, 0, 10)))
+	require.Equal(uint64(3), total(indexer.TransfersByToken(xrc1155, 0, 10)))
+	require.Equal(uint64(1), total(indexer.TransfersByToken(xrc721, 0, 10)))
+	// zero address is not indexed
+	require.Equal(uint64(0), total(indexer.TransfersByAddress(zero, nil, 0, 10)))
+
+	// delete tip blocks
+	require.ErrorIs(indexer.DeleteTipBlock(ctx, blk2), db.ErrInvalid)
+	require.NoError(indexer.DeleteTipBlock(ctx, blk3))
+	// a failed deletion leaves the index untouched
+	kv.fail = true
+	require.Error(indexer.DeleteTipBlock(ctx, blk2))
+	kv.fail = false
+	height, err = indexer.Height()
+	require.NoError(err)
+	require.Equal(uint64(2), height)
+	require.Equal(uint64(5), total(indexer.TransfersByAddress(alice, nil, 0, 10)))
+	require.Equal(uint64(3), total(indexer.TransfersByToken(xrc1155, 0, 10)))
+	require.NoError(indexer.DeleteTipBlock(ctx, blk2))
+	height, err = indexer.Height()
+	require.NoError(err)
+	require.Equal(uint64(1), height)
+	require.Equal(uint64(2), total(indexer.TransfersByAddress(alice, nil, 0, 10)))
+	require.Equal(uint64(0), total(indexer.TransfersByAddress(carol, nil, 0, 10)))
+	require.Equal(uint64(0), total(indexer.TransfersByToken(xrc1155, 0, 10)))
+	require.Equal(uint64(1), total(indexer.TransfersByAddress(bob, nil, 0, 10)))
+
+	// index the block again
+	require.NoError(indexer.PutBlock(ctx, blk2))
+	require.Equal(uint64(5), total(indexer.TransfersByAddress(alice, nil, 0, 10)))
+	require.Equal(uint64(2), total(indexer.TransfersByAddress(carol, xrc1155, 0, 10)))
+}
+
+// failWriteBatchKVStore fails writing the batch which updates the tip height when fail is set
+type failWriteBatchKVStore struct {
+	db.KVStoreWithRange
+	fail bool
+}
+
+func (kv *failWriteBatchKVStore) WriteBatch(b batch.KVStoreBatch) error {
+	for i := 0; kv.fail && i < b.Size(); i++ {
+		if e, err := b.Entry(i); err == nil && e.Namespace() == _tokenTransferHeightNS {
+			return errors.New("failed to write batch")
+		}
+	}
+	return kv.KVStoreWithRange.WriteBatch(b)
+}
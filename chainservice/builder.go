@@ -241,7 +241,11 @@ func (builder *Builder) createElectionCommittee() (committee.Committee, error) {
 
 func (builder *Builder) buildActionPool() error {
 	if builder.cs.actpool == nil {
-		ac, err := actpool.NewActPool(builder.cfg.Genesis, builder.cs.factory, builder.cfg.ActPool)
+		var opts []actpool.Option
+		if builder.cfg.ActPool.Journal.Path != "" {
+			opts = append(opts, actpool.EnableJournal(builder.cfg.ActPool.Journal, builder.cfg.Chain.EVMNetworkID))
+		}
+		ac, err := actpool.NewActPool(builder.cfg.Genesis, builder.cs.factory, builder.cfg.ActPool, opts...)
 		if err != nil {
 			return errors.Wrap(err, "failed to create actpool")
 		}
@@ -260,6 +264,25 @@ func (builder *Builder) buildBlockDAO(forTest bool) error {
 		return nil
 	}
 
+	var (
+		err   error
+		store blockdao.BlockDAO
+	)
+	if forTest {
+		store, err = filedao.NewFileDAOInMemForTest()
+	} else {
+		dbConfig := builder.cfg.DB
+		dbConfig.DbPath = builder.cfg.Chain.ChainDBPath
+		store, err = filedao.NewFileDAO(dbConfig, block.NewDeserializer(builder.cfg.Chain.EVMNetworkID))
+	}
+	if err != nil {
+		return err
+	}
+	// internal transfer indexer reads the transaction logs from the store
+	if err := builder.buildInternalTransferIndexer(forTest, store); err != nil {
+		return err
+	}
+
 	var indexers []blockdao.BlockIndexer
 	// indexers in synchronizedIndexers will need to run PutBlock() one by one
 	// factory is dependent on sgdIndexer and contractStakingIndexer, so it should be put in the first place
@@ -275,29 +298,97 @@ func (builder *Builder) buildBlockDAO(forTest bool) error {
 	} else {
 		indexers = append(indexers, builder.cs.factory)
 	}
+	// indexers not relied on by consensus are fed asynchronously if enabled
+	var (
+		asyncCfg      = builder.cfg.Chain.AsyncIndexer
+		asyncIndexers []*blockdao.AsyncIndexer
+	)
 	if !builder.cfg.Chain.EnableAsyncIndexWrite && builder.cs.indexer != nil {
-		indexers = append(indexers, builder.cs.indexer)
+		if asyncCfg.Enabled {
+			asyncIndexers = append(asyncIndexers, blockdao.NewAsyncIndexer("blockindexer", builder.cs.indexer, asyncCfg))
+		} else {
+			indexers = append(indexers, builder.cs.indexer)
+		}
+	}
+	if builder.cs.tokenTransferIndexer != nil {
+		if asyncCfg.Enabled {
+			asyncIndexers = append(asyncIndexers, blockdao.NewAsyncIndexer("tokentransfer", builder.cs.tokenTransferIndexer, asyncCfg))
+		} else {
+			indexers = append(indexers, builder.cs.tokenTransferIndexer)
+		}
+	}
+	if builder.cs.internalTransferIndexer != nil {
+		if asyncCfg.Enabled {
+			asyncIndexers = append(asyncIndexers, blockdao.NewAsyncIndexer("internaltransfer", builder.cs.internalTransferIndexer, asyncCfg))
+		} else {
+			indexers = append(indexers, builder.cs.internalTransferIndexer)
+		}
 	}
 	if builder.cs.bfIndexer != nil {
-		indexers = append(indexers, builder.cs.bfIndexer)
+		if asyncCfg.Enabled {
+			asyncIndexers = append(asyncIndexers, blockdao.NewAsyncIndexer("bloomfilter", builder.cs.bfIndexer, asyncCfg))
+		} else {
+			indexers = append(indexers, builder.cs.bfIndexer)
+		}
+	}
+	// api waits for the async indexers before querying them
+	builder.cs.asyncIndexers = asyncIndexers
+	opts := []blockdao.Option{blockdao.AsyncIndexersOption(asyncIndexers...)}
+	if retention := builder.cfg.DB.BlockHistoryRetention; retention > 0 {
+		// the block indexer written asynchronously is fed by the blockchain subscription rather than block dao
+		var extra []blockdao.BlockIndexerWithPrune
+		if builder.cfg.Chain.EnableAsyncIndexWrite && builder.cs.indexer != nil {
+			if pi, ok := builder.cs.indexer.(blockdao.BlockIndexerWithPrune); ok {
+				extra = append(extra, pi)
+			}
+		}
+		opts = append(opts, blockdao.BlockRetentionOption(retention, extra...))
+	}
+	builder.cs.blockdao = blockdao.NewBlockDAOWithIndexersAndCache(store, indexers, builder.cfg.DB.MaxCacheSize, opts...)
+
+	return nil
+}
+
+func (builder *Builder) buildTokenTransferIndexer(forTest bool) error {
+	if builder.cs.tokenTransferIndexer != nil {
+		return nil
+	}
+	_, gateway := builder.cfg.Plugins[config.GatewayPlugin]
+	if !gateway || !builder.cfg.Chain.EnableTokenTransferIndexer {
+		return nil
 	}
 	var (
-		err   error
-		store blockdao.BlockDAO
+		kvStore db.KVStore = db.NewMemKVStore()
+		err     error
 	)
-	if forTest {
-		store, err = filedao.NewFileDAOInMemForTest()
-	} else {
-		dbConfig := builder.cfg.DB
-		dbConfig.DbPath = builder.cfg.Chain.ChainDBPath
-		store, err = filedao.NewFileDAO(dbConfig, block.NewDeserializer(builder.cfg.Chain.EVMNetworkID))
-	}
-	if err != nil {
-		return err
+	if !forTest {
+		if kvStore, err = db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.TokenTransferIndexDBPath); err != nil {
+			return err
+		}
 	}
-	builder.cs.blockdao = blockdao.NewBlockDAOWithIndexersAndCache(store, indexers, builder.cfg.DB.MaxCacheSize)
+	builder.cs.tokenTransferIndexer, err = blockindex.NewTokenTransferIndexer(kvStore)
+	return err
+}
 
-	return nil
+func (builder *Builder) buildInternalTransferIndexer(forTest bool, reader blockindex.TransactionLogReader) error {
+	if builder.cs.internalTransferIndexer != nil {
+		return nil
+	}
+	_, gateway := builder.cfg.Plugins[config.GatewayPlugin]
+	if !gateway || !builder.cfg.Chain.EnableInternalTransferIndexer {
+		return nil
+	}
+	var (
+		kvStore db.KVStore = db.NewMemKVStore()
+		err     error
+	)
+	if !forTest {
+		if kvStore, err = db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.InternalTransferIndexDBPath); err != nil {
+			return err
+		}
+	}
+	builder.cs.internalTransferIndexer, err = blockindex.NewInternalTransferIndexer(kvStore, reader)
+	return err
 }
 
 func (builder *Builder) buildSGDRegistry(forTest bool) error {
@@ -327,11 +418,13 @@ func (builder *Builder) buildContractStakingIndexer(forTest bool) error {
 		builder.cs.contractStakingIndexer = nil
 		return nil
 	}
-	dbConfig := builder.cfg.DB
-	dbConfig.DbPath = builder.cfg.Chain.ContractStakingIndexDBPath
+	kvStore, err := db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.ContractStakingIndexDBPath)
+	if err != nil {
+		return err
+	}
 	voteCalcConsts := builder.cfg.Genesis.VoteWeightCalConsts
 	indexer, err := contractstaking.NewContractStakingIndexer(
-		db.NewBoltDB(dbConfig),
+		kvStore,
 		contractstaking.Config{
 			ContractAddress:      builder.cfg.Genesis.SystemStakingContractAddress,
 			ContractDeployHeight: builder.cfg.Genesis.SystemStakingContractHeight,
@@ -396,31 +489,44 @@ func (builder *Builder) createGateWayComponents(forTest bool) (
 		}
 		return
 	}
-	dbConfig := builder.cfg.DB
-	dbConfig.DbPath = builder.cfg.Chain.IndexDBPath
-	indexer, err = blockindex.NewIndexer(db.NewBoltDB(dbConfig), builder.cfg.Genesis.Hash())
+	var kvStore db.KVStore
+	if kvStore, err = db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.IndexDBPath); err != nil {
+		return
+	}
+	indexer, err = blockindex.NewIndexer(kvStore, builder.cfg.Genesis.Hash())
 	if err != nil {
 		return
 	}
 
 	// create bloomfilter indexer
-	dbConfig.DbPath = builder.cfg.Chain.BloomfilterIndexDBPath
-	bfIndexer, err = blockindex.NewBloomfilterIndexer(db.NewBoltDB(dbConfig), builder.cfg.Indexer)
+	if kvStore, err = db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.BloomfilterIndexDBPath); err != nil {
+		return
+	}
+	bfIndexer, err = blockindex.NewBloomfilterIndexer(kvStore, builder.cfg.Indexer)
 	if err != nil {
 		return
 	}
 
 	// create candidate indexer
-	dbConfig.DbPath = builder.cfg.Chain.CandidateIndexDBPath
-	candidateIndexer, err = poll.NewCandidateIndexer(db.NewBoltDB(dbConfig))
+	if kvStore, err = db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.CandidateIndexDBPath); err != nil {
+		return
+	}
+	candidateIndexer, err = poll.NewCandidateIndexer(kvStore)
 	if err != nil {
 		return
 	}
 
 	// create staking indexer
 	if builder.cfg.Chain.EnableStakingIndexer {
-		dbConfig.DbPath = builder.cfg.Chain.StakingIndexDBPath
-		candBucketsIndexer, err = staking.NewStakingCandidatesBucketsIndexer(db.NewBoltDB(dbConfig))
+		if kvStore, err = db.CreateKVStore(builder.cfg.DB, builder.cfg.Chain.StakingIndexDBPath); err != nil {
+			return
+		}
+		kvForRangeIndex, ok := kvStore.(db.KVStoreForRangeIndex)
+		if !ok {
+			err = errors.New("staking indexer requires a KVStore for range index")
+			return
+		}
+		candBucketsIndexer, err = staking.NewStakingCandidatesBucketsIndexer(kvForRangeIndex)
 	}
 	return
 }
@@ -428,6 +534,8 @@ func (builder *Builder) createGateWayComponents(forTest bool) (
 func (builder *Builder) buildBlockchain(forSubChain, forTest bool) error {
 	builder.cs.chain = builder.createBlockchain(forSubChain, forTest)
 	builder.cs.lifecycle.Add(builder.cs.chain)
+	// actpool replays its journal against the state, so it starts after the chain
+	builder.cs.lifecycle.Add(builder.cs.actpool)
 
 	if err := builder.cs.chain.AddSubscriber(builder.cs.actpool); err != nil {
 		return errors.Wrap(err, "failed to add actpool as subscriber")
@@ -450,7 +558,7 @@ func (builder *Builder) createBlockchain(forSubChain, forTest bool) blockchain.B
 	if builder.cs.chain != nil {
 		return builder.cs.chain
 	}
-	var chainOpts []blockchain.Option
+	chainOpts := []blockchain.Option{blockchain.SignerOption(builder.cs.producerSigner)}
 	if !forSubChain {
 		chainOpts = append(chainOpts, blockchain.BlockValidatorOption(block.NewValidator(builder.cs.factory, builder.cs.actpool)))
 	} else {
@@ -467,7 +575,7 @@ func (builder *Builder) buildNodeInfoManager() error {
 		return errors.New("cannot find staking protocol")
 	}
 	chain := builder.cs.chain
-	dm := nodeinfo.NewInfoManager(&builder.cfg.NodeInfo, cs.p2pAgent, cs.chain, builder.cfg.Chain.ProducerPrivateKey(), func() []string {
+	dm := nodeinfo.NewInfoManager(&builder.cfg.NodeInfo, cs.p2pAgent, cs.chain, cs.producerSigner, func() []string {
 		ctx := protocol.WithFeatureCtx(
 			protocol.WithBlockCtx(
 				genesis.WithGenesisContext(context.Background(), chain.Genesis()),
@@ -684,6 +792,8 @@ func (builder *Builder) buildConsensusComponent() error {
 		consensus.WithBroadcast(func(msg proto.Message) error {
 			return p2pAgent.BroadcastOutbound(context.Background(), msg)
 		}),
+		consensus.WithEvidenceHandler(builder.submitEquivocationEvidenceFunc()),
+		consensus.WithSigner(builder.cs.producerSigner),
 	}
 	if rDPoSProtocol := rolldpos.FindProtocol(builder.cs.registry); rDPoSProtocol != nil {
 		copts = append(copts, consensus.WithRollDPoSProtocol(rDPoSProtocol))
@@ -712,11 +822,67 @@ func (builder *Builder) buildConsensusComponent() error {
 	return nil
 }
 
+func (builder *Builder) buildProducerSigner() error {
+	if builder.cs.producerSigner != nil {
+		return nil
+	}
+	s, err := builder.cfg.Chain.ProducerSigner(context.Background())
+	if err != nil {
+		return errors.Wrap(err, "failed to create producer signer")
+	}
+	builder.cs.producerSigner = s
+	builder.cs.lifecycle.Add(s)
+	return nil
+}
+
+// submitEquivocationEvidenceFunc returns a function which submits the evidence with the producer's key
+func (builder *Builder) submitEquivocationEvidenceFunc() rp.EvidenceHandler {
+	var (
+		ap       = builder.cs.actpool
+		p2pAgent = builder.cs.p2pAgent
+		registry = builder.cs.registry
+		priKey   = builder.cs.producerSigner
+		chainID  = builder.cfg.Chain.ID
+		gasPrice = builder.cfg.ActPool.MinGasPrice()
+	)
+	return func(ev *action.EquivocationEvidence) error {
+		nonce, err := ap.GetPendingNonce(priKey.PublicKey().Address().String())
+		if err != nil {
+			return err
+		}
+		exec, err := action.NewEquivocationEvidenceExecution(nonce, 0, gasPrice, ev)
+		if err != nil {
+			return err
+		}
+		gasLimit, err := exec.IntrinsicGas()
+		if err != nil {
+			return err
+		}
+		elp := (&action.EnvelopeBuilder{}).SetNonce(nonce).
+			SetGasLimit(gasLimit).
+			SetGasPrice(gasPrice).
+			SetChainID(chainID).
+			SetAction(exec).Build()
+		selp, err := action.Sign(elp, priKey)
+		if err != nil {
+			return err
+		}
+		ctx := protocol.WithRegistry(context.Background(), registry)
+		if err := ap.Add(ctx, selp); err != nil {
+			return errors.Wrap(err, "failed to add equivocation evidence into actpool")
+		}
+		return p2pAgent.BroadcastOutbound(ctx, selp.Proto())
+	}
+}
+
 func (builder *Builder) build(forSubChain, forTest bool) (*ChainService, error) {
 	builder.cs.registry = protocol.NewRegistry()
 	if builder.cs.p2pAgent == nil {
 		builder.cs.p2pAgent = p2p.NewDummyAgent()
 	}
+	if err := builder.buildProducerSigner(); err != nil {
+		return nil, err
+	}
 	if err := builder.buildFactory(forTest); err != nil {
 		return nil, err
 	}
@@ -729,6 +895,9 @@ func (builder *Builder) build(forSubChain, forTest bool) (*ChainService, error)
 	if err := builder.buildGatewayComponents(forTest); err != nil {
 		return nil, err
 	}
+	if err := builder.buildTokenTransferIndexer(forTest); err != nil {
+		return nil, err
+	}
 	if err := builder.buildSGDRegistry(forTest); err != nil {
 		return nil, err
 	}
@@ -34,6 +34,7 @@ import (
 	"github.com/iotexproject/iotex-core/p2p"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 	"github.com/iotexproject/iotex-core/pkg/util/blockutil"
 	"github.com/iotexproject/iotex-core/server/itx/nodestats"
 	"github.com/iotexproject/iotex-core/state/factory"
@@ -65,16 +66,20 @@ type ChainService struct {
 	p2pAgent          p2p.Agent
 	electionCommittee committee.Committee
 	// TODO: explorer dependency deleted at #1085, need to api related params
-	indexer                blockindex.Indexer
-	bfIndexer              blockindex.BloomFilterIndexer
-	candidateIndexer       *poll.CandidateIndexer
-	candBucketsIndexer     *staking.CandidatesBucketsIndexer
-	sgdIndexer             blockindex.SGDRegistry
-	contractStakingIndexer *contractstaking.Indexer
-	registry               *protocol.Registry
-	nodeInfoManager        *nodeinfo.InfoManager
-	apiStats               *nodestats.APILocalStats
-	blockTimeCalculator    *blockutil.BlockTimeCalculator
+	indexer                 blockindex.Indexer
+	bfIndexer               blockindex.BloomFilterIndexer
+	asyncIndexers           []*blockdao.AsyncIndexer
+	candidateIndexer        *poll.CandidateIndexer
+	candBucketsIndexer      *staking.CandidatesBucketsIndexer
+	sgdIndexer              blockindex.SGDRegistry
+	tokenTransferIndexer    blockindex.TokenTransferIndexer
+	internalTransferIndexer blockindex.InternalTransferIndexer
+	contractStakingIndexer  *contractstaking.Indexer
+	registry                *protocol.Registry
+	nodeInfoManager         *nodeinfo.InfoManager
+	apiStats                *nodestats.APILocalStats
+	blockTimeCalculator     *blockutil.BlockTimeCalculator
+	producerSigner          signer.Signer
 }
 
 // Start starts the server
@@ -168,6 +173,11 @@ func (cs *ChainService) Consensus() consensus.Consensus {
 	return cs.consensus
 }
 
+// ProducerSigner returns the signer of the producer key
+func (cs *ChainService) ProducerSigner() signer.Signer {
+	return cs.producerSigner
+}
+
 // BlockSync returns the block syncer
 func (cs *ChainService) BlockSync() blocksync.BlockSync {
 	return cs.blocksync
@@ -195,6 +205,15 @@ func (cs *ChainService) NewAPIServer(cfg api.Config, plugins map[int]interface{}
 		api.WithAPIStats(cs.apiStats),
 		api.WithSGDIndexer(cs.sgdIndexer),
 	}
+	if cs.tokenTransferIndexer != nil {
+		apiServerOptions = append(apiServerOptions, api.WithTokenTransferIndexer(cs.tokenTransferIndexer))
+	}
+	if cs.internalTransferIndexer != nil {
+		apiServerOptions = append(apiServerOptions, api.WithInternalTransferIndexer(cs.internalTransferIndexer))
+	}
+	if len(cs.asyncIndexers) > 0 {
+		apiServerOptions = append(apiServerOptions, api.WithAsyncIndexers(cs.asyncIndexers...))
+	}
 
 	svr, err := api.NewServerV2(
 		cfg,
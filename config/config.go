@@ -25,6 +25,7 @@ import (
 	"github.com/iotexproject/iotex-core/dispatcher"
 	"github.com/iotexproject/iotex-core/nodeinfo"
 	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/pkg/ha"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -78,6 +79,7 @@ var (
 			StartSubChainInterval: 10 * time.Second,
 			SystemLogDBPath:       "/var/log",
 		},
+		HA:       ha.DefaultConfig,
 		DB:       db.DefaultConfig,
 		Indexer:  blockindex.DefaultConfig,
 		Genesis:  genesis.Default,
@@ -91,10 +93,13 @@ var (
 	Validates = []Validate{
 		ValidateRollDPoS,
 		ValidateArchiveMode,
+		ValidateBlockHistoryRetention,
 		ValidateDispatcher,
 		ValidateAPI,
 		ValidateActPool,
 		ValidateForkHeights,
+		ValidateHA,
+		ValidateRemoteSigner,
 	}
 )
 
@@ -127,6 +132,7 @@ type (
 		Dispatcher         dispatcher.Config               `yaml:"dispatcher"`
 		API                api.Config                      `yaml:"api"`
 		System             System                          `yaml:"system"`
+		HA                 ha.Config                       `yaml:"ha"`
 		DB                 db.Config                       `yaml:"db"`
 		Indexer            blockindex.Config               `yaml:"indexer"`
 		Log                log.GlobalConfig                `yaml:"log"`
@@ -258,11 +264,91 @@ func ValidateArchiveMode(cfg Config) error {
 	return errors.Wrap(ErrInvalidCfg, "Archive mode is incompatible with trieless state DB")
 }
 
+// ValidateBlockHistoryRetention validates the block history retention setting
+func ValidateBlockHistoryRetention(cfg Config) error {
+	if cfg.DB.BlockHistoryRetention == 0 {
+		return nil
+	}
+	if cfg.Chain.EnableArchiveMode {
+		return errors.Wrap(ErrInvalidCfg, "Archive mode is incompatible with block history retention")
+	}
+	if cfg.DB.V2BlocksToSplitDB == 0 {
+		return errors.Wrap(ErrInvalidCfg, "block history retention requires splitting the chain db into files")
+	}
+	return nil
+}
+
+// ValidateHA validates the leader lease configs
+func ValidateHA(cfg Config) error {
+	switch cfg.HA.Lease {
+	case ha.ManualLease:
+		return nil
+	case ha.FileLease:
+		if cfg.HA.LeasePath == "" {
+			return errors.Wrap(ErrInvalidCfg, "file lease requires the lease path")
+		}
+	case ha.KVLease:
+		if cfg.HA.LeaseURL == "" || cfg.HA.LeaseKey == "" {
+			return errors.Wrap(ErrInvalidCfg, "kv lease requires the lease url and key")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidCfg, "unknown lease backend %s", cfg.HA.Lease)
+	}
+	if cfg.HA.RenewInterval <= 0 || 2*cfg.HA.RenewInterval > cfg.HA.LeaseTTL {
+		return errors.Wrap(ErrInvalidCfg, "lease TTL should be at least twice the renew interval")
+	}
+	if cfg.System.Active {
+		return errors.Wrap(ErrInvalidCfg, "node should start in stand-by mode when the leader lease is enabled")
+	}
+	return nil
+}
+
+// ValidateRemoteSigner validates the remote signer of the producer key
+func ValidateRemoteSigner(cfg Config) error {
+	if err := cfg.Chain.RemoteSigner.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidCfg, err.Error())
+	}
+	return nil
+}
+
 // ValidateAPI validates the api configs
 func ValidateAPI(cfg Config) error {
 	if cfg.API.TpsWindow <= 0 {
 		return errors.Wrap(ErrInvalidCfg, "tps window is not a positive integer when the api is enabled")
 	}
+	if cfg.API.GasStation.FeeHistoryCacheSize <= 0 {
+		return errors.Wrap(ErrInvalidCfg, "fee history cache size is not a positive integer")
+	}
+	auth := cfg.API.Auth
+	maxWeight := 1
+	for method, weight := range auth.MethodWeights {
+		if weight <= 0 {
+			return errors.Wrapf(ErrInvalidCfg, "weight of method %s is not a positive integer", method)
+		}
+		if weight > maxWeight {
+			maxWeight = weight
+		}
+	}
+	quotas := []api.APIQuota{auth.Anonymous}
+	keys := make(map[string]bool, len(auth.Keys))
+	for _, k := range auth.Keys {
+		if k.Key == "" {
+			return errors.Wrap(ErrInvalidCfg, "api key is empty")
+		}
+		if keys[k.Key] {
+			return errors.Wrapf(ErrInvalidCfg, "duplicate api key of %s", k.Name)
+		}
+		keys[k.Key] = true
+		quotas = append(quotas, k.APIQuota)
+	}
+	for _, q := range quotas {
+		if q.RateLimit < 0 {
+			return errors.Wrap(ErrInvalidCfg, "api rate limit is negative")
+		}
+		if q.RateLimit > 0 && q.Burst < maxWeight {
+			return errors.Wrap(ErrInvalidCfg, "api burst is less than the maximum method weight")
+		}
+	}
 	return nil
 }
 
@@ -333,6 +419,8 @@ func ValidateForkHeights(cfg Config) error {
 		return errors.Wrap(ErrInvalidCfg, "Sumatra is heigher than Tsunami")
 	case hu.TsunamiBlockHeight > hu.UpernavikBlockHeight:
 		return errors.Wrap(ErrInvalidCfg, "Tsunami is heigher than Upernavik")
+	case hu.UpernavikBlockHeight > hu.VanuatuBlockHeight:
+		return errors.Wrap(ErrInvalidCfg, "Upernavik is heigher than Vanuatu")
 	}
 	return nil
 }
@@ -16,7 +16,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/api"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/ha"
 )
 
 const (
@@ -269,6 +271,90 @@ func TestValidateArchiveMode(t *testing.T) {
 	require.NoError(t, errors.Cause(ValidateArchiveMode(cfg)))
 }
 
+func TestValidateBlockHistoryRetention(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	require.NoError(ValidateBlockHistoryRetention(cfg))
+	cfg.DB.BlockHistoryRetention = 100
+	cfg.DB.V2BlocksToSplitDB = 0
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateBlockHistoryRetention(cfg)))
+	cfg.DB.V2BlocksToSplitDB = 10
+	require.NoError(ValidateBlockHistoryRetention(cfg))
+	cfg.Chain.EnableArchiveMode = true
+	require.EqualError(ValidateBlockHistoryRetention(cfg), "Archive mode is incompatible with block history retention: invalid config value")
+}
+
+func TestValidateAPIAuth(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	cfg.API.Auth = api.AuthConfig{
+		Keys: []api.APIKeyConfig{
+			{Key: "key1", Name: "alice", APIQuota: api.APIQuota{RateLimit: 10, Burst: 10}},
+		},
+		MethodWeights: map[string]int{"eth_getLogs": 10},
+	}
+	require.NoError(ValidateAPI(cfg))
+	cfg.API.Auth.MethodWeights["eth_getLogs"] = 20
+	require.EqualError(ValidateAPI(cfg), "api burst is less than the maximum method weight: invalid config value")
+	cfg.API.Auth.MethodWeights["eth_getLogs"] = 0
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateAPI(cfg)))
+	delete(cfg.API.Auth.MethodWeights, "eth_getLogs")
+	cfg.API.Auth.Keys = append(cfg.API.Auth.Keys, api.APIKeyConfig{Key: "key1", Name: "bob"})
+	require.EqualError(ValidateAPI(cfg), "duplicate api key of bob: invalid config value")
+	cfg.API.Auth.Keys[1].Key = ""
+	require.EqualError(ValidateAPI(cfg), "api key is empty: invalid config value")
+}
+
+func TestValidateAPIFeeHistoryCacheSize(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	require.NoError(ValidateAPI(cfg))
+	for _, size := range []int{0, -1} {
+		cfg.API.GasStation.FeeHistoryCacheSize = size
+		require.EqualError(ValidateAPI(cfg), "fee history cache size is not a positive integer: invalid config value")
+	}
+}
+
+func TestValidateRemoteSigner(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	require.NoError(ValidateRemoteSigner(cfg))
+	sk, err := crypto.GenerateKey()
+	require.NoError(err)
+	cfg.Chain.RemoteSigner.Endpoint = "127.0.0.1:14015"
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateRemoteSigner(cfg)))
+	cfg.Chain.RemoteSigner.PublicKey = sk.PublicKey().HexString()
+	require.EqualError(ValidateRemoteSigner(cfg), "mutual TLS is required for a remote signer, CA certificate, client certificate and key should be set: invalid remote signer config: invalid config value")
+	// mutual TLS is required on a unix socket too
+	cfg.Chain.RemoteSigner.Endpoint = "unix:///var/run/iotex-signer.sock"
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateRemoteSigner(cfg)))
+	cfg.Chain.RemoteSigner.CACertPath = "/etc/iotex/signer/ca.pem"
+	cfg.Chain.RemoteSigner.CertPath = "/etc/iotex/signer/client.pem"
+	cfg.Chain.RemoteSigner.KeyPath = "/etc/iotex/signer/client.key"
+	require.NoError(ValidateRemoteSigner(cfg))
+	require.Equal(sk.PublicKey().Address().String(), cfg.Chain.ProducerAddress().String())
+}
+
+func TestValidateHA(t *testing.T) {
+	require := require.New(t)
+	cfg := Default
+	require.NoError(ValidateHA(cfg))
+	cfg.HA.Lease = "etcd"
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateHA(cfg)))
+	cfg.HA.Lease = ha.FileLease
+	require.EqualError(ValidateHA(cfg), "file lease requires the lease path: invalid config value")
+	cfg.HA.LeasePath = "/tmp/iotex.lease"
+	require.EqualError(ValidateHA(cfg), "node should start in stand-by mode when the leader lease is enabled: invalid config value")
+	cfg.System.Active = false
+	require.NoError(ValidateHA(cfg))
+	cfg.HA.Lease = ha.KVLease
+	require.EqualError(ValidateHA(cfg), "kv lease requires the lease url and key: invalid config value")
+	cfg.HA.LeaseURL = "redis://localhost:6379/0"
+	require.NoError(ValidateHA(cfg))
+	cfg.HA.RenewInterval = cfg.HA.LeaseTTL
+	require.Equal(ErrInvalidCfg, errors.Cause(ValidateHA(cfg)))
+}
+
 func TestValidateActPool(t *testing.T) {
 	cfg := Default
 	cfg.ActPool.MaxNumActsPerAcct = 0
@@ -383,6 +469,9 @@ func TestValidateForkHeights(t *testing.T) {
 		{
 			"Tsunami", ErrInvalidCfg, "Tsunami is heigher than Upernavik",
 		},
+		{
+			"Upernavik", ErrInvalidCfg, "Upernavik is heigher than Vanuatu",
+		},
 		{
 			"", nil, "",
 		},
@@ -445,6 +534,8 @@ func newTestCfg(fork string) Config {
 		cfg.Genesis.SumatraBlockHeight = cfg.Genesis.TsunamiBlockHeight + 1
 	case "Tsunami":
 		cfg.Genesis.TsunamiBlockHeight = cfg.Genesis.UpernavikBlockHeight + 1
+	case "Upernavik":
+		cfg.Genesis.VanuatuBlockHeight = cfg.Genesis.UpernavikBlockHeight - 1
 	}
 	return cfg
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/iotexproject/iotex-core/consensus/scheme/rolldpos"
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/state/factory"
 )
@@ -47,8 +48,10 @@ type IotxConsensus struct {
 
 type optionParams struct {
 	broadcastHandler scheme.Broadcast
+	evidenceHandler  rolldpos.EvidenceHandler
 	pp               poll.Protocol
 	rp               *rp.Protocol
+	signer           signer.Signer
 }
 
 // Option sets Consensus construction parameter.
@@ -62,6 +65,22 @@ func WithBroadcast(broadcastHandler scheme.Broadcast) Option {
 	}
 }
 
+// WithEvidenceHandler is an option to add equivocation evidence callback to Consensus
+func WithEvidenceHandler(evidenceHandler rolldpos.EvidenceHandler) Option {
+	return func(ops *optionParams) error {
+		ops.evidenceHandler = evidenceHandler
+		return nil
+	}
+}
+
+// WithSigner is an option to sign with the producer key held by the signer, instead of the key in config
+func WithSigner(s signer.Signer) Option {
+	return func(ops *optionParams) error {
+		ops.signer = s
+		return nil
+	}
+}
+
 // WithRollDPoSProtocol is an option to register rolldpos protocol
 func WithRollDPoSProtocol(rp *rp.Protocol) Option {
 	return func(ops *optionParams) error {
@@ -132,14 +151,19 @@ func NewConsensus(
 			return addrs, nil
 		}
 		proposersByEpochFunc := delegatesByEpochFunc
+		producer := ops.signer
+		if producer == nil {
+			producer = cfg.Chain.ProducerPrivateKey()
+		}
 		bd := rolldpos.NewRollDPoSBuilder().
 			SetAddr(cfg.Chain.ProducerAddress().String()).
-			SetPriKey(cfg.Chain.ProducerPrivateKey()).
+			SetSigner(producer).
 			SetConfig(cfg).
 			SetChainManager(rolldpos.NewChainManager(bc)).
 			SetBlockDeserializer(block.NewDeserializer(bc.EvmNetworkID())).
 			SetClock(clock).
 			SetBroadcast(ops.broadcastHandler).
+			SetEvidenceHandler(ops.evidenceHandler).
 			SetDelegatesByEpochFunc(delegatesByEpochFunc).
 			SetProposersByEpochFunc(proposersByEpochFunc).
 			RegisterProtocol(ops.rp)
@@ -378,6 +378,34 @@ func (m *endorsementManager) AddVoteEndorsement(
 	return nil
 }
 
+// ConflictingEndorsement returns the vote and endorsement of the same endorser on the same topic with the same
+// timestamp as the given one, but for a different block
+func (m *endorsementManager) ConflictingEndorsement(
+	vote *ConsensusVote,
+	en *endorsement.Endorsement,
+) (*ConsensusVote, *endorsement.Endorsement) {
+	encoded := encodeToString(vote.BlockHash())
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	endorser := en.Endorser().HexString()
+	for key, c := range m.collections {
+		if key == encoded || len(key) == 0 {
+			continue
+		}
+		e := c.Endorsement(endorser, vote.Topic())
+		if e == nil || !e.Timestamp().Equal(en.Timestamp()) {
+			continue
+		}
+		blkHash, err := hex.DecodeString(key)
+		if err != nil {
+			continue
+		}
+		return NewConsensusVote(blkHash, vote.Topic()), e
+	}
+	return nil, nil
+}
+
 func (m *endorsementManager) SetMintedBlock(blk *block.Block) error {
 	m.cachedMintedBlk = blk
 	if m.eManagerDB != nil {
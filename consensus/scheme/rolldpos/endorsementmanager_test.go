@@ -13,6 +13,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/test/identityset"
@@ -213,3 +214,46 @@ func TestEndorsementManagerProto(t *testing.T) {
 	require.Equal(em.collections[encoded].endorsers, em2.collections[encoded].endorsers)
 	require.Equal(em.cachedMintedBlk.HashBlock(), em2.cachedMintedBlk.HashBlock())
 }
+
+func TestEndorsementManagerConflictingEndorsement(t *testing.T) {
+	require := require.New(t)
+
+	em, err := newEndorsementManager(nil, block.NewDeserializer(0))
+	require.NoError(err)
+	var (
+		priKey = identityset.PrivateKey(1)
+		ts     = time.Now()
+		cv1    = NewConsensusVote([]byte("block1"), COMMIT)
+		cv2    = NewConsensusVote([]byte("block2"), COMMIT)
+	)
+	en1, err := endorsement.Endorse(priKey, cv1, ts)
+	require.NoError(err)
+	require.NoError(em.AddVoteEndorsement(cv1, en1))
+	_, conflicting := em.ConflictingEndorsement(cv1, en1)
+	require.Nil(conflicting)
+
+	// vote of another endorser or at another time does not conflict
+	en, err := endorsement.Endorse(identityset.PrivateKey(2), cv2, ts)
+	require.NoError(err)
+	require.NoError(em.AddVoteEndorsement(cv2, en))
+	_, conflicting = em.ConflictingEndorsement(cv2, en)
+	require.Nil(conflicting)
+	en, err = endorsement.Endorse(priKey, cv2, ts.Add(time.Second))
+	require.NoError(err)
+	_, conflicting = em.ConflictingEndorsement(cv2, en)
+	require.Nil(conflicting)
+
+	en2, err := endorsement.Endorse(priKey, cv2, ts)
+	require.NoError(err)
+	require.NoError(em.AddVoteEndorsement(cv2, en2))
+	vote, conflicting := em.ConflictingEndorsement(cv2, en2)
+	require.Equal(en1, conflicting)
+	require.Equal(cv1, vote)
+
+	// the conflicting votes make a valid evidence
+	pb1, err := vote.Proto()
+	require.NoError(err)
+	pb2, err := cv2.Proto()
+	require.NoError(err)
+	require.NoError(action.NewEquivocationEvidence(pb1, conflicting, pb2, en2).Verify())
+}
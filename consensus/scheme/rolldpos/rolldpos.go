@@ -11,7 +11,6 @@ import (
 
 	"github.com/facebookgo/clock"
 	"github.com/iotexproject/go-fsm"
-	"github.com/iotexproject/go-pkgs/crypto"
 	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -25,6 +24,7 @@ import (
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 )
 
 var (
@@ -43,6 +43,10 @@ type (
 		ToleratedOvertime time.Duration                `yaml:"toleratedOvertime"`
 		Delay             time.Duration                `yaml:"delay"`
 		ConsensusDBPath   string                       `yaml:"consensusDBPath"`
+		// SignGuardPath is the file to record the last signature of the delegate, to avoid double signing after restart.
+		// The file only protects the node on its own host, it doesn't stop another node running the same producer key
+		// on a different host, which the leader lease with fencing of the remote signer is for
+		SignGuardPath string `yaml:"signGuardPath"`
 	}
 
 	// ChainManager defines the blockchain interface
@@ -83,6 +87,7 @@ var DefaultConfig = Config{
 	ToleratedOvertime: 2 * time.Second,
 	Delay:             5 * time.Second,
 	ConsensusDBPath:   "/var/data/consensus.db",
+	SignGuardPath:     "/var/data/signguard.db",
 }
 
 // NewChainManager creates a chain manager
@@ -332,10 +337,11 @@ type (
 		cfg BuilderConfig
 		// TODO: we should use keystore in the future
 		encodedAddr       string
-		priKey            crypto.PrivateKey
+		signer            signer.Signer
 		chain             ChainManager
 		blockDeserializer *block.Deserializer
 		broadcastHandler  scheme.Broadcast
+		evidenceHandler   EvidenceHandler
 		clock             clock.Clock
 		// TODO: explorer dependency deleted at #1085, need to add api params
 		rp                   *rolldpos.Protocol
@@ -361,9 +367,9 @@ func (b *Builder) SetAddr(encodedAddr string) *Builder {
 	return b
 }
 
-// SetPriKey sets the private key
-func (b *Builder) SetPriKey(priKey crypto.PrivateKey) *Builder {
-	b.priKey = priKey
+// SetSigner sets the signer of the producer key
+func (b *Builder) SetSigner(signer signer.Signer) *Builder {
+	b.signer = signer
 	return b
 }
 
@@ -385,6 +391,12 @@ func (b *Builder) SetBroadcast(broadcastHandler scheme.Broadcast) *Builder {
 	return b
 }
 
+// SetEvidenceHandler sets the evidence handler
+func (b *Builder) SetEvidenceHandler(evidenceHandler EvidenceHandler) *Builder {
+	b.evidenceHandler = evidenceHandler
+	return b
+}
+
 // SetClock sets the clock
 func (b *Builder) SetClock(clock clock.Clock) *Builder {
 	b.clock = clock
@@ -428,6 +440,7 @@ func (b *Builder) Build() (*RollDPoS, error) {
 	ctx, err := NewRollDPoSCtx(
 		consensusfsm.NewConsensusConfig(b.cfg.Consensus.FSM, b.cfg.DardanellesUpgrade, b.cfg.Genesis, b.cfg.Consensus.Delay),
 		b.cfg.DB,
+		b.cfg.Consensus.SignGuardPath,
 		b.cfg.SystemActive,
 		b.cfg.Consensus.ToleratedOvertime,
 		b.cfg.Genesis.TimeBasedRotation,
@@ -435,10 +448,11 @@ func (b *Builder) Build() (*RollDPoS, error) {
 		b.blockDeserializer,
 		b.rp,
 		b.broadcastHandler,
+		b.evidenceHandler,
 		b.delegatesByEpochFunc,
 		b.proposersByEpochFunc,
 		b.encodedAddr,
-		b.priKey,
+		b.signer,
 		b.clock,
 		b.cfg.Genesis.BeringBlockHeight,
 	)
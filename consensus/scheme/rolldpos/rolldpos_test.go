@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -77,7 +78,7 @@ func TestNewRollDPoS(t *testing.T) {
 		r, err := NewRollDPoSBuilder().
 			SetConfig(builderCfg).
 			SetAddr(identityset.Address(0).String()).
-			SetPriKey(sk).
+			SetSigner(sk).
 			SetChainManager(NewChainManager(mock_blockchain.NewMockBlockchain(ctrl))).
 			SetBroadcast(func(_ proto.Message) error {
 				return nil
@@ -94,7 +95,7 @@ func TestNewRollDPoS(t *testing.T) {
 		r, err := NewRollDPoSBuilder().
 			SetConfig(builderCfg).
 			SetAddr(identityset.Address(0).String()).
-			SetPriKey(sk).
+			SetSigner(sk).
 			SetChainManager(NewChainManager(mock_blockchain.NewMockBlockchain(ctrl))).
 			SetBroadcast(func(_ proto.Message) error {
 				return nil
@@ -115,7 +116,7 @@ func TestNewRollDPoS(t *testing.T) {
 		r, err := NewRollDPoSBuilder().
 			SetConfig(builderCfg).
 			SetAddr(identityset.Address(0).String()).
-			SetPriKey(sk).
+			SetSigner(sk).
 			SetChainManager(NewChainManager(mock_blockchain.NewMockBlockchain(ctrl))).
 			SetBroadcast(func(_ proto.Message) error {
 				return nil
@@ -133,7 +134,7 @@ func TestNewRollDPoS(t *testing.T) {
 		r, err := NewRollDPoSBuilder().
 			SetConfig(builderCfg).
 			SetAddr(identityset.Address(0).String()).
-			SetPriKey(sk).
+			SetSigner(sk).
 			SetBroadcast(func(_ proto.Message) error {
 				return nil
 			}).
@@ -235,7 +236,7 @@ func TestValidateBlockFooter(t *testing.T) {
 	r, err := NewRollDPoSBuilder().
 		SetConfig(builderCfg).
 		SetAddr(identityset.Address(1).String()).
-		SetPriKey(sk1).
+		SetSigner(sk1).
 		SetChainManager(NewChainManager(bc)).
 		SetBroadcast(func(_ proto.Message) error {
 			return nil
@@ -293,7 +294,8 @@ func TestRollDPoS_Metrics(t *testing.T) {
 
 	sk1 := identityset.PrivateKey(1)
 	cfg := DefaultConfig
-	cfg.ConsensusDBPath = "consensus.db"
+	cfg.ConsensusDBPath = filepath.Join(t.TempDir(), "consensus.db")
+	cfg.SignGuardPath = ""
 	g := genesis.Default
 	g.NumDelegates = 4
 	g.NumSubEpochs = 1
@@ -324,7 +326,7 @@ func TestRollDPoS_Metrics(t *testing.T) {
 	r, err := NewRollDPoSBuilder().
 		SetConfig(builderCfg).
 		SetAddr(identityset.Address(1).String()).
-		SetPriKey(sk1).
+		SetSigner(sk1).
 		SetChainManager(NewChainManager(bc)).
 		SetBroadcast(func(_ proto.Message) error {
 			return nil
@@ -388,6 +390,7 @@ func TestRollDPoSConsensus(t *testing.T) {
 	newConsensusComponents := func(numNodes int) ([]*RollDPoS, []*directOverlay, []blockchain.Blockchain) {
 		cfg := DefaultConfig
 		cfg.ConsensusDBPath = ""
+		cfg.SignGuardPath = ""
 		cfg.Delay = 300 * time.Millisecond
 		cfg.FSM.AcceptBlockTTL = 800 * time.Millisecond
 		cfg.FSM.AcceptProposalEndorsementTTL = 400 * time.Millisecond
@@ -485,7 +488,7 @@ func TestRollDPoSConsensus(t *testing.T) {
 
 			consensus, err := NewRollDPoSBuilder().
 				SetAddr(chainAddrs[i].encodedAddr).
-				SetPriKey(chainAddrs[i].priKey).
+				SetSigner(chainAddrs[i].priKey).
 				SetConfig(builderCfg).
 				SetChainManager(NewChainManager(chain)).
 				SetBroadcast(p2p.Broadcast).
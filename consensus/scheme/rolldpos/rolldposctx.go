@@ -12,11 +12,12 @@ import (
 
 	"github.com/facebookgo/clock"
 	fsm "github.com/iotexproject/go-fsm"
-	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/cache"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -25,8 +26,12 @@ import (
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 )
 
+// the number of equivocation evidences remembered to avoid reporting them again
+const _reportedEvidencesSize = 64
+
 var (
 	_timeSlotMtc = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -72,6 +77,9 @@ type (
 	// NodesSelectionByEpochFunc defines a function to select nodes
 	NodesSelectionByEpochFunc func(uint64) ([]string, error)
 
+	// EvidenceHandler defines a function to handle the evidence of a delegate signing conflicting votes
+	EvidenceHandler func(*action.EquivocationEvidence) error
+
 	// RDPoSCtx is the context of RollDPoS
 	RDPoSCtx interface {
 		consensusfsm.Context
@@ -90,12 +98,15 @@ type (
 		chain             ChainManager
 		blockDeserializer *block.Deserializer
 		broadcastHandler  scheme.Broadcast
+		evidenceHandler   EvidenceHandler
+		reportedEvidences cache.LRUCache
 		roundCalc         *roundCalculator
 		eManagerDB        db.KVStore
+		signGuard         *signGuard
 		toleratedOvertime time.Duration
 
 		encodedAddr string
-		priKey      crypto.PrivateKey
+		signer      signer.Signer
 		round       *roundCtx
 		clock       clock.Clock
 		active      bool
@@ -107,6 +118,7 @@ type (
 func NewRollDPoSCtx(
 	cfg consensusfsm.ConsensusConfig,
 	consensusDBConfig db.Config,
+	signGuardPath string,
 	active bool,
 	toleratedOvertime time.Duration,
 	timeBasedRotation bool,
@@ -114,10 +126,11 @@ func NewRollDPoSCtx(
 	blockDeserializer *block.Deserializer,
 	rp *rolldpos.Protocol,
 	broadcastHandler scheme.Broadcast,
+	evidenceHandler EvidenceHandler,
 	delegatesByEpochFunc NodesSelectionByEpochFunc,
 	proposersByEpochFunc NodesSelectionByEpochFunc,
 	encodedAddr string,
-	priKey crypto.PrivateKey,
+	signer signer.Signer,
 	clock clock.Clock,
 	beringHeight uint64,
 ) (RDPoSCtx, error) {
@@ -162,18 +175,24 @@ func NewRollDPoSCtx(
 		ConsensusConfig:   cfg,
 		active:            active,
 		encodedAddr:       encodedAddr,
-		priKey:            priKey,
+		signer:            signer,
 		chain:             chain,
 		blockDeserializer: blockDeserializer,
 		broadcastHandler:  broadcastHandler,
+		evidenceHandler:   evidenceHandler,
+		reportedEvidences: cache.NewThreadSafeLruCache(_reportedEvidencesSize),
 		clock:             clock,
 		roundCalc:         roundCalc,
 		eManagerDB:        eManagerDB,
+		signGuard:         newSignGuard(signGuardPath),
 		toleratedOvertime: toleratedOvertime,
 	}, nil
 }
 
 func (ctx *rollDPoSCtx) Start(c context.Context) (err error) {
+	if err := ctx.signGuard.load(); err != nil {
+		return err
+	}
 	var eManager *endorsementManager
 	if ctx.eManagerDB != nil {
 		if err := ctx.eManagerDB.Start(c); err != nil {
@@ -652,7 +671,11 @@ func (ctx *rollDPoSCtx) isDelegate() bool {
 }
 
 func (ctx *rollDPoSCtx) endorseBlockProposal(proposal *blockProposal) (*EndorsedConsensusMessage, error) {
-	en, err := endorsement.Endorse(ctx.priKey, proposal, ctx.round.StartTime())
+	blkHash := proposal.block.HashBlock()
+	if err := ctx.signGuard.guard(proposal.block.Height(), ctx.round.Number(), _signStepBlockProposal, blkHash[:]); err != nil {
+		return nil, err
+	}
+	en, err := endorsement.Endorse(ctx.signer, proposal, ctx.round.StartTime())
 	if err != nil {
 		return nil, err
 	}
@@ -721,6 +744,9 @@ func (ctx *rollDPoSCtx) verifyVote(
 	if err := ctx.round.AddVoteEndorsement(vote, endorsement); err != nil {
 		return blkHash, err
 	}
+	if conflictingVote, conflictingEn := ctx.round.ConflictingEndorsement(vote, endorsement); conflictingEn != nil {
+		ctx.reportEquivocation(vote, endorsement, conflictingVote, conflictingEn)
+	}
 	ctx.loggerWithStats().Debug(
 		"verified consensus vote",
 		log.Hex("block", blkHash),
@@ -733,6 +759,42 @@ func (ctx *rollDPoSCtx) verifyVote(
 	return blkHash, nil
 }
 
+// reportEquivocation hands the conflicting votes to the evidence handler, each equivocation is reported once
+func (ctx *rollDPoSCtx) reportEquivocation(
+	vote1 *ConsensusVote,
+	en1 *endorsement.Endorsement,
+	vote2 *ConsensusVote,
+	en2 *endorsement.Endorsement,
+) {
+	if ctx.evidenceHandler == nil {
+		return
+	}
+	pb1, err := vote1.Proto()
+	if err != nil {
+		return
+	}
+	pb2, err := vote2.Proto()
+	if err != nil {
+		return
+	}
+	ev := action.NewEquivocationEvidence(pb1, en1, pb2, en2)
+	evHash := ev.Hash()
+	if _, ok := ctx.reportedEvidences.Get(evHash); ok {
+		return
+	}
+	ctx.reportedEvidences.Add(evHash, struct{}{})
+	ctx.loggerWithStats().Warn(
+		"detected conflicting consensus votes",
+		zap.String("endorser", en1.Endorser().Address().String()),
+		zap.Uint8("topic", uint8(vote1.Topic())),
+		log.Hex("block1", vote1.BlockHash()),
+		log.Hex("block2", vote2.BlockHash()),
+	)
+	if err := ctx.evidenceHandler(ev); err != nil {
+		ctx.loggerWithStats().Error("failed to handle equivocation evidence", zap.Error(err))
+	}
+}
+
 func (ctx *rollDPoSCtx) newEndorsement(
 	blkHash []byte,
 	topic ConsensusVoteTopic,
@@ -742,7 +804,10 @@ func (ctx *rollDPoSCtx) newEndorsement(
 		blkHash,
 		topic,
 	)
-	en, err := endorsement.Endorse(ctx.priKey, vote, timestamp)
+	if err := ctx.signGuard.guard(ctx.round.Height(), ctx.round.Number(), signStepOfTopic(topic), blkHash); err != nil {
+		return nil, err
+	}
+	en, err := endorsement.Endorse(ctx.signer, vote, timestamp)
 	if err != nil {
 		return nil, err
 	}
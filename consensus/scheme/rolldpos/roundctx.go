@@ -237,6 +237,13 @@ func (ctx *roundCtx) AddVoteEndorsement(
 	return nil
 }
 
+func (ctx *roundCtx) ConflictingEndorsement(
+	vote *ConsensusVote,
+	en *endorsement.Endorsement,
+) (*ConsensusVote, *endorsement.Endorsement) {
+	return ctx.eManager.ConflictingEndorsement(vote, en)
+}
+
 func (ctx *roundCtx) SetMintedBlock(blk *block.Block) error {
 	return ctx.eManager.SetMintedBlock(blk)
 }
@@ -0,0 +1,215 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// the steps of a round a delegate signs in order, the block proposal is signed before the votes
+const (
+	_signStepBlockProposal uint8 = iota
+	_signStepProposalVote
+	_signStepLockVote
+	_signStepCommitVote
+)
+
+var (
+	// ErrDoubleSign indicates the signature requested conflicts with the one signed before
+	ErrDoubleSign = errors.New("refuse to sign conflicting endorsement")
+
+	// ErrCorruptedSignGuard indicates the sign guard file is corrupted
+	ErrCorruptedSignGuard = errors.New("sign guard file is corrupted")
+)
+
+type (
+	// signRecord is the position in consensus of the last signature, and the block hash signed
+	signRecord struct {
+		height  uint64
+		round   uint32
+		step    uint8
+		blkHash []byte
+	}
+
+	// signGuard records the last signature of the delegate, and refuses to sign at a position before it, or a
+	// different block at the same position. The record is written to file before the signature is released, so
+	// that it survives restarts. With an empty path, the record is kept in memory only.
+	// The guard is single-host protection: it is not safe on shared storage, where the rename and sync of two nodes
+	// are not serialized, and it doesn't know the signatures made by a node on another host with the same key
+	signGuard struct {
+		mutex sync.Mutex
+		path  string
+		last  *signRecord
+	}
+)
+
+func signStepOfTopic(topic ConsensusVoteTopic) uint8 {
+	switch topic {
+	case PROPOSAL:
+		return _signStepProposalVote
+	case LOCK:
+		return _signStepLockVote
+	default:
+		return _signStepCommitVote
+	}
+}
+
+func newSignGuard(path string) *signGuard {
+	return &signGuard{path: path}
+}
+
+// load reads the last signature from file
+func (g *signGuard) load() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if g.path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(g.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to read sign guard file")
+	}
+	record, err := decodeSignRecord(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load sign guard file %s", g.path)
+	}
+	g.last = record
+	log.L().Info("Loaded the last signature.",
+		zap.Uint64("height", record.height),
+		zap.Uint32("round", record.round),
+		zap.Uint8("step", record.step),
+		log.Hex("block", record.blkHash))
+	return nil
+}
+
+// guard checks the signature to make against the last one, and persists it if it is allowed to sign
+func (g *signGuard) guard(height uint64, round uint32, step uint8, blkHash []byte) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	record := &signRecord{height: height, round: round, step: step, blkHash: blkHash}
+	if last := g.last; last != nil {
+		switch cmp := last.compare(record); {
+		case cmp > 0:
+			return errors.Wrapf(ErrDoubleSign,
+				"height %d round %d step %d is before the last signature at height %d round %d step %d",
+				height, round, step, last.height, last.round, last.step)
+		case cmp == 0:
+			if !bytes.Equal(last.blkHash, blkHash) {
+				return errors.Wrapf(ErrDoubleSign,
+					"block %x conflicts with block %x signed at height %d round %d step %d",
+					blkHash, last.blkHash, height, round, step)
+			}
+			// signing the same block again does no harm
+			return nil
+		}
+	}
+	if err := g.persist(record); err != nil {
+		return err
+	}
+	g.last = record
+	return nil
+}
+
+// persist writes the record to a temporary file, and renames it to the guard file after syncing to disk
+func (g *signGuard) persist(record *signRecord) error {
+	if g.path == "" {
+		return nil
+	}
+	tmpPath := g.path + ".new"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to create sign guard file")
+	}
+	if _, err := tmp.Write(record.encode()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, g.path); err != nil {
+		return errors.Wrap(err, "failed to replace sign guard file")
+	}
+	// sync the directory, so that the rename is durable
+	dir, err := os.Open(filepath.Dir(g.path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// compare returns -1, 0 or 1 if the record is before, at or after the other one
+func (r *signRecord) compare(other *signRecord) int {
+	switch {
+	case r.height != other.height:
+		if r.height < other.height {
+			return -1
+		}
+		return 1
+	case r.round != other.round:
+		if r.round < other.round {
+			return -1
+		}
+		return 1
+	case r.step != other.step:
+		if r.step < other.step {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// encode returns the bytes of record: height (8 bytes) | round (4 bytes) | step (1 byte) | block hash | crc32 (4 bytes)
+func (r *signRecord) encode() []byte {
+	buf := make([]byte, 13+len(r.blkHash)+4)
+	binary.BigEndian.PutUint64(buf, r.height)
+	binary.BigEndian.PutUint32(buf[8:], r.round)
+	buf[12] = r.step
+	copy(buf[13:], r.blkHash)
+	binary.BigEndian.PutUint32(buf[len(buf)-4:], crc32.ChecksumIEEE(buf[:len(buf)-4]))
+	return buf
+}
+
+func decodeSignRecord(data []byte) (*signRecord, error) {
+	if len(data) < 17 {
+		return nil, ErrCorruptedSignGuard
+	}
+	body := data[:len(data)-4]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(data[len(data)-4:]) {
+		return nil, ErrCorruptedSignGuard
+	}
+	record := &signRecord{
+		height: binary.BigEndian.Uint64(body),
+		round:  binary.BigEndian.Uint32(body[8:]),
+		step:   body[12],
+	}
+	if len(body) > 13 {
+		record.blkHash = make([]byte, len(body)-13)
+		copy(record.blkHash, body[13:])
+	}
+	return record, nil
+}
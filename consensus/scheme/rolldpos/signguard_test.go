@@ -0,0 +1,64 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package rolldpos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignGuard(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		path       = filepath.Join(t.TempDir(), "signguard.db")
+		hash1      = []byte("block1")
+		hash2      = []byte("block2")
+		commitStep = signStepOfTopic(COMMIT)
+	)
+	g := newSignGuard(path)
+	require.NoError(g.load())
+	require.NoError(g.guard(10, 0, _signStepBlockProposal, hash1))
+	require.NoError(g.guard(10, 0, signStepOfTopic(PROPOSAL), hash1))
+	// signing the same block again is allowed
+	require.NoError(g.guard(10, 0, signStepOfTopic(PROPOSAL), hash1))
+	// conflicting block at the same step
+	require.ErrorIs(g.guard(10, 0, signStepOfTopic(PROPOSAL), hash2), ErrDoubleSign)
+	require.NoError(g.guard(10, 0, signStepOfTopic(LOCK), hash1))
+	// going back to an earlier step, round or height
+	require.ErrorIs(g.guard(10, 0, signStepOfTopic(PROPOSAL), hash1), ErrDoubleSign)
+	require.NoError(g.guard(10, 1, signStepOfTopic(PROPOSAL), nil))
+	require.ErrorIs(g.guard(10, 0, commitStep, hash1), ErrDoubleSign)
+	require.NoError(g.guard(10, 1, commitStep, hash2))
+
+	// the last signature survives restart
+	g = newSignGuard(path)
+	require.NoError(g.load())
+	require.ErrorIs(g.guard(10, 1, commitStep, hash1), ErrDoubleSign)
+	require.ErrorIs(g.guard(9, 5, commitStep, hash2), ErrDoubleSign)
+	require.NoError(g.guard(10, 1, commitStep, hash2))
+	require.NoError(g.guard(11, 0, _signStepBlockProposal, hash1))
+	_, err := os.Stat(path + ".new")
+	require.True(os.IsNotExist(err))
+
+	// corrupted file fails to load
+	data, err := os.ReadFile(path)
+	require.NoError(err)
+	data[0]++
+	require.NoError(os.WriteFile(path, data, 0600))
+	require.ErrorIs(newSignGuard(path).load(), ErrCorruptedSignGuard)
+	require.NoError(os.WriteFile(path, data[:10], 0600))
+	require.ErrorIs(newSignGuard(path).load(), ErrCorruptedSignGuard)
+
+	// in-memory guard
+	g = newSignGuard("")
+	require.NoError(g.load())
+	require.NoError(g.guard(1, 0, commitStep, hash1))
+	require.ErrorIs(g.guard(1, 0, commitStep, hash2), ErrDoubleSign)
+}
@@ -5,6 +5,8 @@ import "github.com/pkg/errors"
 var (
 	// ErrEmptyDBPath is the error when db path is empty
 	ErrEmptyDBPath = errors.New("empty db path")
+	// ErrUnknownBackend is the error when db backend is not supported
+	ErrUnknownBackend = errors.New("unknown db backend")
 )
 
 // CreateKVStore creates db from config and db path
@@ -14,7 +16,14 @@ func CreateKVStore(cfg Config, dbPath string) (KVStore, error) {
 	}
 	cfg.DbPath = dbPath
 
-	return NewBoltDB(cfg), nil
+	switch cfg.Backend {
+	case "", BackendBolt:
+		return NewBoltDB(cfg), nil
+	case BackendPebble:
+		return NewPebbleDB(cfg), nil
+	default:
+		return nil, errors.Wrapf(ErrUnknownBackend, "backend = %s", cfg.Backend)
+	}
 }
 
 // CreateKVStoreWithCache creates db with cache from config and db path, cacheSize
@@ -5,6 +5,13 @@
 
 package db
 
+const (
+	// BackendBolt is the bolt DB backend
+	BackendBolt = "bolt"
+	// BackendPebble is the pebble DB backend
+	BackendPebble = "pebble"
+)
+
 // Config is the config for database
 type Config struct {
 	DbPath string `yaml:"dbPath"`
@@ -18,16 +25,25 @@ type Config struct {
 	V2BlocksToSplitDB uint64 `yaml:"v2BlocksToSplitDB"`
 	// Compressor is the compression used on block data, used by new DB file after v1.1.2
 	Compressor string `yaml:"compressor"`
+	// ZstdLevel is the compression level of new DB file when Compressor is Zstd
+	ZstdLevel int `yaml:"zstdLevel"`
+	// ZstdDictionary is the path of the trained zstd dictionary, which is stored in new DB file when Compressor is Zstd
+	ZstdDictionary string `yaml:"zstdDictionary"`
 	// CompressLegacy enables gzip compression on block data, used by legacy DB file before v1.1.2
 	CompressLegacy bool `yaml:"compressLegacy"`
 	// SplitDBSize is the config for DB's split file size
 	SplitDBSizeMB uint64 `yaml:"splitDBSizeMB"`
 	// SplitDBHeight is the config for DB's split start height
 	SplitDBHeight uint64 `yaml:"splitDBHeight"`
+	// BlockHistoryRetention is the number of recent blocks retained in chain db, the v2 files whose blocks are all
+	// below are deleted, along with their index. 0 means all blocks are retained
+	BlockHistoryRetention uint64 `yaml:"blockHistoryRetention"`
 	// HistoryStateRetention is the number of blocks account/contract state will be retained
 	HistoryStateRetention uint64 `yaml:"historyStateRetention"`
 	// ReadOnly is set db to be opened in read only mode
 	ReadOnly bool `yaml:"readOnly"`
+	// Backend is the storage engine of KVStore created by CreateKVStore, bolt or pebble
+	Backend string `yaml:"backend"`
 }
 
 // SplitDBSize returns the configured SplitDBSizeMB
@@ -42,8 +58,12 @@ var DefaultConfig = Config{
 	BlockStoreBatchSize:   16,
 	V2BlocksToSplitDB:     1000000,
 	Compressor:            "Snappy",
+	ZstdLevel:             3,
+	ZstdDictionary:        "",
 	CompressLegacy:        false,
 	SplitDBSizeMB:         0,
 	SplitDBHeight:         900000,
+	BlockHistoryRetention: 0,
 	HistoryStateRetention: 2000,
+	Backend:               BackendBolt,
 }
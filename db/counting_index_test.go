@@ -157,6 +157,7 @@ func TestCountingIndex(t *testing.T) {
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		newTestPebbleDB(t),
 	} {
 		t.Run("test counting index", func(t *testing.T) {
 			testFunc(v, t)
@@ -382,6 +382,45 @@ func (b *BoltDB) WriteBatch(kvsb batch.KVStoreBatch) (err error) {
 	return err
 }
 
+// Buckets returns the names of all buckets in ascending order
+func (b *BoltDB) Buckets() ([]string, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+
+	var names []string
+	if err := b.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			names = append(names, string(name))
+			return nil
+		})
+	}); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return names, nil
+}
+
+// Iterate calls fn on records of a bucket with key >= minKey in ascending order of key, until fn returns false
+func (b *BoltDB) Iterate(namespace string, minKey []byte, fn func(k, v []byte) bool) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+
+	return b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(namespace))
+		if bucket == nil {
+			return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", []byte(namespace))
+		}
+		c := bucket.Cursor()
+		for k, v := c.Seek(minKey); k != nil; k, v = c.Next() {
+			if !fn(k, v) {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
 // BucketExists returns true if bucket exists
 func (b *BoltDB) BucketExists(namespace string) bool {
 	if !b.IsReady() {
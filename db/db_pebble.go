@@ -0,0 +1,604 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"syscall"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+const (
+	// key of a bucket is _pebbleBucketPrefix + namespace, it marks the existence of the namespace
+	_pebbleBucketPrefix byte = iota
+	// key of a record is _pebbleRecordPrefix + uvarint(len(namespace)) + namespace + key
+	_pebbleRecordPrefix
+)
+
+type (
+	// PebbleDB is KVStore implementation based on pebble DB
+	PebbleDB struct {
+		lifecycle.Readiness
+		// mutex serializes the read-modify-write operations used by range index
+		mutex  sync.Mutex
+		db     *pebble.DB
+		path   string
+		config Config
+	}
+
+	pebbleLogger struct{}
+)
+
+// NewPebbleDB instantiates a PebbleDB which implements KVStore
+func NewPebbleDB(cfg Config) *PebbleDB {
+	return &PebbleDB{
+		db:     nil,
+		path:   cfg.DbPath,
+		config: cfg,
+	}
+}
+
+// Start opens the PebbleDB (creates new directory if not existing yet)
+func (b *PebbleDB) Start(_ context.Context) error {
+	db, err := pebble.Open(b.path, &pebble.Options{
+		ReadOnly: b.config.ReadOnly,
+		Logger:   pebbleLogger{},
+	})
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	b.db = db
+	return b.TurnOn()
+}
+
+// Stop closes the PebbleDB
+func (b *PebbleDB) Stop(_ context.Context) error {
+	if err := b.TurnOff(); err != nil {
+		return err
+	}
+	if err := b.db.Close(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// Put inserts a <key, value> record
+func (b *PebbleDB) Put(namespace string, key, value []byte) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+
+	wb := b.db.NewBatch()
+	defer wb.Close()
+	if err := putPebbleRecord(wb, []byte(namespace), key, value); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return b.commit(wb, "Failed to put db.")
+}
+
+// Get retrieves a record
+func (b *PebbleDB) Get(namespace string, key []byte) ([]byte, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+
+	v, closer, err := b.db.Get(pebbleRecordKey([]byte(namespace), key))
+	if err == pebble.ErrNotFound {
+		return nil, errors.Wrapf(ErrNotExist, "key = %x doesn't exist", key)
+	}
+	if err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	defer closer.Close()
+	value := make([]byte, len(v))
+	copy(value, v)
+	return value, nil
+}
+
+// Filter returns <k, v> pair in a bucket that meet the condition
+func (b *PebbleDB) Filter(namespace string, cond Condition, minKey, maxKey []byte) ([][]byte, [][]byte, error) {
+	if !b.IsReady() {
+		return nil, nil, ErrDBNotStarted
+	}
+	if !b.BucketExists(namespace) {
+		return nil, nil, errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", []byte(namespace))
+	}
+
+	prefix := pebbleRecordKey([]byte(namespace), nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		fk, fv   [][]byte
+		checkMax = len(maxKey) > 0
+	)
+	for valid := iter.SeekGE(append(prefix, minKey...)); valid; valid = iter.Next() {
+		k, v := iter.Key()[len(prefix):], iter.Value()
+		if checkMax && bytes.Compare(k, maxKey) == 1 {
+			break
+		}
+		if cond(k, v) {
+			key := make([]byte, len(k))
+			copy(key, k)
+			value := make([]byte, len(v))
+			copy(value, v)
+			fk = append(fk, key)
+			fv = append(fv, value)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, errors.Wrap(ErrIO, err.Error())
+	}
+
+	if len(fk) == 0 {
+		return nil, nil, errors.Wrap(ErrNotExist, "filter returns no match")
+	}
+	return fk, fv, nil
+}
+
+// Range retrieves values for a range of keys
+func (b *PebbleDB) Range(namespace string, key []byte, count uint64) ([][]byte, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+
+	prefix := pebbleRecordKey([]byte(namespace), nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	value := make([][]byte, count)
+	valid := iter.SeekGE(append(prefix, key...))
+	if !valid {
+		return nil, errors.Wrapf(ErrNotExist, "entry for key 0x%x doesn't exist", key)
+	}
+	// retrieve 'count' items
+	for i := uint64(0); i < count; i++ {
+		if !valid {
+			return nil, errors.Wrapf(ErrNotExist, "entry for key 0x%x doesn't exist", key)
+		}
+		value[i] = make([]byte, len(iter.Value()))
+		copy(value[i], iter.Value())
+		valid = iter.Next()
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return value, nil
+}
+
+// GetBucketByPrefix retrieves all bucket those with const namespace prefix
+func (b *PebbleDB) GetBucketByPrefix(namespace []byte) ([][]byte, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+
+	prefix := pebbleBucketKey(namespace)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	allKey := make([][]byte, 0)
+	for valid := iter.First(); valid; valid = iter.Next() {
+		name := iter.Key()[1:]
+		if bytes.Equal(name, namespace) {
+			continue
+		}
+		temp := make([]byte, len(name))
+		copy(temp, name)
+		allKey = append(allKey, temp)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return allKey, nil
+}
+
+// GetKeyByPrefix retrieves all keys those with const prefix
+func (b *PebbleDB) GetKeyByPrefix(namespace, prefix []byte) ([][]byte, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+	if !b.BucketExists(string(namespace)) {
+		return nil, ErrNotExist
+	}
+
+	nsPrefix := pebbleRecordKey(namespace, nil)
+	iter, err := b.newIter(pebbleRecordKey(namespace, prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	allKey := make([][]byte, 0)
+	for valid := iter.First(); valid; valid = iter.Next() {
+		k := iter.Key()[len(nsPrefix):]
+		temp := make([]byte, len(k))
+		copy(temp, k)
+		allKey = append(allKey, temp)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return allKey, nil
+}
+
+// Delete deletes a record, if key is nil, this will delete the whole bucket
+func (b *PebbleDB) Delete(namespace string, key []byte) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+
+	wb := b.db.NewBatch()
+	defer wb.Close()
+	ns := []byte(namespace)
+	if key == nil {
+		prefix := pebbleRecordKey(ns, nil)
+		if err := wb.DeleteRange(prefix, pebbleUpperBound(prefix), nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+		if err := wb.Delete(pebbleBucketKey(ns), nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	} else if err := wb.Delete(pebbleRecordKey(ns, key), nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return b.commit(wb, "Failed to delete db.")
+}
+
+// WriteBatch commits a batch
+func (b *PebbleDB) WriteBatch(kvsb batch.KVStoreBatch) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+
+	kvsb.Lock()
+	defer kvsb.Unlock()
+
+	wb := b.db.NewBatch()
+	defer wb.Close()
+	// writes are applied in the order of the original batch, so the last write of a key takes effect
+	for i := 0; i < kvsb.Size(); i++ {
+		write, e := kvsb.Entry(i)
+		if e != nil {
+			return e
+		}
+		ns := []byte(write.Namespace())
+		switch write.WriteType() {
+		case batch.Put:
+			if e := putPebbleRecord(wb, ns, write.Key(), write.Value()); e != nil {
+				return errors.Wrap(ErrIO, errors.Wrap(e, write.Error()).Error())
+			}
+		case batch.Delete:
+			if e := wb.Delete(pebbleRecordKey(ns, write.Key()), nil); e != nil {
+				return errors.Wrap(ErrIO, errors.Wrap(e, write.Error()).Error())
+			}
+		}
+	}
+	return b.commit(wb, "Failed to write batch db.")
+}
+
+// Buckets returns the names of all buckets in ascending order
+func (b *PebbleDB) Buckets() ([]string, error) {
+	names, err := b.GetBucketByPrefix(nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, 0, len(names))
+	for _, name := range names {
+		ret = append(ret, string(name))
+	}
+	return ret, nil
+}
+
+// Iterate calls fn on records of a bucket with key >= minKey in ascending order of key, until fn returns false
+func (b *PebbleDB) Iterate(namespace string, minKey []byte, fn func(k, v []byte) bool) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+	if !b.BucketExists(namespace) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", []byte(namespace))
+	}
+
+	prefix := pebbleRecordKey([]byte(namespace), nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for valid := iter.SeekGE(append(prefix, minKey...)); valid; valid = iter.Next() {
+		if !fn(iter.Key()[len(prefix):], iter.Value()) {
+			break
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// BucketExists returns true if bucket exists
+func (b *PebbleDB) BucketExists(namespace string) bool {
+	if !b.IsReady() {
+		log.L().Debug(ErrDBNotStarted.Error())
+		return false
+	}
+
+	_, closer, err := b.db.Get(pebbleBucketKey([]byte(namespace)))
+	if err != nil {
+		return false
+	}
+	closer.Close()
+	return true
+}
+
+// ======================================
+// below functions used by RangeIndex
+// ======================================
+
+// Insert inserts a value into the index
+func (b *PebbleDB) Insert(name []byte, key uint64, value []byte) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+	if !b.BucketExists(string(name)) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	prefix := pebbleRecordKey(name, nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	wb := b.db.NewBatch()
+	defer wb.Close()
+	ak := byteutil.Uint64ToBytesBigEndian(key - 1)
+	valid := iter.SeekGE(append(prefix, ak...))
+	if !valid || !bytes.Equal(iter.Key()[len(prefix):], ak) {
+		// insert new key
+		var v []byte
+		if valid {
+			v = iter.Value()
+		}
+		if err := wb.Set(pebbleRecordKey(name, ak), v, nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	} else {
+		// update an existing key
+		valid = iter.Next()
+	}
+	if valid {
+		if err := wb.Set(append([]byte{}, iter.Key()...), value, nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return b.commit(wb, "Failed to insert db.")
+}
+
+// SeekNext returns value by the key (if key not exist, use next key)
+func (b *PebbleDB) SeekNext(name []byte, key uint64) ([]byte, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+	if !b.BucketExists(string(name)) {
+		return nil, errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+
+	prefix := pebbleRecordKey(name, nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	value := []byte{}
+	if iter.SeekGE(append(prefix, byteutil.Uint64ToBytesBigEndian(key)...)) {
+		value = make([]byte, len(iter.Value()))
+		copy(value, iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return value, nil
+}
+
+// SeekPrev returns value by the key (if key not exist, use previous key)
+func (b *PebbleDB) SeekPrev(name []byte, key uint64) ([]byte, error) {
+	if !b.IsReady() {
+		return nil, ErrDBNotStarted
+	}
+	if !b.BucketExists(string(name)) {
+		return nil, errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+
+	prefix := pebbleRecordKey(name, nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	value := []byte{}
+	if iter.SeekLT(append(prefix, byteutil.Uint64ToBytesBigEndian(key)...)) {
+		value = make([]byte, len(iter.Value()))
+		copy(value, iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return value, nil
+}
+
+// Remove removes an existing key
+func (b *PebbleDB) Remove(name []byte, key uint64) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+	if !b.BucketExists(string(name)) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	prefix := pebbleRecordKey(name, nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	ak := byteutil.Uint64ToBytesBigEndian(key - 1)
+	if !iter.SeekGE(append(prefix, ak...)) || !bytes.Equal(iter.Key()[len(prefix):], ak) {
+		// return nil if the key does not exist
+		return iter.Error()
+	}
+	wb := b.db.NewBatch()
+	defer wb.Close()
+	v := append([]byte{}, iter.Value()...)
+	if err := wb.Delete(pebbleRecordKey(name, ak), nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	// write the corresponding value to next key
+	if iter.Next() {
+		if err := wb.Set(append([]byte{}, iter.Key()...), v, nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return b.commit(wb, "Failed to remove db.")
+}
+
+// Purge deletes an existing key and all keys before it
+func (b *PebbleDB) Purge(name []byte, key uint64) error {
+	if !b.IsReady() {
+		return ErrDBNotStarted
+	}
+	if !b.BucketExists(string(name)) {
+		return errors.Wrapf(ErrBucketNotExist, "bucket = %x doesn't exist", name)
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	prefix := pebbleRecordKey(name, nil)
+	iter, err := b.newIter(prefix)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	wb := b.db.NewBatch()
+	defer wb.Close()
+	nk := pebbleRecordKey(name, byteutil.Uint64ToBytesBigEndian(key))
+	// delete all keys before this key
+	if err := wb.DeleteRange(prefix, nk, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	// write not exist value to next key
+	if iter.SeekGE(nk) {
+		if err := wb.Set(append([]byte{}, iter.Key()...), NotExist, nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return b.commit(wb, "Failed to purge db.")
+}
+
+// ======================================
+// private functions
+// ======================================
+
+func (b *PebbleDB) newIter(prefix []byte) (*pebble.Iterator, error) {
+	iter, err := b.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: pebbleUpperBound(prefix),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return iter, nil
+}
+
+func (b *PebbleDB) commit(wb *pebble.Batch, msg string) error {
+	err := wb.Commit(pebble.Sync)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, syscall.ENOSPC) {
+		log.L().Fatal(msg, zap.Error(err))
+	}
+	return errors.Wrap(ErrIO, err.Error())
+}
+
+func putPebbleRecord(wb *pebble.Batch, ns, key, value []byte) error {
+	if err := wb.Set(pebbleBucketKey(ns), nil, nil); err != nil {
+		return err
+	}
+	return wb.Set(pebbleRecordKey(ns, key), value, nil)
+}
+
+func pebbleBucketKey(ns []byte) []byte {
+	return append([]byte{_pebbleBucketPrefix}, ns...)
+}
+
+func pebbleRecordKey(ns, key []byte) []byte {
+	k := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(ns)+len(key))
+	k[0] = _pebbleRecordPrefix
+	n := binary.PutUvarint(k[1:], uint64(len(ns)))
+	k = append(k[:1+n], ns...)
+	return append(k, key...)
+}
+
+// pebbleUpperBound returns the smallest key greater than all keys with the prefix, nil if there's no such key
+func pebbleUpperBound(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+func (pebbleLogger) Infof(format string, args ...interface{}) {
+	log.L().Debug(fmt.Sprintf(format, args...))
+}
+
+func (pebbleLogger) Fatalf(format string, args ...interface{}) {
+	log.L().Fatal(fmt.Sprintf(format, args...))
+}
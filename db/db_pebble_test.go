@@ -0,0 +1,111 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/db/batch"
+)
+
+func newTestPebbleDB(t testing.TB) *PebbleDB {
+	cfg := DefaultConfig
+	cfg.DbPath = t.TempDir()
+	cfg.Backend = BackendPebble
+	return NewPebbleDB(cfg)
+}
+
+func TestPebbleDB_NilDB_DoesNotPanic(t *testing.T) {
+	r := require.New(t)
+	kv := newTestPebbleDB(t)
+
+	r.False(kv.BucketExists("namespace"))
+	_, _, err := kv.Filter("namespace", func(k, v []byte) bool {
+		return true
+	}, nil, nil)
+	r.Equal(ErrDBNotStarted, err)
+	_, err = kv.Get("namespace", []byte("test"))
+	r.Equal(ErrDBNotStarted, err)
+	_, err = kv.Range("namespace", []byte("key"), 1)
+	r.Equal(ErrDBNotStarted, err)
+	_, err = kv.GetBucketByPrefix([]byte("namespace"))
+	r.Equal(ErrDBNotStarted, err)
+	_, err = kv.GetKeyByPrefix([]byte("namespace"), []byte("prefix"))
+	r.Equal(ErrDBNotStarted, err)
+	r.Equal(ErrDBNotStarted, kv.Put("test", []byte("key"), []byte("value")))
+	r.Equal(ErrDBNotStarted, kv.Delete("test", []byte("key")))
+	r.Equal(ErrDBNotStarted, kv.WriteBatch(batch.NewBatch()))
+	r.Equal(ErrDBNotStarted, kv.Insert([]byte("name"), 123, []byte("value")))
+	r.Equal(ErrDBNotStarted, kv.Remove([]byte("name"), 123))
+	r.Equal(ErrDBNotStarted, kv.Purge([]byte("name"), 123))
+	_, err = kv.SeekNext([]byte("name"), 12)
+	r.Equal(ErrDBNotStarted, err)
+	_, err = kv.SeekPrev([]byte("name"), 12)
+	r.Equal(ErrDBNotStarted, err)
+}
+
+func TestPebbleDB(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	kv := newTestPebbleDB(t)
+	r.NoError(kv.Start(ctx))
+
+	// namespaces sharing a prefix do not overlap
+	r.NoError(kv.Put("ns", []byte("1key"), []byte("v1")))
+	r.NoError(kv.Put("ns1", []byte("key"), []byte("v2")))
+	r.NoError(kv.Put("ns12", []byte("key"), []byte("v3")))
+	v, err := kv.Get("ns", []byte("1key"))
+	r.NoError(err)
+	r.Equal([]byte("v1"), v)
+	_, err = kv.Get("ns1", []byte("1key"))
+	r.Equal(ErrNotExist, errors.Cause(err))
+	r.True(kv.BucketExists("ns1"))
+	r.False(kv.BucketExists("ns2"))
+	buckets, err := kv.GetBucketByPrefix([]byte("ns"))
+	r.NoError(err)
+	r.Equal([][]byte{[]byte("ns1"), []byte("ns12")}, buckets)
+	fk, _, err := kv.Filter("ns1", func(k, v []byte) bool { return true }, nil, nil)
+	r.NoError(err)
+	r.Equal([][]byte{[]byte("key")}, fk)
+
+	// range and keys by prefix
+	b := batch.NewBatch()
+	for _, k := range []string{"a1", "a2", "a3", "b1"} {
+		b.Put("range", []byte(k), []byte("v"+k), "")
+	}
+	b.Delete("range", []byte("a3"), "")
+	r.NoError(kv.WriteBatch(b))
+	values, err := kv.Range("range", []byte("a2"), 2)
+	r.NoError(err)
+	r.Equal([][]byte{[]byte("va2"), []byte("vb1")}, values)
+	_, err = kv.Range("range", []byte("a2"), 3)
+	r.Equal(ErrNotExist, errors.Cause(err))
+	_, err = kv.Range("range", []byte("c"), 1)
+	r.Equal(ErrNotExist, errors.Cause(err))
+	keys, err := kv.GetKeyByPrefix([]byte("range"), []byte("a"))
+	r.NoError(err)
+	r.Equal([][]byte{[]byte("a1"), []byte("a2")}, keys)
+	_, err = kv.GetKeyByPrefix([]byte("norange"), []byte("a"))
+	r.Equal(ErrNotExist, errors.Cause(err))
+
+	// data persists after restart
+	r.NoError(kv.Stop(ctx))
+	r.NoError(kv.Start(ctx))
+	defer func() {
+		r.NoError(kv.Stop(ctx))
+	}()
+	v, err = kv.Get("range", []byte("b1"))
+	r.NoError(err)
+	r.Equal([]byte("vb1"), v)
+	r.NoError(kv.Delete("range", nil))
+	r.False(kv.BucketExists("range"))
+	_, err = kv.Get("range", []byte("b1"))
+	r.Equal(ErrNotExist, errors.Cause(err))
+}
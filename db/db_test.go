@@ -63,6 +63,7 @@ func TestKVStorePutGet(t *testing.T) {
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		newTestPebbleDB(t),
 	} {
 		t.Run("test put get", func(t *testing.T) {
 			testKVStorePutGet(v, t)
@@ -243,6 +244,7 @@ func TestDBBatch(t *testing.T) {
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		newTestPebbleDB(t),
 	} {
 		t.Run("test batch", func(t *testing.T) {
 			testBatchRollback(v, t)
@@ -295,6 +297,7 @@ func TestCacheKV(t *testing.T) {
 	for _, v := range []KVStore{
 		NewMemKVStore(),
 		NewBoltDB(cfg),
+		newTestPebbleDB(t),
 	} {
 		t.Run("test cache kv", func(t *testing.T) {
 			testFunc(v, t)
@@ -337,9 +340,14 @@ func TestDeleteBucket(t *testing.T) {
 	cfg := DefaultConfig
 	cfg.DbPath = testPath
 
-	t.Run("test delete bucket", func(t *testing.T) {
-		testFunc(NewBoltDB(cfg), t)
-	})
+	for _, v := range []KVStore{
+		NewBoltDB(cfg),
+		newTestPebbleDB(t),
+	} {
+		t.Run("test delete bucket", func(t *testing.T) {
+			testFunc(v, t)
+		})
+	}
 }
 
 func TestFilter(t *testing.T) {
@@ -438,9 +446,14 @@ func TestFilter(t *testing.T) {
 	cfg := DefaultConfig
 	cfg.DbPath = testPath
 
-	t.Run("test filter", func(t *testing.T) {
-		testFunc(NewBoltDB(cfg), t)
-	})
+	for _, v := range []KVStore{
+		NewBoltDB(cfg),
+		newTestPebbleDB(t),
+	} {
+		t.Run("test filter", func(t *testing.T) {
+			testFunc(v, t)
+		})
+	}
 }
 
 func TestCreateKVStore(t *testing.T) {
@@ -463,4 +476,71 @@ func TestCreateKVStore(t *testing.T) {
 	d, err = CreateKVStoreWithCache(cfg, testPath, 5)
 	require.NoError(err)
 	require.NotNil(d)
+
+	cfg.Backend = BackendPebble
+	d, err = CreateKVStore(cfg, t.TempDir())
+	require.NoError(err)
+	require.IsType(&PebbleDB{}, d)
+
+	cfg.Backend = "leveldb"
+	d, err = CreateKVStore(cfg, testPath)
+	require.ErrorIs(err, ErrUnknownBackend)
+	require.Nil(d)
+}
+
+func TestIterate(t *testing.T) {
+	testFunc := func(kv KVStoreWithIterator, t *testing.T) {
+		require := require.New(t)
+		require.NoError(kv.Start(context.Background()))
+		defer func() {
+			require.NoError(kv.Stop(context.Background()))
+		}()
+
+		b := batch.NewBatch()
+		for i := range _testK1 {
+			b.Put(_bucket2, _testK2[i], _testV2[i], "")
+			b.Put(_bucket1, _testK1[i], _testV1[i], "")
+		}
+		require.NoError(kv.WriteBatch(b))
+		buckets, err := kv.Buckets()
+		require.NoError(err)
+		require.Equal([]string{_bucket1, _bucket2}, buckets)
+
+		var keys, values [][]byte
+		require.NoError(kv.Iterate(_bucket1, nil, func(k, v []byte) bool {
+			keys = append(keys, append([]byte{}, k...))
+			values = append(values, append([]byte{}, v...))
+			return true
+		}))
+		require.Equal(_testK1[:], keys)
+		require.Equal(_testV1[:], values)
+
+		// iterate from a key and stop early
+		keys = nil
+		require.NoError(kv.Iterate(_bucket2, _testK2[1], func(k, v []byte) bool {
+			keys = append(keys, append([]byte{}, k...))
+			return false
+		}))
+		require.Equal([][]byte{_testK2[1]}, keys)
+
+		require.Equal(ErrBucketNotExist, errors.Cause(kv.Iterate("nonamespace", nil, func(k, v []byte) bool {
+			return true
+		})))
+	}
+
+	path := "test-iterate.bolt"
+	testPath, err := testutil.PathOfTempFile(path)
+	require.NoError(t, err)
+	defer testutil.CleanupPath(testPath)
+	cfg := DefaultConfig
+	cfg.DbPath = testPath
+
+	for _, v := range []KVStoreWithIterator{
+		NewBoltDB(cfg),
+		newTestPebbleDB(t),
+	} {
+		t.Run("test iterate", func(t *testing.T) {
+			testFunc(v, t)
+		})
+	}
 }
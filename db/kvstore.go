@@ -43,6 +43,16 @@ type (
 		Range(string, []byte, uint64) ([][]byte, error)
 	}
 
+	// KVStoreWithIterator is KVStore with API to walk through all records
+	KVStoreWithIterator interface {
+		KVStore
+		// Buckets returns the names of all buckets in ascending order
+		Buckets() ([]string, error)
+		// Iterate calls the func on records of a bucket with key >= minKey in ascending order of key, until the func
+		// returns false, the key and value passed to the func are only valid during the call
+		Iterate(string, []byte, func([]byte, []byte) bool) error
+	}
+
 	// KVStoreForRangeIndex is KVStore for range index
 	KVStoreForRangeIndex interface {
 		KVStore
@@ -0,0 +1,151 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/triepb"
+)
+
+// ErrInvalidProof is an error when a proof doesn't match the root hash or the key
+var ErrInvalidProof = errors.New("invalid proof")
+
+// Proof returns the serialized nodes on the path from the root to the key, which proves the key exists in the trie
+// if the last node is a leaf of the key, otherwise it proves the key doesn't exist
+func (mpt *merklePatriciaTrie) Proof(key []byte) ([][]byte, error) {
+	mpt.mutex.RLock()
+	defer mpt.mutex.RUnlock()
+
+	kt, err := mpt.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		proof  [][]byte
+		n      node = mpt.root
+		offset uint8
+	)
+	for {
+		if hn, ok := n.(*hashNode); ok {
+			if n, err = hn.LoadNode(mpt); err != nil {
+				return nil, err
+			}
+		}
+		ser, err := mpt.serialize(n)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, ser)
+		switch nd := n.(type) {
+		case *branchNode:
+			child, err := nd.child(kt[offset])
+			if errors.Cause(err) == trie.ErrNotExist {
+				return proof, nil
+			}
+			n = child
+			offset++
+		case *extensionNode:
+			if nd.commonPrefixLength(kt[offset:]) != uint8(len(nd.path)) {
+				return proof, nil
+			}
+			n = nd.child
+			offset += uint8(len(nd.path))
+		case *leafNode:
+			return proof, nil
+		default:
+			return nil, errors.Wrapf(trie.ErrInvalidTrie, "unexpected node type %T", n)
+		}
+	}
+}
+
+func (mpt *merklePatriciaTrie) serialize(n node) ([]byte, error) {
+	sn, ok := n.(serializable)
+	if !ok {
+		return nil, errors.Wrapf(trie.ErrInvalidTrie, "node %T is not serializable", n)
+	}
+	pb, err := sn.proto(mpt, false)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// VerifyProof verifies the proof of the key against the root hash of a trie built with hashFunc, and returns the
+// value of the key, trie.ErrNotExist is returned if the proof proves the key doesn't exist in the trie
+func VerifyProof(hashFunc HashFunc, rootHash, key []byte, proof [][]byte) ([]byte, error) {
+	value, n, err := verifyProof(hashFunc, rootHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(proof) {
+		return nil, errors.Wrapf(ErrInvalidProof, "%d redundant nodes", len(proof)-n)
+	}
+	return value, nil
+}
+
+// VerifyTwoLayerProof verifies the proof generated by a two layer trie against its root hash, and returns the value
+// of the layer two key, trie.ErrNotExist is returned if the proof proves the key doesn't exist in the trie
+func VerifyTwoLayerProof(rootHash, layerOneKey, layerTwoKey []byte, proof [][]byte) ([]byte, error) {
+	layerTwoRoot, n, err := verifyProof(DefaultHashFunc, rootHash, layerOneKey, proof)
+	if err != nil {
+		return nil, err
+	}
+	return VerifyProof(DefaultHashFunc, layerTwoRoot, layerTwoKey, proof[n:])
+}
+
+// verifyProof walks the proof from the root to the key, and returns the value and the number of nodes walked through
+func verifyProof(hashFunc HashFunc, rootHash, key []byte, proof [][]byte) ([]byte, int, error) {
+	var (
+		expected = rootHash
+		offset   int
+	)
+	for i, ser := range proof {
+		if !bytes.Equal(hashFunc(ser), expected) {
+			return nil, 0, errors.Wrapf(ErrInvalidProof, "hash of node %d mismatches", i)
+		}
+		pb := triepb.NodePb{}
+		if err := proto.Unmarshal(ser, &pb); err != nil {
+			return nil, 0, errors.Wrapf(ErrInvalidProof, "failed to deserialize node %d: %v", i, err)
+		}
+		switch {
+		case pb.GetBranch() != nil:
+			if offset >= len(key) {
+				return nil, 0, errors.Wrapf(ErrInvalidProof, "branch node %d is beyond the key", i)
+			}
+			expected = nil
+			for _, b := range pb.GetBranch().Branches {
+				if b.Index == uint32(key[offset]) {
+					expected = b.Path
+					break
+				}
+			}
+			if expected == nil {
+				return nil, i + 1, trie.ErrNotExist
+			}
+			offset++
+		case pb.GetExtend() != nil:
+			ext := pb.GetExtend()
+			if offset > len(key) || !bytes.HasPrefix(key[offset:], ext.Path) {
+				return nil, i + 1, trie.ErrNotExist
+			}
+			expected = ext.Value
+			offset += len(ext.Path)
+		case pb.GetLeaf() != nil:
+			if !bytes.Equal(pb.GetLeaf().Path, key) {
+				return nil, i + 1, trie.ErrNotExist
+			}
+			return pb.GetLeaf().Value, i + 1, nil
+		default:
+			return nil, 0, errors.Wrapf(ErrInvalidProof, "invalid type of node %d", i)
+		}
+	}
+	return nil, 0, errors.Wrap(ErrInvalidProof, "proof is incomplete")
+}
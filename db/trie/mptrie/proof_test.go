@@ -0,0 +1,136 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package mptrie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/db/trie"
+)
+
+func TestProof(t *testing.T) {
+	keys := [][]byte{ham, car, cat, dog, egg, fox, cow, ant}
+	for _, async := range []bool{false, true} {
+		require := require.New(t)
+		trieDB := trie.NewMemKVStore()
+		opts := []Option{KVStoreOption(trieDB), KeyLengthOption(8)}
+		if async {
+			opts = append(opts, AsyncOption())
+		}
+		tr, err := New(opts...)
+		require.NoError(err)
+		require.NoError(tr.Start(context.Background()))
+
+		// proof of empty trie
+		root, err := tr.RootHash()
+		require.NoError(err)
+		proof, err := tr.Proof(cat)
+		require.NoError(err)
+		require.Len(proof, 1)
+		_, err = VerifyProof(DefaultHashFunc, root, cat, proof)
+		require.Equal(trie.ErrNotExist, errors.Cause(err))
+
+		for i, k := range keys {
+			require.NoError(tr.Upsert(k, testV[i]))
+		}
+		root, err = tr.RootHash()
+		require.NoError(err)
+		verify := func(tr trie.Trie) {
+			for i, k := range keys {
+				proof, err := tr.Proof(k)
+				require.NoError(err)
+				v, err := VerifyProof(DefaultHashFunc, root, k, proof)
+				require.NoError(err)
+				require.Equal(testV[i], v)
+			}
+			// proof of absence
+			for _, k := range [][]byte{rat, br1, cl2} {
+				proof, err := tr.Proof(k)
+				require.NoError(err)
+				_, err = VerifyProof(DefaultHashFunc, root, k, proof)
+				require.Equal(trie.ErrNotExist, errors.Cause(err))
+			}
+		}
+		verify(tr)
+
+		// proof of a trie loaded from db
+		tr2, err := New(KVStoreOption(trieDB), KeyLengthOption(8), RootHashOption(root))
+		require.NoError(err)
+		require.NoError(tr2.Start(context.Background()))
+		verify(tr2)
+
+		// invalid proofs
+		proof, err = tr.Proof(cat)
+		require.NoError(err)
+		_, err = VerifyProof(DefaultHashFunc, root, car, proof)
+		require.Error(err)
+		_, err = VerifyProof(DefaultHashFunc, root, cat, proof[:len(proof)-1])
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		_, err = VerifyProof(DefaultHashFunc, emptyTrieRootHash, cat, proof)
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		tampered := append([][]byte{}, proof...)
+		tampered[len(tampered)-1] = append([]byte{}, tampered[len(tampered)-1]...)
+		tampered[len(tampered)-1][len(tampered[len(tampered)-1])-1]++
+		_, err = VerifyProof(DefaultHashFunc, root, cat, tampered)
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		_, err = VerifyProof(DefaultHashFunc, root, cat, append(proof, proof[0]))
+		require.Equal(ErrInvalidProof, errors.Cause(err))
+		_, err = tr.Proof([]byte{1, 2, 3})
+		require.Error(err)
+		require.NoError(tr.Stop(context.Background()))
+	}
+}
+
+func TestTwoLayerTrieProof(t *testing.T) {
+	require := require.New(t)
+	var (
+		ns1 = []byte("layerOneKey111111111")
+		ns2 = []byte("layerOneKey222222222")
+		ns3 = []byte("layerOneKey333333333")
+	)
+	tlt := NewTwoLayerTrie(trie.NewMemKVStore(), "rootKey")
+	require.NoError(tlt.Start(context.Background()))
+	defer require.NoError(tlt.Stop(context.Background()))
+	require.NoError(tlt.Upsert(ns1, cat, testV[2]))
+	require.NoError(tlt.Upsert(ns1, car, testV[1]))
+	require.NoError(tlt.Upsert(ns2, dog, testV[3]))
+	root, err := tlt.RootHash()
+	require.NoError(err)
+
+	for _, e := range []struct {
+		ns, key, value []byte
+	}{
+		{ns1, cat, testV[2]},
+		{ns1, car, testV[1]},
+		{ns2, dog, testV[3]},
+	} {
+		proof, err := tlt.Proof(e.ns, e.key)
+		require.NoError(err)
+		v, err := VerifyTwoLayerProof(root, e.ns, e.key, proof)
+		require.NoError(err)
+		require.Equal(e.value, v)
+	}
+	for _, e := range []struct {
+		ns, key []byte
+	}{
+		{ns1, dog},
+		{ns2, cat},
+		{ns3, cat},
+	} {
+		proof, err := tlt.Proof(e.ns, e.key)
+		require.NoError(err)
+		_, err = VerifyTwoLayerProof(root, e.ns, e.key, proof)
+		require.Equal(trie.ErrNotExist, errors.Cause(err))
+	}
+	proof, err := tlt.Proof(ns1, cat)
+	require.NoError(err)
+	_, err = VerifyTwoLayerProof(root, ns2, cat, proof)
+	require.Error(err)
+}
@@ -176,6 +176,30 @@ func (tlt *twoLayerTrie) Get(layerOneKey []byte, layerTwoKey []byte) ([]byte, er
 	return lt.tr.Get(layerTwoKey)
 }
 
+func (tlt *twoLayerTrie) Proof(layerOneKey []byte, layerTwoKey []byte) ([][]byte, error) {
+	proof, err := tlt.layerOne.Proof(layerOneKey)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tlt.layerOne.Get(layerOneKey); err != nil {
+		if errors.Cause(err) == trie.ErrNotExist {
+			// the proof of layer one proves the absence
+			return proof, nil
+		}
+		return nil, err
+	}
+	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
+	if err != nil {
+		return nil, err
+	}
+	layerTwoProof, err := lt.tr.Proof(layerTwoKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(proof, layerTwoProof...), nil
+}
+
 func (tlt *twoLayerTrie) Upsert(layerOneKey []byte, layerTwoKey []byte, value []byte) error {
 	lt, err := tlt.layerTwoTrie(layerOneKey, len(layerTwoKey))
 	if err != nil {
@@ -48,6 +48,8 @@ type (
 		IsEmpty() bool
 		// Clone clones a trie with a new kvstore
 		Clone(KVStore) (Trie, error)
+		// Proof returns the merkle proof of an entry
+		Proof([]byte) ([][]byte, error)
 	}
 	// TwoLayerTrie is a trie data structure with two layers
 	TwoLayerTrie interface {
@@ -65,5 +67,8 @@ type (
 		Upsert([]byte, []byte, []byte) error
 		// Delete deletes an item in layer two
 		Delete([]byte, []byte) error
+		// Proof returns the merkle proof of an item in layer two, which is the proof in layer one followed by the
+		// proof in layer two
+		Proof([]byte, []byte) ([][]byte, error)
 	}
 )
@@ -663,6 +663,8 @@ func TestEnforceChainID(t *testing.T) {
 	cfg.Genesis.BlockGasLimit = uint64(100000)
 	cfg.Genesis.MidwayBlockHeight = 3
 	cfg.Genesis.QuebecBlockHeight = 7
+	// rejected actions are resubmitted with the same nonce and a slightly higher gas price
+	cfg.ActPool.PriceBump = 0
 	registry := protocol.NewRegistry()
 	acc := account.NewProtocol(rewarding.DepositGas)
 	require.NoError(acc.Register(registry))
@@ -90,6 +90,7 @@ func TestBlockReward(t *testing.T) {
 	cfg.Consensus.RollDPoS.FSM.AcceptLockEndorsementTTL = 300 * time.Millisecond
 	cfg.Consensus.RollDPoS.FSM.CommitTTL = 100 * time.Millisecond
 	cfg.Consensus.RollDPoS.ConsensusDBPath = testConsensusPath
+	cfg.Consensus.RollDPoS.SignGuardPath = ""
 	cfg.Genesis.EnableGravityChainVoting = false
 	cfg.Chain.ProducerPrivKey = identityset.PrivateKey(0).HexString()
 	cfg.Chain.TrieDBPatchFile = ""
@@ -185,6 +186,7 @@ func TestBlockEpochReward(t *testing.T) {
 		cfg := newConfig(chainDBPath, trieDBPath, indexDBPath, contractIndexDBPath, identityset.PrivateKey(i),
 			networkPort, apiPort, uint64(numNodes))
 		cfg.Consensus.RollDPoS.ConsensusDBPath = consensusDBPath
+		cfg.Consensus.RollDPoS.SignGuardPath = ""
 		if i == 0 {
 			cfg.Network.BootstrapNodes = []string{}
 			cfg.Network.MasterKey = "bootnode"
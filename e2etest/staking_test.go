@@ -226,6 +226,7 @@ func TestStakingContract(t *testing.T) {
 	cfg.Chain.ContractStakingIndexDBPath = testContractStakeIndexPath
 	cfg.System.SystemLogDBPath = testSystemLogPath
 	cfg.Consensus.RollDPoS.ConsensusDBPath = testConsensusPath
+	cfg.Consensus.RollDPoS.SignGuardPath = ""
 	cfg.Chain.ProducerPrivKey = "a000000000000000000000000000000000000000000000000000000000000000"
 	cfg.Consensus.Scheme = config.RollDPoSScheme
 	cfg.Genesis.NumDelegates = 1
@@ -12,11 +12,14 @@ type Config struct {
 	SuggestBlockWindow int    `yaml:"suggestBlockWindow"`
 	DefaultGas         uint64 `yaml:"defaultGas"`
 	Percentile         int    `yaml:"Percentile"`
+	// FeeHistoryCacheSize is the number of blocks whose fee data is cached
+	FeeHistoryCacheSize int `yaml:"feeHistoryCacheSize"`
 }
 
 // DefaultConfig is the default config
 var DefaultConfig = Config{
-	SuggestBlockWindow: 20,
-	DefaultGas:         uint64(unit.Qev),
-	Percentile:         60,
+	SuggestBlockWindow:  20,
+	DefaultGas:          uint64(unit.Qev),
+	Percentile:          60,
+	FeeHistoryCacheSize: 1024,
 }
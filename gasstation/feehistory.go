@@ -0,0 +1,232 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package gasstation
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+)
+
+// _maxFeeHistoryBlocks is the max number of blocks can be queried by fee history
+const _maxFeeHistoryBlocks = 1024
+
+var (
+	// ErrInvalidPercentile indicates the reward percentile is invalid
+	ErrInvalidPercentile = errors.New("invalid reward percentile")
+)
+
+type (
+	// blockFee is the fee data of a block
+	blockFee struct {
+		baseFee      *big.Int // nil before Vanuatu
+		gasUsed      uint64
+		gasUsedRatio float64
+		tips         []txTip // sorted by tip in ascending order
+	}
+
+	// txTip is the effective tip paid by a non-system action
+	txTip struct {
+		tip *big.Int
+		gas uint64
+	}
+)
+
+// FeeHistory returns the base fee, gas used ratio and the reward percentiles of effective tips of the blocks
+// in [lastBlock-blocks+1, lastBlock], the base fee of the block after lastBlock is also returned
+func (gs *GasStation) FeeHistory(ctx context.Context, blocks, lastBlock uint64, rewardPercentiles []float64) (uint64, [][]*big.Int, []*big.Int, []float64, error) {
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return 0, nil, nil, nil, errors.Wrapf(ErrInvalidPercentile, "%f out of range [0, 100]", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return 0, nil, nil, nil, errors.Wrapf(ErrInvalidPercentile, "%f is lower than previous %f", p, rewardPercentiles[i-1])
+		}
+	}
+	if blocks > _maxFeeHistoryBlocks {
+		blocks = _maxFeeHistoryBlocks
+	}
+	if tip := gs.bc.TipHeight(); lastBlock > tip {
+		lastBlock = tip
+	}
+	// genesis block doesn't carry any fee
+	if blocks > lastBlock {
+		blocks = lastBlock
+	}
+	if blocks == 0 {
+		return lastBlock, nil, nil, nil, nil
+	}
+	var (
+		oldest   = lastBlock - blocks + 1
+		baseFees = make([]*big.Int, blocks+1)
+		ratios   = make([]float64, blocks)
+		rewards  [][]*big.Int
+		lastFee  *blockFee
+	)
+	if len(rewardPercentiles) > 0 {
+		rewards = make([][]*big.Int, blocks)
+	}
+	for i := uint64(0); i < blocks; i++ {
+		if err := ctx.Err(); err != nil {
+			return 0, nil, nil, nil, err
+		}
+		fee, err := gs.blockFee(oldest + i)
+		if err != nil {
+			return 0, nil, nil, nil, err
+		}
+		baseFees[i] = orZero(fee.baseFee)
+		ratios[i] = fee.gasUsedRatio
+		if rewards != nil {
+			rewards[i] = fee.rewards(rewardPercentiles)
+		}
+		lastFee = fee
+	}
+	g := gs.bc.Genesis()
+	baseFees[blocks] = orZero(protocol.CalcBaseFee(g.Blockchain, &protocol.TipInfo{
+		Height:  lastBlock,
+		GasUsed: lastFee.gasUsed,
+		BaseFee: lastFee.baseFee,
+	}))
+	return oldest, rewards, baseFees, ratios, nil
+}
+
+// SuggestGasTipCap suggests the tip (priority fee) per gas, the result is cached per tip height
+func (gs *GasStation) SuggestGasTipCap() (*big.Int, error) {
+	var (
+		tip = gs.bc.TipHeight()
+		g   = gs.bc.Genesis()
+	)
+	if !g.IsVanuatu(tip + 1) {
+		// without base fee, the whole gas price is paid as tip
+		price, err := gs.SuggestGasPrice()
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetUint64(price), nil
+	}
+	gs.mu.RLock()
+	if gs.tipCap != nil && gs.tipCapHeight == tip {
+		defer gs.mu.RUnlock()
+		return new(big.Int).Set(gs.tipCap), nil
+	}
+	gs.mu.RUnlock()
+
+	var (
+		smallestTips   []*big.Int
+		endBlockHeight uint64
+	)
+	if tip > uint64(gs.cfg.SuggestBlockWindow) {
+		endBlockHeight = tip - uint64(gs.cfg.SuggestBlockWindow)
+	}
+	for height := tip; height > endBlockHeight; height-- {
+		fee, err := gs.blockFee(height)
+		if err != nil {
+			return nil, err
+		}
+		if len(fee.tips) == 0 {
+			continue
+		}
+		smallestTips = append(smallestTips, fee.tips[0].tip)
+	}
+	tipCap := new(big.Int)
+	if len(smallestTips) > 0 {
+		sort.Slice(smallestTips, func(i, j int) bool {
+			return smallestTips[i].Cmp(smallestTips[j]) < 0
+		})
+		tipCap.Set(smallestTips[(len(smallestTips)-1)*gs.cfg.Percentile/100])
+	}
+
+	gs.mu.Lock()
+	gs.tipCapHeight, gs.tipCap = tip, tipCap
+	gs.mu.Unlock()
+	return new(big.Int).Set(tipCap), nil
+}
+
+// blockFee returns the fee data of the block at height, which is cached by the block hash, so that the data of a
+// block removed by a rewind is never returned for the new block at the same height
+func (gs *GasStation) blockFee(height uint64) (*blockFee, error) {
+	blkHash, err := gs.dao.GetBlockHash(height)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := gs.feeCache.Get(blkHash); ok {
+		return v.(*blockFee), nil
+	}
+	blk, err := gs.dao.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := gs.dao.GetReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(blk.Actions) {
+		return nil, errors.Errorf("number of receipts %d doesn't match number of actions %d at height %d", len(receipts), len(blk.Actions), height)
+	}
+	fee := &blockFee{
+		baseFee: blk.BaseFee(),
+	}
+	for i, act := range blk.Actions {
+		fee.gasUsed += receipts[i].GasConsumed
+		if action.IsSystemAction(act) {
+			continue
+		}
+		tip := act.EffectiveGasPrice(fee.baseFee)
+		if fee.baseFee != nil {
+			tip.Sub(tip, fee.baseFee)
+		}
+		fee.tips = append(fee.tips, txTip{tip: tip, gas: receipts[i].GasConsumed})
+	}
+	sort.SliceStable(fee.tips, func(i, j int) bool {
+		return fee.tips[i].tip.Cmp(fee.tips[j].tip) < 0
+	})
+	g := gs.bc.Genesis()
+	if gasLimit := g.BlockGasLimitByHeight(height); gasLimit > 0 {
+		fee.gasUsedRatio = float64(fee.gasUsed) / float64(gasLimit)
+	}
+	gs.feeCache.Add(blkHash, fee)
+	return fee, nil
+}
+
+// rewards returns the tips at the given percentiles, weighted by the gas used of each action
+func (fee *blockFee) rewards(percentiles []float64) []*big.Int {
+	rewards := make([]*big.Int, len(percentiles))
+	if len(fee.tips) == 0 {
+		for i := range rewards {
+			rewards[i] = new(big.Int)
+		}
+		return rewards
+	}
+	var totalGas uint64
+	for _, t := range fee.tips {
+		totalGas += t.gas
+	}
+	var (
+		txIndex    int
+		sumGasUsed = fee.tips[0].gas
+	)
+	for i, p := range percentiles {
+		threshold := uint64(float64(totalGas) * p / 100)
+		for sumGasUsed < threshold && txIndex < len(fee.tips)-1 {
+			txIndex++
+			sumGasUsed += fee.tips[txIndex].gas
+		}
+		rewards[i] = new(big.Int).Set(fee.tips[txIndex].tip)
+	}
+	return rewards
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
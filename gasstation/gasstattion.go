@@ -9,7 +9,9 @@ import (
 	"context"
 	"math/big"
 	"sort"
+	"sync"
 
+	"github.com/iotexproject/go-pkgs/cache"
 	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/iotexproject/iotex-address/address"
 
@@ -23,6 +25,7 @@ import (
 type BlockDAO interface {
 	GetBlockHash(uint64) (hash.Hash256, error)
 	GetBlockByHeight(uint64) (*block.Block, error)
+	GetReceipts(uint64) ([]*action.Receipt, error)
 }
 
 // SimulateFunc is function that simulate execution
@@ -30,17 +33,23 @@ type SimulateFunc func(context.Context, address.Address, *action.Execution, evm.
 
 // GasStation provide gas related api
 type GasStation struct {
-	bc  blockchain.Blockchain
-	dao BlockDAO
-	cfg Config
+	bc       blockchain.Blockchain
+	dao      BlockDAO
+	cfg      Config
+	feeCache cache.LRUCache
+
+	mu           sync.RWMutex
+	tipCapHeight uint64
+	tipCap       *big.Int
 }
 
 // NewGasStation creates a new gas station
 func NewGasStation(bc blockchain.Blockchain, dao BlockDAO, cfg Config) *GasStation {
 	return &GasStation{
-		bc:  bc,
-		dao: dao,
-		cfg: cfg,
+		bc:       bc,
+		dao:      dao,
+		cfg:      cfg,
+		feeCache: cache.NewThreadSafeLruCache(cfg.FeeHistoryCacheSize),
 	}
 }
 
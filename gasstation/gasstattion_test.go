@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/iotexproject/go-pkgs/hash"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -30,6 +31,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/unit"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
@@ -294,3 +296,109 @@ func prepareBlocks(r *require.Assertions, cases []testActionGas) map[uint64]*blo
 	}
 	return blocks
 }
+
+// testBlockHashes returns distinct hashes of the blocks, whose headers are empty
+func testBlockHashes(blocks map[uint64]*block.Block) map[uint64]hash.Hash256 {
+	hashes := make(map[uint64]hash.Hash256, len(blocks))
+	for height := range blocks {
+		hashes[height] = hash.Hash256b(byteutil.Uint64ToBytes(height))
+	}
+	return hashes
+}
+
+func TestFeeHistory(t *testing.T) {
+	r := require.New(t)
+	blocks := prepareBlocks(r, []testActionGas{
+		{},
+		{{uint64(unit.Qev), 30000}, {uint64(unit.Qev) * 3, 10000}},
+		{{uint64(unit.Qev) * 2, 50000000}},
+		{},
+	})
+	ctrl := gomock.NewController(t)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	dao := mock_blockdao.NewMockBlockDAO(ctrl)
+	gs := NewGasStation(bc, dao, DefaultConfig)
+	bc.EXPECT().TipHeight().Return(uint64(len(blocks) - 1)).AnyTimes()
+	bc.EXPECT().Genesis().Return(genesis.Default).AnyTimes()
+	hashes := testBlockHashes(blocks)
+	dao.EXPECT().GetBlockHash(gomock.Any()).DoAndReturn(
+		func(height uint64) (hash.Hash256, error) {
+			return hashes[height], nil
+		},
+	).AnyTimes()
+	// block data is read only once and then served from cache, until the block is replaced by a rewind
+	dao.EXPECT().GetBlockByHeight(gomock.Any()).DoAndReturn(
+		func(height uint64) (*block.Block, error) {
+			return blocks[height], nil
+		},
+	).Times(4)
+	dao.EXPECT().GetReceipts(gomock.Any()).DoAndReturn(
+		func(height uint64) ([]*action.Receipt, error) {
+			return blocks[height].Receipts, nil
+		},
+	).Times(4)
+
+	for i := 0; i < 2; i++ {
+		oldest, rewards, baseFees, ratios, err := gs.FeeHistory(context.Background(), 10, 100, []float64{25, 75, 100})
+		r.NoError(err)
+		r.Equal(uint64(1), oldest)
+		r.Len(baseFees, 4)
+		for _, fee := range baseFees {
+			r.Zero(fee.Sign())
+		}
+		r.Equal([]float64{0.002, 2.5, 0}, ratios)
+		r.Equal([][]*big.Int{
+			{big.NewInt(unit.Qev), big.NewInt(unit.Qev), big.NewInt(unit.Qev * 3)},
+			{big.NewInt(unit.Qev * 2), big.NewInt(unit.Qev * 2), big.NewInt(unit.Qev * 2)},
+			{big.NewInt(0), big.NewInt(0), big.NewInt(0)},
+		}, rewards)
+	}
+
+	// the tip block is rewound and replaced by another block at the same height
+	blocks[3] = prepareBlocks(r, []testActionGas{{{uint64(unit.Qev) * 5, 10000}}})[0]
+	hashes[3] = hash.Hash256b([]byte("rewound"))
+	_, rewards, _, _, err := gs.FeeHistory(context.Background(), 1, 100, []float64{50})
+	r.NoError(err)
+	r.Equal([][]*big.Int{{big.NewInt(unit.Qev * 5)}}, rewards)
+
+	_, _, _, _, err = gs.FeeHistory(context.Background(), 1, 1, []float64{50, 10})
+	r.ErrorIs(err, ErrInvalidPercentile)
+}
+
+func TestSuggestGasTipCap(t *testing.T) {
+	r := require.New(t)
+	blocks := prepareBlocks(r, []testActionGas{
+		{},
+		{{uint64(unit.Qev) * 3, 10000}},
+		{{uint64(unit.Qev) * 2, 10000}, {uint64(unit.Qev) * 4, 10000}},
+	})
+	ctrl := gomock.NewController(t)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	dao := mock_blockdao.NewMockBlockDAO(ctrl)
+	gs := NewGasStation(bc, dao, DefaultConfig)
+	g := genesis.Default
+	g.VanuatuBlockHeight = 1
+	bc.EXPECT().TipHeight().Return(uint64(len(blocks) - 1)).Times(2)
+	bc.EXPECT().Genesis().Return(g).AnyTimes()
+	hashes := testBlockHashes(blocks)
+	dao.EXPECT().GetBlockHash(gomock.Any()).DoAndReturn(
+		func(height uint64) (hash.Hash256, error) {
+			return hashes[height], nil
+		},
+	).AnyTimes()
+	dao.EXPECT().GetBlockByHeight(gomock.Any()).DoAndReturn(
+		func(height uint64) (*block.Block, error) {
+			return blocks[height], nil
+		},
+	).Times(2)
+	dao.EXPECT().GetReceipts(gomock.Any()).DoAndReturn(
+		func(height uint64) ([]*action.Receipt, error) {
+			return blocks[height].Receipts, nil
+		},
+	).Times(2)
+	for i := 0; i < 2; i++ {
+		tip, err := gs.SuggestGasTipCap()
+		r.NoError(err)
+		r.Equal(big.NewInt(unit.Qev*2), tip)
+	}
+}
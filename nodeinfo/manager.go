@@ -25,6 +25,7 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/routine"
+	"github.com/iotexproject/iotex-core/pkg/signer"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/pkg/version"
 )
@@ -58,7 +59,7 @@ type (
 		nodeMap              *lru.Cache
 		transmitter          transmitter
 		chain                chain
-		privKey              crypto.PrivateKey
+		privKey              signer.Signer
 		getBroadcastListFunc getBroadcastListFunc
 	}
 
@@ -78,7 +79,7 @@ func init() {
 }
 
 // NewInfoManager new info manager
-func NewInfoManager(cfg *Config, t transmitter, ch chain, privKey crypto.PrivateKey, broadcastListFunc getBroadcastListFunc) *InfoManager {
+func NewInfoManager(cfg *Config, t transmitter, ch chain, privKey signer.Signer, broadcastListFunc getBroadcastListFunc) *InfoManager {
 	dm := &InfoManager{
 		nodeMap:              lru.New(cfg.NodeMapSize),
 		transmitter:          t,
@@ -10,6 +10,7 @@ import (
 	"compress/gzip"
 	"io"
 
+	"github.com/DataDog/zstd"
 	"github.com/golang/snappy"
 	"github.com/pkg/errors"
 )
@@ -18,8 +19,12 @@ import (
 const (
 	Gzip   = "Gzip"
 	Snappy = "Snappy"
+	Zstd   = "Zstd"
 )
 
+// DefaultZstdLevel is the zstd compression level used by Compress
+const DefaultZstdLevel = 3
+
 // error definition
 var (
 	ErrInputEmpty = errors.New("input cannot be empty")
@@ -36,6 +41,8 @@ func Compress(value []byte, compressor string) ([]byte, error) {
 		return CompGzip(value)
 	case Snappy:
 		return CompSnappy(value)
+	case Zstd:
+		return CompZstd(value)
 	default:
 		panic("unsupported compressor")
 	}
@@ -48,6 +55,8 @@ func Decompress(value []byte, compressor string) ([]byte, error) {
 		return DecompGzip(value)
 	case Snappy:
 		return DecompSnappy(value)
+	case Zstd:
+		return DecompZstd(value)
 	default:
 		panic("unsupported compressor")
 	}
@@ -96,3 +105,53 @@ func DecompSnappy(data []byte) ([]byte, error) {
 	}
 	return v, err
 }
+
+// CompZstd uses zstd to compress the input bytes at the default level
+func CompZstd(data []byte) ([]byte, error) {
+	return zstd.CompressLevel(nil, data, DefaultZstdLevel)
+}
+
+// DecompZstd uses zstd to decompress the input bytes
+func DecompZstd(data []byte) ([]byte, error) {
+	return zstd.Decompress(nil, data)
+}
+
+// ZstdCompressor compresses with zstd at the given level, and with the trained dictionary if it is given
+type ZstdCompressor struct {
+	level int
+	bulk  *zstd.BulkProcessor
+}
+
+// NewZstdCompressor creates a zstd compressor, the dictionary is optional
+func NewZstdCompressor(level int, dict []byte) (*ZstdCompressor, error) {
+	if level < zstd.BestSpeed || level > zstd.BestCompression {
+		return nil, errors.Errorf("invalid zstd level %d, expecting [%d, %d]", level, zstd.BestSpeed, zstd.BestCompression)
+	}
+	c := &ZstdCompressor{
+		level: level,
+	}
+	if len(dict) > 0 {
+		bulk, err := zstd.NewBulkProcessor(dict, level)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load zstd dictionary")
+		}
+		c.bulk = bulk
+	}
+	return c, nil
+}
+
+// Compress compresses the input bytes
+func (c *ZstdCompressor) Compress(data []byte) ([]byte, error) {
+	if c.bulk != nil {
+		return c.bulk.Compress(nil, data)
+	}
+	return zstd.CompressLevel(nil, data, c.level)
+}
+
+// Decompress decompresses the input bytes
+func (c *ZstdCompressor) Decompress(data []byte) ([]byte, error) {
+	if c.bulk != nil {
+		return c.bulk.Decompress(nil, data)
+	}
+	return zstd.Decompress(nil, data)
+}
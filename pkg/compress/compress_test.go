@@ -6,6 +6,7 @@
 package compress
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
@@ -22,6 +23,8 @@ func TestCompressDataBytes(t *testing.T) {
 	r.Error(err)
 	_, err = Decompress([]byte{}, Snappy)
 	r.Error(err)
+	_, err = Decompress([]byte{}, Zstd)
+	r.Error(err)
 	r.Panics(func() { Compress([]byte{}, "invalid") })
 	r.Panics(func() { Decompress([]byte{}, "invalid") })
 
@@ -35,7 +38,7 @@ func TestCompressDataBytes(t *testing.T) {
 		[]byte("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ`1234567890-=~!@#$%^&*()_+å∫ç∂´´©˙ˆˆ˚¬µ˜˜πœ®ß†¨¨∑≈¥Ω[]',./{}|:<>?"),
 	}
 	for _, ser := range compressTests {
-		for _, compress := range []string{Gzip, Snappy} {
+		for _, compress := range []string{Gzip, Snappy, Zstd} {
 			v, err := Compress(ser, compress)
 			r.NoError(err)
 
@@ -45,3 +48,37 @@ func TestCompressDataBytes(t *testing.T) {
 		}
 	}
 }
+
+func TestZstdCompressor(t *testing.T) {
+	r := require.New(t)
+
+	_, err := NewZstdCompressor(0, nil)
+	r.Error(err)
+	_, err = NewZstdCompressor(23, nil)
+	r.Error(err)
+
+	data := bytes.Repeat([]byte("abcdefghijklmnopqrstuvwxyz0123456789"), 100)
+	plain, err := NewZstdCompressor(DefaultZstdLevel, nil)
+	r.NoError(err)
+	v, err := plain.Compress(data)
+	r.NoError(err)
+	// compatible with the default zstd compression
+	ser, err := Decompress(v, Zstd)
+	r.NoError(err)
+	r.Equal(data, ser)
+	ser, err = plain.Decompress(v)
+	r.NoError(err)
+	r.Equal(data, ser)
+
+	// compress with a raw content dictionary
+	withDict, err := NewZstdCompressor(19, []byte("0123456789abcdefghijklmnopqrstuvwxyz"))
+	r.NoError(err)
+	v, err = withDict.Compress(data[:36])
+	r.NoError(err)
+	ser, err = withDict.Decompress(v)
+	r.NoError(err)
+	r.Equal(data[:36], ser)
+	// cannot decompress without the dictionary
+	_, err = plain.Decompress(v)
+	r.Error(err)
+}
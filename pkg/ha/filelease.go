@@ -0,0 +1,116 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/pkg/errors"
+)
+
+// fileLease is a lease backed by an advisory file lock, which suits nodes running on the same host. The lock is
+// released by the OS once the holder process exits, and the lease state is recorded next to the lock file
+type fileLease struct {
+	mutex     sync.Mutex
+	lock      *flock.Flock
+	statePath string
+	now       func() time.Time
+}
+
+// NewFileLease creates a lease locking the file at path
+func NewFileLease(path string) Lease {
+	return &fileLease{
+		lock:      flock.New(path),
+		statePath: path + ".state",
+		now:       time.Now,
+	}
+}
+
+func (l *fileLease) Acquire(_ context.Context, holder string, ttl time.Duration) (*LeaseState, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	state, err := l.readState()
+	if err != nil {
+		return nil, err
+	}
+	if !l.lock.Locked() {
+		locked, err := l.lock.TryLock()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to lock the lease file")
+		}
+		if !locked {
+			return state, nil
+		}
+		state.Holder = holder
+		state.Token++
+	}
+	state.Expiry = l.now().Add(ttl)
+	if err := l.writeState(state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (l *fileLease) Release(_ context.Context, holder string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if !l.lock.Locked() {
+		return nil
+	}
+	state, err := l.readState()
+	if err != nil {
+		return err
+	}
+	if state.Holder == holder {
+		state.Expiry = l.now()
+		if err := l.writeState(state); err != nil {
+			return err
+		}
+	}
+	return l.lock.Unlock()
+}
+
+func (l *fileLease) State(_ context.Context) (*LeaseState, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.readState()
+}
+
+func (l *fileLease) readState() (*LeaseState, error) {
+	state := &LeaseState{}
+	data, err := os.ReadFile(l.statePath)
+	switch {
+	case os.IsNotExist(err):
+		return state, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to read the lease state")
+	}
+	if len(data) == 0 {
+		return state, nil
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the lease state")
+	}
+	return state, nil
+}
+
+func (l *fileLease) writeState(state *LeaseState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	// write to a temp file first, so that readers never see a partial state
+	tmp := l.statePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write the lease state")
+	}
+	return errors.Wrap(os.Rename(tmp, l.statePath), "failed to write the lease state")
+}
@@ -6,29 +6,226 @@
 package ha
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/consensus"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
+)
+
+var (
+	_haLeaseMtc = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "iotex_ha_lease",
+			Help: "IoTeX HA leader lease status.",
+		},
+		[]string{"type"},
+	)
+	_haLeaseEventMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_ha_lease_events",
+			Help: "IoTeX HA leader lease events.",
+		},
+		[]string{"event"},
+	)
 )
 
-// Controller controls the node high availability status
-type Controller struct {
-	c consensus.Consensus
+func init() {
+	prometheus.MustRegister(_haLeaseMtc)
+	prometheus.MustRegister(_haLeaseEventMtc)
+}
+
+type (
+	// Controller controls the node high availability status
+	Controller struct {
+		c      consensus.Consensus
+		cfg    Config
+		lease  Lease
+		fencer Fencer
+		task   *routine.RecurringTask
+		clock  clock.Clock
+
+		mutex sync.Mutex
+		// state is the latest observed lease state
+		state *LeaseState
+		// held is true if this node holds the lease, and token is the fencing token of its term
+		held  bool
+		token uint64
+		// lastRenew is the time the lease was last renewed successfully
+		lastRenew time.Time
+		// activateAt is the time from which the node may activate in the current term
+		activateAt time.Time
+		active     bool
+	}
+
+	// Fencer rejects the work of a node whose fencing token is older than the newest one it has seen, e.g., the
+	// remote signer refuses to sign for a former leader
+	Fencer interface {
+		SetFencingToken(token uint64)
+	}
+
+	// Option is the option to create a HA controller
+	Option func(*Controller)
+)
+
+// WithLease makes the controller switch the node status by the leader lease instead of by the operator
+func WithLease(lease Lease, cfg Config) Option {
+	return func(ha *Controller) {
+		ha.lease = lease
+		ha.cfg = cfg
+	}
+}
+
+// WithFencer passes the fencing token of the lease held by the node to the fencer, before the node activates
+func WithFencer(f Fencer) Option {
+	return func(ha *Controller) {
+		ha.fencer = f
+	}
+}
+
+// WithClock sets the clock of the controller
+func WithClock(c clock.Clock) Option {
+	return func(ha *Controller) {
+		ha.clock = c
+	}
 }
 
 // New constructs a HA controller instance
-func New(c consensus.Consensus) *Controller {
-	return &Controller{
-		c: c,
+func New(c consensus.Consensus, opts ...Option) *Controller {
+	ha := &Controller{
+		c:     c,
+		clock: clock.New(),
+	}
+	for _, opt := range opts {
+		opt(ha)
+	}
+	if ha.lease != nil && ha.cfg.NodeID == "" {
+		ha.cfg.NodeID = defaultNodeID()
+	}
+	return ha
+}
+
+// Start starts contending for the leader lease. The node stays in stand-by mode until it holds the lease
+func (ha *Controller) Start(ctx context.Context) error {
+	if ha.lease == nil {
+		return nil
+	}
+	log.L().Info("Start contending for the HA leader lease.", zap.String("nodeID", ha.cfg.NodeID))
+	ha.c.Activate(false)
+	ha.Renew()
+	ha.task = routine.NewRecurringTask(ha.Renew, ha.cfg.RenewInterval, routine.WithClock(ha.clock))
+	return ha.task.Start(ctx)
+}
+
+// Stop steps down and releases the leader lease
+func (ha *Controller) Stop(ctx context.Context) error {
+	if ha.lease == nil {
+		return nil
+	}
+	if ha.task != nil {
+		if err := ha.task.Stop(ctx); err != nil {
+			return err
+		}
+	}
+	ha.mutex.Lock()
+	defer ha.mutex.Unlock()
+	held := ha.held
+	ha.stepDown()
+	if !held {
+		return nil
+	}
+	return ha.lease.Release(ctx, ha.cfg.NodeID)
+}
+
+// Renew acquires or renews the leader lease, and switches the node status accordingly
+func (ha *Controller) Renew() {
+	ha.mutex.Lock()
+	defer ha.mutex.Unlock()
+	now := ha.clock.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), ha.cfg.RenewInterval)
+	defer cancel()
+	state, err := ha.lease.Acquire(ctx, ha.cfg.NodeID, ha.cfg.LeaseTTL)
+	if err != nil {
+		_haLeaseEventMtc.WithLabelValues("renewFailed").Inc()
+		log.L().Error("Failed to renew the HA leader lease.", zap.Error(err))
+		// the lease may expire before the next renewal, step down in time for others to take over
+		if ha.held && now.Add(ha.cfg.RenewInterval).Sub(ha.lastRenew) >= ha.cfg.LeaseTTL {
+			ha.stepDown()
+		}
+		ha.updateMetrics()
+		return
+	}
+	ha.state = state
+	if !state.HeldBy(ha.cfg.NodeID, now) {
+		ha.stepDown()
+		ha.updateMetrics()
+		return
+	}
+	if !ha.held || ha.token != state.Token {
+		// a new term, the previous holder may still be active until its lease expires
+		ha.stepDown()
+		ha.held = true
+		ha.token = state.Token
+		ha.activateAt = now.Add(ha.cfg.FenceDelay)
+		if ha.fencer != nil {
+			ha.fencer.SetFencingToken(state.Token)
+		}
+		_haLeaseEventMtc.WithLabelValues("acquired").Inc()
+		log.L().Info("Acquired the HA leader lease.", zap.Uint64("token", state.Token), zap.Time("activateAt", ha.activateAt))
+	}
+	ha.lastRenew = now
+	if !ha.active && !now.Before(ha.activateAt) {
+		log.L().Info("Set the node to active mode", zap.Uint64("token", ha.token))
+		ha.c.Activate(true)
+		ha.active = true
+	}
+	ha.updateMetrics()
+}
+
+func (ha *Controller) stepDown() {
+	if ha.held {
+		_haLeaseEventMtc.WithLabelValues("lost").Inc()
+		log.L().Warn("Lost the HA leader lease.", zap.Uint64("token", ha.token))
+	}
+	ha.held = false
+	if ha.active {
+		log.L().Info("Set the node to stand-by mode", zap.Uint64("token", ha.token))
+		ha.c.Activate(false)
+		ha.active = false
+	}
+}
+
+func (ha *Controller) updateMetrics() {
+	var held, active float64
+	if ha.held {
+		held = 1
+	}
+	if ha.active {
+		active = 1
+	}
+	_haLeaseMtc.WithLabelValues("held").Set(held)
+	_haLeaseMtc.WithLabelValues("active").Set(active)
+	if ha.state != nil {
+		_haLeaseMtc.WithLabelValues("token").Set(float64(ha.state.Token))
 	}
 }
 
 // Handle handles admin request
 func (ha *Controller) Handle(w http.ResponseWriter, r *http.Request) {
 	val := strings.ToLower(r.URL.Query().Get("activate"))
+	if val != "" && ha.lease != nil {
+		http.Error(w, "the node status is managed by the leader lease", http.StatusConflict)
+		return
+	}
 	switch val {
 	case "true":
 		log.S().Info("Set the node to active mode")
@@ -37,11 +234,33 @@ func (ha *Controller) Handle(w http.ResponseWriter, r *http.Request) {
 		log.S().Info("Set the node to stand-by mode")
 		ha.c.Activate(false)
 	case "":
+		type leasePayload struct {
+			NodeID string    `json:"nodeID"`
+			Held   bool      `json:"held"`
+			Holder string    `json:"holder"`
+			Token  uint64    `json:"token"`
+			Expiry time.Time `json:"expiry"`
+		}
 		type payload struct {
-			Active bool `json:"active"`
+			Active bool          `json:"active"`
+			Lease  *leasePayload `json:"lease,omitempty"`
+		}
+		p := payload{Active: ha.c.Active()}
+		if ha.lease != nil {
+			ha.mutex.Lock()
+			p.Lease = &leasePayload{
+				NodeID: ha.cfg.NodeID,
+				Held:   ha.held,
+			}
+			if ha.state != nil {
+				p.Lease.Holder = ha.state.Holder
+				p.Lease.Token = ha.state.Token
+				p.Lease.Expiry = ha.state.Expiry
+			}
+			ha.mutex.Unlock()
 		}
 		enc := json.NewEncoder(w)
-		if err := enc.Encode(&payload{Active: ha.c.Active()}); err != nil {
+		if err := enc.Encode(&p); err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
@@ -0,0 +1,185 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/clock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/mock/mock_consensus"
+)
+
+type fakeConsensus struct {
+	*mock_consensus.MockConsensus
+	active bool
+}
+
+func (c *fakeConsensus) Activate(active bool) { c.active = active }
+
+func (c *fakeConsensus) Active() bool { return c.active }
+
+type fakeFencer struct {
+	token uint64
+}
+
+func (f *fakeFencer) SetFencingToken(token uint64) { f.token = token }
+
+type failingLease struct {
+	Lease
+	fail bool
+}
+
+func (l *failingLease) Acquire(ctx context.Context, holder string, ttl time.Duration) (*LeaseState, error) {
+	if l.fail {
+		return nil, errors.New("store unavailable")
+	}
+	return l.Lease.Acquire(ctx, holder, ttl)
+}
+
+func TestControllerFailover(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	clk := clock.NewMock()
+	clk.Add(time.Hour)
+	store := newMemKVStore()
+	cfg := DefaultConfig
+	fencer := &fakeFencer{}
+	newNode := func(id string) (*Controller, *fakeConsensus, *failingLease) {
+		kv := NewKVLease(store, "lease").(*kvLease)
+		kv.now = clk.Now
+		lease := &failingLease{Lease: kv}
+		c := &fakeConsensus{MockConsensus: mock_consensus.NewMockConsensus(ctrl)}
+		nodeCfg := cfg
+		nodeCfg.NodeID = id
+		return New(c, WithLease(lease, nodeCfg), WithClock(clk), WithFencer(fencer)), c, lease
+	}
+	ha1, c1, lease1 := newNode("node1")
+	ha2, c2, _ := newNode("node2")
+
+	// node1 acquires the lease and activates after the fence delay
+	ha1.Renew()
+	ha2.Renew()
+	require.False(c1.active)
+	require.False(c2.active)
+	require.True(ha1.held)
+	require.False(ha2.held)
+	require.EqualValues(1, fencer.token)
+	clk.Add(cfg.FenceDelay)
+	ha1.Renew()
+	ha2.Renew()
+	require.True(c1.active)
+	require.False(c2.active)
+
+	// node1 fails to renew, and steps down before the lease expires
+	lease1.fail = true
+	for elapsed := cfg.RenewInterval; elapsed < cfg.LeaseTTL-cfg.RenewInterval; elapsed += cfg.RenewInterval {
+		clk.Add(cfg.RenewInterval)
+		ha1.Renew()
+		require.True(c1.active)
+	}
+	clk.Add(cfg.RenewInterval)
+	ha1.Renew()
+	require.False(c1.active)
+	require.False(ha1.held)
+
+	// node2 takes over after the lease expires, and activates after the fence delay
+	ha2.Renew()
+	require.False(ha2.held)
+	clk.Add(cfg.LeaseTTL)
+	ha2.Renew()
+	require.True(ha2.held)
+	require.False(c2.active)
+	require.EqualValues(2, fencer.token)
+	clk.Add(cfg.FenceDelay)
+	ha2.Renew()
+	require.True(c2.active)
+	require.EqualValues(2, ha2.state.Token)
+
+	// node1 recovers and stays in stand-by mode
+	lease1.fail = false
+	ha1.Renew()
+	require.False(c1.active)
+	require.Equal("node2", ha1.state.Holder)
+
+	// manual switch is rejected, and the lease state is exposed
+	w := httptest.NewRecorder()
+	ha1.Handle(w, httptest.NewRequest(http.MethodGet, "/ha?activate=true", nil))
+	require.Equal(http.StatusConflict, w.Code)
+	require.False(c1.active)
+	w = httptest.NewRecorder()
+	ha2.Handle(w, httptest.NewRequest(http.MethodGet, "/ha", nil))
+	var payload struct {
+		Active bool `json:"active"`
+		Lease  struct {
+			NodeID string `json:"nodeID"`
+			Held   bool   `json:"held"`
+			Holder string `json:"holder"`
+			Token  uint64 `json:"token"`
+		} `json:"lease"`
+	}
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &payload))
+	require.True(payload.Active)
+	require.Equal("node2", payload.Lease.NodeID)
+	require.True(payload.Lease.Held)
+	require.Equal("node2", payload.Lease.Holder)
+	require.EqualValues(2, payload.Lease.Token)
+
+	// node2 releases the lease on stop, node1 takes over
+	require.NoError(ha2.Stop(ctx))
+	require.False(c2.active)
+	ha1.Renew()
+	require.True(ha1.held)
+	require.EqualValues(3, ha1.state.Token)
+	require.NoError(ha1.Stop(ctx))
+}
+
+func TestControllerStartStop(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	c := &fakeConsensus{MockConsensus: mock_consensus.NewMockConsensus(ctrl), active: true}
+	lease := NewKVLease(newMemKVStore(), "lease")
+	cfg := DefaultConfig
+	cfg.FenceDelay = 0
+	ha := New(c, WithLease(lease, cfg))
+	require.NotEmpty(ha.cfg.NodeID)
+	require.NoError(ha.Start(ctx))
+	require.True(c.active)
+	state, err := lease.State(ctx)
+	require.NoError(err)
+	require.True(state.HeldBy(ha.cfg.NodeID, time.Now()))
+	require.NoError(ha.Stop(ctx))
+	require.False(c.active)
+	state, err = lease.State(ctx)
+	require.NoError(err)
+	require.False(state.HeldBy(ha.cfg.NodeID, time.Now()))
+}
+
+func TestControllerManual(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	c := &fakeConsensus{MockConsensus: mock_consensus.NewMockConsensus(ctrl)}
+	ha := New(c)
+	require.NoError(ha.Start(context.Background()))
+	w := httptest.NewRecorder()
+	ha.Handle(w, httptest.NewRequest(http.MethodGet, "/ha?activate=true", nil))
+	require.Equal(http.StatusOK, w.Code)
+	require.True(c.active)
+	w = httptest.NewRecorder()
+	ha.Handle(w, httptest.NewRequest(http.MethodGet, "/ha", nil))
+	require.JSONEq(`{"active":true}`, w.Body.String())
+	require.NoError(ha.Stop(context.Background()))
+}
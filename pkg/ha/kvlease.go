@@ -0,0 +1,112 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// KVStore is a shared key-value store with atomic compare-and-swap, e.g., etcd, consul or a database
+	KVStore interface {
+		// Get returns the value of key, or nil if key does not exist
+		Get(ctx context.Context, key string) ([]byte, error)
+		// CompareAndSwap sets the value of key to new if its current value is old, a nil old means key does not
+		// exist. It returns false if the value has been changed by someone else
+		CompareAndSwap(ctx context.Context, key string, old, new []byte) (bool, error)
+	}
+
+	kvLease struct {
+		store KVStore
+		key   string
+		now   func() time.Time
+	}
+)
+
+// NewKVLease creates a lease stored under key in a shared key-value store
+func NewKVLease(store KVStore, key string) Lease {
+	return &kvLease{
+		store: store,
+		key:   key,
+		now:   time.Now,
+	}
+}
+
+func (l *kvLease) Acquire(ctx context.Context, holder string, ttl time.Duration) (*LeaseState, error) {
+	old, state, err := l.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := l.now()
+	switch {
+	case state.HeldBy(holder, now):
+		// renew
+	case old == nil || !now.Before(state.Expiry):
+		// take over an absent or expired lease
+		state.Holder = holder
+		state.Token++
+	default:
+		return state, nil
+	}
+	state.Expiry = now.Add(ttl)
+	if err := l.swap(ctx, old, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (l *kvLease) Release(ctx context.Context, holder string) error {
+	old, state, err := l.get(ctx)
+	if err != nil {
+		return err
+	}
+	now := l.now()
+	if !state.HeldBy(holder, now) {
+		return nil
+	}
+	// keep the token, so that the next holder gets a greater one
+	state.Expiry = now
+	return l.swap(ctx, old, state)
+}
+
+func (l *kvLease) State(ctx context.Context) (*LeaseState, error) {
+	_, state, err := l.get(ctx)
+	return state, err
+}
+
+func (l *kvLease) get(ctx context.Context) ([]byte, *LeaseState, error) {
+	value, err := l.store.Get(ctx, l.key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read the lease")
+	}
+	state := &LeaseState{}
+	if value == nil {
+		return nil, state, nil
+	}
+	if err := json.Unmarshal(value, state); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode the lease")
+	}
+	return value, state, nil
+}
+
+func (l *kvLease) swap(ctx context.Context, old []byte, state *LeaseState) error {
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	swapped, err := l.store.CompareAndSwap(ctx, l.key, old, value)
+	if err != nil {
+		return errors.Wrap(err, "failed to write the lease")
+	}
+	if !swapped {
+		return errors.New("the lease has been updated concurrently")
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package ha
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memKVStore struct {
+	mutex sync.Mutex
+	kv    map[string][]byte
+}
+
+func newMemKVStore() *memKVStore {
+	return &memKVStore{kv: map[string][]byte{}}
+}
+
+func (s *memKVStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.kv[key], nil
+}
+
+func (s *memKVStore) CompareAndSwap(_ context.Context, key string, old, new []byte) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !bytes.Equal(s.kv[key], old) {
+		return false, nil
+	}
+	s.kv[key] = new
+	return true, nil
+}
+
+func TestKVLease(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	store := newMemKVStore()
+	leases := make([]*kvLease, 2)
+	for i := range leases {
+		leases[i] = NewKVLease(store, "lease").(*kvLease)
+		leases[i].now = func() time.Time { return now }
+	}
+	ttl := 10 * time.Second
+
+	// node1 acquires the absent lease
+	state, err := leases[0].Acquire(ctx, "node1", ttl)
+	require.NoError(err)
+	require.Equal(&LeaseState{Holder: "node1", Token: 1, Expiry: now.Add(ttl)}, state)
+
+	// node2 cannot acquire the lease before it expires
+	now = now.Add(5 * time.Second)
+	state, err = leases[1].Acquire(ctx, "node2", ttl)
+	require.NoError(err)
+	require.Equal("node1", state.Holder)
+	require.False(state.HeldBy("node2", now))
+
+	// node1 renews the lease with the same token
+	state, err = leases[0].Acquire(ctx, "node1", ttl)
+	require.NoError(err)
+	require.Equal(&LeaseState{Holder: "node1", Token: 1, Expiry: now.Add(ttl)}, state)
+
+	// node2 takes over the expired lease with a greater token
+	now = now.Add(ttl)
+	state, err = leases[1].Acquire(ctx, "node2", ttl)
+	require.NoError(err)
+	require.Equal(&LeaseState{Holder: "node2", Token: 2, Expiry: now.Add(ttl)}, state)
+	state, err = leases[0].Acquire(ctx, "node1", ttl)
+	require.NoError(err)
+	require.Equal("node2", state.Holder)
+
+	// release by someone else is a no-op
+	require.NoError(leases[0].Release(ctx, "node1"))
+	state, err = leases[0].State(ctx)
+	require.NoError(err)
+	require.True(state.HeldBy("node2", now))
+
+	// node1 acquires the released lease right away
+	require.NoError(leases[1].Release(ctx, "node2"))
+	state, err = leases[0].Acquire(ctx, "node1", ttl)
+	require.NoError(err)
+	require.Equal(&LeaseState{Holder: "node1", Token: 3, Expiry: now.Add(ttl)}, state)
+}
+
+func TestFileLease(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "iotex.lease")
+	lease1, lease2 := NewFileLease(path), NewFileLease(path)
+	ttl := 10 * time.Second
+
+	state, err := lease1.Acquire(ctx, "node1", ttl)
+	require.NoError(err)
+	require.Equal("node1", state.Holder)
+	require.EqualValues(1, state.Token)
+
+	// the file is locked by node1
+	state, err = lease2.Acquire(ctx, "node2", ttl)
+	require.NoError(err)
+	require.Equal("node1", state.Holder)
+	state, err = lease1.Acquire(ctx, "node1", ttl)
+	require.NoError(err)
+	require.EqualValues(1, state.Token)
+
+	require.NoError(lease1.Release(ctx, "node1"))
+	state, err = lease2.State(ctx)
+	require.NoError(err)
+	require.False(state.HeldBy("node1", time.Now()))
+	state, err = lease2.Acquire(ctx, "node2", ttl)
+	require.NoError(err)
+	require.Equal("node2", state.Holder)
+	require.EqualValues(2, state.Token)
+	require.NoError(lease2.Release(ctx, "node2"))
+}
+
+func TestNewLease(t *testing.T) {
+	require := require.New(t)
+	lease, err := NewLease(DefaultConfig)
+	require.NoError(err)
+	require.Nil(lease)
+	cfg := DefaultConfig
+	cfg.Lease = FileLease
+	cfg.LeasePath = filepath.Join(t.TempDir(), "iotex.lease")
+	lease, err = NewLease(cfg)
+	require.NoError(err)
+	require.NotNil(lease)
+	cfg.Lease = KVLease
+	cfg.LeaseURL = "redis://localhost:6379/0"
+	lease, err = NewLease(cfg)
+	require.NoError(err)
+	require.Equal(DefaultConfig.LeaseKey, lease.(*kvLease).key)
+	cfg.LeaseURL = "localhost:6379"
+	_, err = NewLease(cfg)
+	require.Error(err)
+	cfg.Lease = "etcd"
+	_, err = NewLease(cfg)
+	require.ErrorIs(err, ErrUnknownLease)
+}
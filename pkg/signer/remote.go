@@ -0,0 +1,264 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	_serviceName      = "iotex.signer.Signer"
+	_publicKeyMethod  = "/" + _serviceName + "/PublicKey"
+	_signMethod       = "/" + _serviceName + "/Sign"
+	_signerMetadataID = "signer"
+	// _fencingTokenKey is the metadata key of the fencing token in a signing request
+	_fencingTokenKey = "x-fencing-token"
+)
+
+type (
+	// RemoteSigner signs with the key held by an external signing process over grpc
+	RemoteSigner struct {
+		cfg   Config
+		conn  *grpc.ClientConn
+		pk    crypto.PublicKey
+		token atomic.Uint64
+	}
+
+	// signerServiceServer is the grpc service exposed by the signing process
+	signerServiceServer interface {
+		PublicKey(context.Context, *emptypb.Empty) (*wrapperspb.BytesValue, error)
+		Sign(context.Context, *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error)
+	}
+
+	// signerService signs for the node holding the newest fencing token. Once a token is seen, the requests with an
+	// older token or without a token are rejected, so that a former leader can't sign after a new one takes over
+	signerService struct {
+		s     Signer
+		mutex sync.Mutex
+		token uint64
+	}
+)
+
+// ErrStaleFencingToken indicates the signing request is made by a node which no longer holds the leader lease
+var ErrStaleFencingToken = errors.New("stale fencing token")
+
+var _signerServiceDesc = grpc.ServiceDesc{
+	ServiceName: _serviceName,
+	HandlerType: (*signerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublicKey",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(emptypb.Empty)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(signerServiceServer).PublicKey(ctx, req.(*emptypb.Empty))
+				}
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: _publicKeyMethod}, handler)
+			},
+		},
+		{
+			MethodName: "Sign",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(wrapperspb.BytesValue)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(signerServiceServer).Sign(ctx, req.(*wrapperspb.BytesValue))
+				}
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: _signMethod}, handler)
+			},
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: _signerMetadataID,
+}
+
+// RegisterService registers the signer as the grpc service of a signing process
+func RegisterService(gs *grpc.Server, s Signer) {
+	gs.RegisterService(&_signerServiceDesc, &signerService{s: s})
+}
+
+func (svc *signerService) PublicKey(context.Context, *emptypb.Empty) (*wrapperspb.BytesValue, error) {
+	return wrapperspb.Bytes(svc.s.PublicKey().Bytes()), nil
+}
+
+func (svc *signerService) Sign(ctx context.Context, in *wrapperspb.BytesValue) (*wrapperspb.BytesValue, error) {
+	// the token is checked and the hash is signed atomically, so that no stale request is signed after a newer one
+	svc.mutex.Lock()
+	defer svc.mutex.Unlock()
+	token, err := fencingTokenFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if token < svc.token {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: token %d is older than %d", ErrStaleFencingToken, token, svc.token)
+	}
+	svc.token = token
+	sig, err := svc.s.Sign(in.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return wrapperspb.Bytes(sig), nil
+}
+
+// fencingTokenFromContext returns the fencing token of the signing request, 0 if it is not set
+func fencingTokenFromContext(ctx context.Context) (uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	values := md.Get(_fencingTokenKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	token, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid fencing token")
+	}
+	return token, nil
+}
+
+// NewRemoteSigner connects to the signing process, and checks that it holds the configured producer key
+func NewRemoteSigner(ctx context.Context, cfg Config) (*RemoteSigner, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	pk, err := crypto.HexStringToPublicKey(cfg.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	tlsCfg, err := ClientTLSConfig(cfg.CACertPath, cfg.CertPath, cfg.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to remote signer %s", cfg.Endpoint)
+	}
+	s := &RemoteSigner{
+		cfg:  cfg,
+		conn: conn,
+		pk:   pk,
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.Timeout)
+	defer cancel()
+	out := new(wrapperspb.BytesValue)
+	if err := conn.Invoke(ctx, _publicKeyMethod, &emptypb.Empty{}, out, grpc.WaitForReady(true)); err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to get the public key from remote signer")
+	}
+	if !bytes.Equal(out.GetValue(), pk.Bytes()) {
+		conn.Close()
+		return nil, errors.Wrap(ErrInvalidConfig, "remote signer does not hold the producer key")
+	}
+	return s, nil
+}
+
+// PublicKey returns the producer public key
+func (s *RemoteSigner) PublicKey() crypto.PublicKey {
+	return s.pk
+}
+
+// SetFencingToken sets the fencing token of the leader lease held by the node, which is sent with the signing
+// requests afterwards
+func (s *RemoteSigner) SetFencingToken(token uint64) {
+	s.token.Store(token)
+}
+
+// Sign asks the signing process to sign the hash, and verifies the returned signature
+func (s *RemoteSigner) Sign(hash []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+	if token := s.token.Load(); token > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, _fencingTokenKey, strconv.FormatUint(token, 10))
+	}
+	out := new(wrapperspb.BytesValue)
+	if err := s.conn.Invoke(ctx, _signMethod, wrapperspb.Bytes(hash), out); err != nil {
+		return nil, errors.Wrap(err, "failed to sign by remote signer")
+	}
+	sig := out.GetValue()
+	if !s.pk.Verify(hash, sig) {
+		return nil, errors.New("invalid signature from remote signer")
+	}
+	return sig, nil
+}
+
+// Stop closes the connection to the signing process
+func (s *RemoteSigner) Stop(context.Context) error {
+	return s.conn.Close()
+}
+
+// ClientTLSConfig returns the TLS config of the node, which verifies the signing process with the CA certificate
+// and authenticates itself with the client certificate
+func ClientTLSConfig(caCertPath, certPath, keyPath string) (*tls.Config, error) {
+	pool, cert, err := loadTLSFiles(caCertPath, certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ServerTLSConfig returns the TLS config of the signing process, which only accepts the clients whose certificates
+// are issued by the CA
+func ServerTLSConfig(caCertPath, certPath, keyPath string) (*tls.Config, error) {
+	pool, cert, err := loadTLSFiles(caCertPath, certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadTLSFiles(caCertPath, certPath, keyPath string) (*x509.CertPool, tls.Certificate, error) {
+	ca, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return nil, tls.Certificate{}, errors.Wrap(err, "failed to read CA certificate")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, tls.Certificate{}, errors.New("failed to parse CA certificate")
+	}
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, tls.Certificate{}, errors.Wrap(err, "failed to load certificate and key")
+	}
+	return pool, cert, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Signer signs hashes with the block producer key. A crypto.PrivateKey is a Signer, while a remote signer keeps
+	// the key out of the node
+	Signer interface {
+		// PublicKey returns the public key of the signing key
+		PublicKey() crypto.PublicKey
+		// Sign signs the hash
+		Sign(hash []byte) ([]byte, error)
+	}
+
+	// Config is the config of the remote signer
+	Config struct {
+		// Endpoint is the grpc address of the signing process, either host:port or unix:///path/to/socket, empty
+		// means the producer key is loaded into the node
+		Endpoint string `yaml:"endpoint"`
+		// PublicKey is the hex encoded public key of the producer, which the remote signer must hold
+		PublicKey string `yaml:"publicKey"`
+		// CACertPath is the CA certificate verifying the signing process. Mutual TLS is required on a unix socket too,
+		// since the file permission of the socket doesn't tell the node from another local process
+		CACertPath string `yaml:"caCertPath"`
+		// CertPath and KeyPath are the client certificate and key, with which the node authenticates itself
+		CertPath string `yaml:"certPath"`
+		KeyPath  string `yaml:"keyPath"`
+		// Timeout is the timeout of a signing request
+		Timeout time.Duration `yaml:"timeout"`
+	}
+
+	localSigner struct {
+		sk crypto.PrivateKey
+	}
+)
+
+var (
+	// DefaultConfig is the default config of the remote signer
+	DefaultConfig = Config{
+		Timeout: 2 * time.Second,
+	}
+
+	// ErrInvalidConfig indicates the remote signer config is invalid
+	ErrInvalidConfig = errors.New("invalid remote signer config")
+)
+
+// NewLocalSigner creates a signer with the private key in memory
+func NewLocalSigner(sk crypto.PrivateKey) Signer {
+	return &localSigner{sk: sk}
+}
+
+func (s *localSigner) PublicKey() crypto.PublicKey {
+	return s.sk.PublicKey()
+}
+
+func (s *localSigner) Sign(hash []byte) ([]byte, error) {
+	return s.sk.Sign(hash)
+}
+
+// IsRemote returns true if a remote signer is configured
+func (cfg Config) IsRemote() bool {
+	return cfg.Endpoint != ""
+}
+
+// Validate validates the remote signer config
+func (cfg Config) Validate() error {
+	if !cfg.IsRemote() {
+		return nil
+	}
+	if _, err := crypto.HexStringToPublicKey(cfg.PublicKey); err != nil {
+		return errors.Wrap(ErrInvalidConfig, "invalid producer public key")
+	}
+	if cfg.Timeout <= 0 {
+		return errors.Wrap(ErrInvalidConfig, "timeout is not positive")
+	}
+	if cfg.CACertPath == "" || cfg.CertPath == "" || cfg.KeyPath == "" {
+		return errors.Wrap(ErrInvalidConfig, "mutual TLS is required for a remote signer, CA certificate, client certificate and key should be set")
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2024 IoTeX Foundation
+// This source code is provided 'as is' and no warranties are given as to title or non-infringement, merchantability
+// or fitness for purpose and, to the extent permitted by law, all liability for your use of the code is disclaimed.
+// This source code is governed by Apache License 2.0 that can be found in the LICENSE file.
+
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestLocalSigner(t *testing.T) {
+	require := require.New(t)
+	sk, err := crypto.GenerateKey()
+	require.NoError(err)
+	s := NewLocalSigner(sk)
+	require.Equal(sk.PublicKey().HexString(), s.PublicKey().HexString())
+	h := hash.Hash256b([]byte("block"))
+	sig, err := s.Sign(h[:])
+	require.NoError(err)
+	require.True(sk.PublicKey().Verify(h[:], sig))
+}
+
+func serveSigner(t *testing.T, lis net.Listener, s Signer, opts ...grpc.ServerOption) {
+	gs := grpc.NewServer(opts...)
+	RegisterService(gs, s)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+}
+
+// serveMutualTLSSigner serves the signer on the listener with mutual TLS, and returns the config of the node
+func serveMutualTLSSigner(t *testing.T, lis net.Listener, sk crypto.PrivateKey) Config {
+	require := require.New(t)
+	dir := t.TempDir()
+	ca, caKey := writeCert(t, dir, "ca", nil, nil)
+	writeCert(t, dir, "server", ca, caKey)
+	writeCert(t, dir, "client", ca, caKey)
+	path := func(name string) string { return filepath.Join(dir, name) }
+	serverTLS, err := ServerTLSConfig(path("ca.pem"), path("server.pem"), path("server.key"))
+	require.NoError(err)
+	serveSigner(t, lis, NewLocalSigner(sk), grpc.Creds(credentials.NewTLS(serverTLS)))
+
+	cfg := DefaultConfig
+	cfg.Endpoint = lis.Addr().String()
+	if lis.Addr().Network() == "unix" {
+		cfg.Endpoint = "unix://" + cfg.Endpoint
+	}
+	cfg.PublicKey = sk.PublicKey().HexString()
+	cfg.CACertPath, cfg.CertPath, cfg.KeyPath = path("ca.pem"), path("client.pem"), path("client.key")
+	return cfg
+}
+
+func TestRemoteSignerUnixSocket(t *testing.T) {
+	require := require.New(t)
+	sk, err := crypto.GenerateKey()
+	require.NoError(err)
+	lis, err := net.Listen("unix", filepath.Join(t.TempDir(), "signer.sock"))
+	require.NoError(err)
+	cfg := serveMutualTLSSigner(t, lis, sk)
+
+	s, err := NewRemoteSigner(context.Background(), cfg)
+	require.NoError(err)
+	defer s.Stop(context.Background())
+	require.Equal(cfg.PublicKey, s.PublicKey().HexString())
+	h := hash.Hash256b([]byte("block"))
+	sig, err := s.Sign(h[:])
+	require.NoError(err)
+	require.True(sk.PublicKey().Verify(h[:], sig))
+
+	// the signing process does not hold the producer key
+	other, err := crypto.GenerateKey()
+	require.NoError(err)
+	pk := cfg.PublicKey
+	cfg.PublicKey = other.PublicKey().HexString()
+	_, err = NewRemoteSigner(context.Background(), cfg)
+	require.ErrorIs(err, ErrInvalidConfig)
+
+	// mutual TLS is required on the socket too
+	cfg.PublicKey = pk
+	cfg.CACertPath, cfg.CertPath, cfg.KeyPath = "", "", ""
+	require.ErrorIs(cfg.Validate(), ErrInvalidConfig)
+
+	// a local process connecting without client certificate is rejected
+	noCertTLS, err := ClientTLSConfig(s.cfg.CACertPath, s.cfg.CertPath, s.cfg.KeyPath)
+	require.NoError(err)
+	noCertTLS.Certificates = nil
+	for _, creds := range []credentials.TransportCredentials{
+		credentials.NewTLS(noCertTLS),
+		insecure.NewCredentials(),
+	} {
+		conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+		require.NoError(err)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		require.Error(conn.Invoke(ctx, _signMethod, wrapperspb.Bytes(h[:]), new(wrapperspb.BytesValue)))
+		cancel()
+		conn.Close()
+	}
+}
+
+func TestRemoteSignerFencing(t *testing.T) {
+	require := require.New(t)
+	sk, err := crypto.GenerateKey()
+	require.NoError(err)
+	lis, err := net.Listen("unix", filepath.Join(t.TempDir(), "signer.sock"))
+	require.NoError(err)
+	cfg := serveMutualTLSSigner(t, lis, sk)
+
+	newSigner := func(token uint64) *RemoteSigner {
+		s, err := NewRemoteSigner(context.Background(), cfg)
+		require.NoError(err)
+		t.Cleanup(func() { s.Stop(context.Background()) })
+		if token > 0 {
+			s.SetFencingToken(token)
+		}
+		return s
+	}
+	h := hash.Hash256b([]byte("block"))
+	former, latter, unfenced := newSigner(1), newSigner(0), newSigner(0)
+	_, err = former.Sign(h[:])
+	require.NoError(err)
+
+	// the new leader takes over, the former leader and a node without token are rejected
+	latter.SetFencingToken(2)
+	_, err = latter.Sign(h[:])
+	require.NoError(err)
+	for _, s := range []*RemoteSigner{former, unfenced} {
+		_, err = s.Sign(h[:])
+		require.Equal(codes.FailedPrecondition, status.Code(errors.Cause(err)))
+		require.ErrorContains(err, ErrStaleFencingToken.Error())
+	}
+	_, err = latter.Sign(h[:])
+	require.NoError(err)
+}
+
+func TestRemoteSignerMutualTLS(t *testing.T) {
+	require := require.New(t)
+	sk, err := crypto.GenerateKey()
+	require.NoError(err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	cfg := serveMutualTLSSigner(t, lis, sk)
+
+	noTLS := cfg
+	noTLS.CACertPath, noTLS.CertPath, noTLS.KeyPath = "", "", ""
+	require.ErrorIs(noTLS.Validate(), ErrInvalidConfig)
+	require.NoError(cfg.Validate())
+	s, err := NewRemoteSigner(context.Background(), cfg)
+	require.NoError(err)
+	defer s.Stop(context.Background())
+	h := hash.Hash256b([]byte("vote"))
+	sig, err := s.Sign(h[:])
+	require.NoError(err)
+	require.True(sk.PublicKey().Verify(h[:], sig))
+
+	// a client without certificate is rejected
+	noCertTLS, err := ClientTLSConfig(cfg.CACertPath, cfg.CertPath, cfg.KeyPath)
+	require.NoError(err)
+	noCertTLS.Certificates = nil
+	conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(credentials.NewTLS(noCertTLS)))
+	require.NoError(err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.Error(conn.Invoke(ctx, _signMethod, wrapperspb.Bytes(h[:]), new(wrapperspb.BytesValue)))
+}
+
+func writeCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		// the server name of a unix socket is localhost
+		DNSNames: []string{"localhost"},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(err)
+	require.NoError(os.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(err)
+	return cert, key
+}
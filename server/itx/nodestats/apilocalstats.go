@@ -2,6 +2,7 @@ package nodestats
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -38,6 +39,8 @@ var _ StatsReporter = (*APILocalStats)(nil)
 type APILocalStats struct {
 	allTimeStats sync.Map
 	currentStats sync.Map
+	clientMutex  sync.Mutex
+	clientStats  map[string]map[string]int
 }
 
 // NewAPILocalStats creates a new APILocalStats
@@ -45,9 +48,22 @@ func NewAPILocalStats() *APILocalStats {
 	return &APILocalStats{
 		allTimeStats: sync.Map{},
 		currentStats: sync.Map{},
+		clientStats:  make(map[string]map[string]int),
 	}
 }
 
+// ReportClientCall reports the result of checking a call against the quota of the client, e.g., allowed or throttled
+func (s *APILocalStats) ReportClientCall(client, result string) {
+	s.clientMutex.Lock()
+	defer s.clientMutex.Unlock()
+	results, ok := s.clientStats[client]
+	if !ok {
+		results = make(map[string]int)
+		s.clientStats[client] = results
+	}
+	results[result]++
+}
+
 // ReportCall reports a call to the API
 func (s *APILocalStats) ReportCall(report APIReport, size int64) {
 	if report.Method == "" {
@@ -92,6 +108,48 @@ func (s *APILocalStats) ReportCall(report APIReport, size int64) {
 
 // BuildReport builds a report of the API stats
 func (s *APILocalStats) BuildReport() string {
+	return s.buildCallReport() + s.buildClientReport()
+}
+
+func (s *APILocalStats) buildClientReport() string {
+	s.clientMutex.Lock()
+	snapshot := s.clientStats
+	s.clientStats = make(map[string]map[string]int)
+	s.clientMutex.Unlock()
+	stringBuilder := strings.Builder{}
+	if len(snapshot) == 0 {
+		return stringBuilder.String()
+	}
+	const reportHeader = "client                                  | " +
+		"  allowed | " +
+		"   denied | " +
+		"throttled | " +
+		"unauthenticated |"
+	stringBuilder.WriteString("***** API CLIENT report *****\n")
+	divider := strings.Repeat("-", len(reportHeader))
+	stringBuilder.WriteString(divider + "\n")
+	stringBuilder.WriteString(reportHeader + "\n")
+	stringBuilder.WriteString(divider + "\n")
+	clients := make([]string, 0, len(snapshot))
+	for client := range snapshot {
+		clients = append(clients, client)
+	}
+	sort.Strings(clients)
+	for _, client := range clients {
+		results := snapshot[client]
+		stringBuilder.WriteString(fmt.Sprintf("%-40s| %9d | %9d | %9d | %15d |\n",
+			client,
+			results["allowed"],
+			results["denied"],
+			results["throttled"],
+			results["unauthenticated"],
+		))
+	}
+	stringBuilder.WriteString(divider + "\n")
+	return stringBuilder.String()
+}
+
+func (s *APILocalStats) buildCallReport() string {
 	var snapshot sync.Map
 	snapshotLen := 0
 	s.currentStats.Range(func(key, value interface{}) bool {
@@ -29,3 +29,19 @@ func TestRPCLocalStats(t *testing.T) {
 	report := stats.BuildReport()
 	t.Log(report)
 }
+
+func TestAPIClientStats(t *testing.T) {
+	require := require.New(t)
+	stats := NewAPILocalStats()
+	require.Empty(stats.BuildReport())
+	stats.ReportClientCall("alice", "allowed")
+	stats.ReportClientCall("alice", "allowed")
+	stats.ReportClientCall("alice", "throttled")
+	stats.ReportClientCall("unknown", "unauthenticated")
+	require.Equal(2, stats.clientStats["alice"]["allowed"])
+	report := stats.BuildReport()
+	require.Contains(report, "API CLIENT report")
+	require.Contains(report, "alice")
+	require.Empty(stats.clientStats)
+	require.Empty(stats.BuildReport())
+}
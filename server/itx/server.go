@@ -247,11 +247,23 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 		}()
 	}
 
+	haCtl, err := newHAController(svr, cfg)
+	if err != nil {
+		log.L().Panic("Failed to create HA controller.", zap.Error(err))
+	}
+	if err := haCtl.Start(ctx); err != nil {
+		log.L().Panic("Failed to start HA controller.", zap.Error(err))
+	}
+	defer func() {
+		if err := haCtl.Stop(context.Background()); err != nil {
+			log.L().Error("Failed to stop HA controller.", zap.Error(err))
+		}
+	}()
+
 	var adminserv http.Server
 	if cfg.System.HTTPAdminPort > 0 {
 		mux := http.NewServeMux()
 		log.RegisterLevelConfigMux(mux)
-		haCtl := ha.New(svr.rootChainService.Consensus())
 		mux.Handle("/ha", http.HandlerFunc(haCtl.Handle))
 		mux.Handle("/debug/pprof/", http.HandlerFunc(pprof.Index))
 		mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
@@ -285,3 +297,18 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 		log.L().Panic("Failed to turn off probe server.", zap.Error(err))
 	}
 }
+
+func newHAController(svr *Server, cfg config.Config) (*ha.Controller, error) {
+	lease, err := ha.NewLease(cfg.HA)
+	if err != nil {
+		return nil, err
+	}
+	if lease == nil {
+		return ha.New(svr.rootChainService.Consensus()), nil
+	}
+	opts := []ha.Option{ha.WithLease(lease, cfg.HA)}
+	if fencer, ok := svr.rootChainService.ProducerSigner().(ha.Fencer); ok {
+		opts = append(opts, ha.WithFencer(fencer))
+	}
+	return ha.New(svr.rootChainService.Consensus(), opts...), nil
+}
@@ -38,6 +38,15 @@ func LegacyNonceAccountTypeOption() AccountCreationOption {
 	}
 }
 
+// PendingNonceOption is an option to create a zero-nonce type account with the given pending nonce
+func PendingNonceOption(nonce uint64) AccountCreationOption {
+	return func(account *Account) error {
+		account.accountType = 1
+		account.nonce = nonce
+		return nil
+	}
+}
+
 // DelegateCandidateOption is an option to create a delegate candidate account
 func DelegateCandidateOption() AccountCreationOption {
 	return func(account *Account) error {
@@ -88,12 +88,26 @@ type (
 		NewBlockBuilder(context.Context, actpool.ActPool, func(action.Envelope) (*action.SealedEnvelope, error)) (*block.Builder, error)
 		SimulateExecution(context.Context, address.Address, *action.Execution) ([]byte, *action.Receipt, error)
 		ReadContractStorage(context.Context, address.Address, []byte) ([]byte, error)
+		// SimulateExecutionAtHeight and ReadContractStorageAtHeight run on the state at height, archive mode is
+		// required unless it's the current height
+		SimulateExecutionAtHeight(context.Context, uint64, address.Address, *action.Execution) ([]byte, *action.Receipt, error)
+		ReadContractStorageAtHeight(context.Context, uint64, address.Address, []byte) ([]byte, error)
 		PutBlock(context.Context, *block.Block) error
 		DeleteTipBlock(context.Context, *block.Block) error
 		StateAtHeight(uint64, interface{}, ...protocol.StateOption) error
 		StatesAtHeight(uint64, ...protocol.StateOption) (state.Iterator, error)
+		// ProofAtHeight returns the state root and the merkle proof of a state at height, archive mode is required
+		// unless it's the current height
+		ProofAtHeight(uint64, ...protocol.StateOption) ([]byte, [][]byte, error)
+		// ReplayBlock replays the actions of the block on the state of its parent block -- archive mode,
+		// the hook is called before each action is replayed, and the returned context is used to run the action
+		ReplayBlock(context.Context, *block.Block, func(context.Context, int, *action.SealedEnvelope) context.Context) ([]*action.Receipt, error)
+		// SimulateActions runs the actions in order on one working set on top of the state at height, which is
+		// discarded afterwards. Archive mode is required unless it's the current height, the hook is called before
+		// each action is run, and the returned context is used to run the action. A signed action failing the
+		// validation is not run, and its error is returned in place of the receipt
+		SimulateActions(context.Context, uint64, []*action.SimulatedAction, func(context.Context, int, *action.SimulatedAction) context.Context) ([]*action.Receipt, []error, error)
 	}
-
 	// factory implements StateFactory interface, tracks changes to account/contract and batch-commits to DB
 	factory struct {
 		lifecycle                lifecycle.Lifecycle
@@ -275,19 +289,54 @@ func (sf *factory) newWorkingSet(ctx context.Context, height uint64) (*workingSe
 	if err := store.Start(ctx); err != nil {
 		return nil, err
 	}
+	if err := sf.applyPatches(store, height); err != nil {
+		return nil, err
+	}
+
+	return newWorkingSet(height, store), nil
+}
+
+// newWorkingSetAtHeight creates a working set on top of the archived state at height - 1
+func (sf *factory) newWorkingSetAtHeight(ctx context.Context, height uint64) (*workingSet, error) {
+	if !sf.saveHistory {
+		return nil, ErrNoArchiveData
+	}
+	g := genesis.MustExtractGenesisContext(ctx)
+	flusher, err := db.NewKVStoreFlusher(
+		sf.dao,
+		batch.NewCachedBatch(),
+		sf.flusherOptions(!g.IsEaster(height))...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	store, err := newFactoryWorkingSetStoreAtHeight(sf.protocolView, flusher, height-1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load archived state at height %d", height-1)
+	}
+	if err := store.Start(ctx); err != nil {
+		return nil, err
+	}
+	if err := sf.applyPatches(store, height); err != nil {
+		return nil, err
+	}
+
+	return newWorkingSet(height, store), nil
+}
+
+func (sf *factory) applyPatches(store workingSetStore, height uint64) error {
 	for _, p := range sf.ps.Get(height) {
 		if p.Type == _Delete {
 			if err := store.Delete(p.Namespace, p.Key); err != nil {
-				return nil, err
+				return err
 			}
 		} else {
 			if err := store.Put(p.Namespace, p.Key, p.Value); err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
-
-	return newWorkingSet(height, store), nil
+	return nil
 }
 
 func (sf *factory) flusherOptions(preEaster bool) []db.KVStoreFlusherOption {
@@ -401,6 +450,60 @@ func (sf *factory) SimulateExecution(
 	return evm.SimulateExecution(ctx, ws, caller, ex)
 }
 
+// ReplayBlock replays the actions of the block on the archived state of its parent block, the states are not committed
+func (sf *factory) ReplayBlock(ctx context.Context, blk *block.Block, hook func(context.Context, int, *action.SealedEnvelope) context.Context) ([]*action.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "factory.ReplayBlock")
+	defer span.End()
+
+	height := blk.Height()
+	sf.mutex.RLock()
+	currentHeight := sf.currentChainHeight
+	sf.mutex.RUnlock()
+	if height == 0 || height > currentHeight {
+		return nil, errors.Errorf("invalid block height %d to replay, current height %d", height, currentHeight)
+	}
+	producer := blk.PublicKey().Address()
+	if producer == nil {
+		return nil, errors.New("failed to get address")
+	}
+	g := genesis.MustExtractGenesisContext(ctx)
+	ctx = protocol.WithBlockCtx(
+		protocol.WithRegistry(ctx, sf.registry),
+		protocol.BlockCtx{
+			BlockHeight:    height,
+			BlockTimeStamp: blk.Timestamp(),
+			GasLimit:       g.BlockGasLimitByHeight(height),
+			Producer:       producer,
+			BaseFee:        blk.BaseFee(),
+		},
+	)
+	ctx = protocol.WithFeatureCtx(ctx)
+	ws, err := sf.newWorkingSetAtHeight(ctx, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+	defer ws.store.Stop(ctx)
+
+	// the block is processed the same way as it is validated, including the pre-states of the protocols
+	if err := ws.process(ctx, blk.RunnableActions().Actions(), hook); err != nil {
+		return nil, errors.Wrapf(err, "failed to replay block %d", height)
+	}
+	return ws.receipts, nil
+}
+
+// SimulateActions runs the actions in order on the state at height, the states are not committed
+func (sf *factory) SimulateActions(ctx context.Context, height uint64, acts []*action.SimulatedAction, hook func(context.Context, int, *action.SimulatedAction) context.Context) ([]*action.Receipt, []error, error) {
+	ctx, span := tracer.NewSpan(ctx, "factory.SimulateActions")
+	defer span.End()
+
+	ws, err := sf.workingSetAtHeight(ctx, height)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+	defer ws.store.Stop(ctx)
+	return ws.simulateActions(protocol.WithRegistry(ctx, sf.registry), acts, hook)
+}
+
 // ReadContractStorage reads contract's storage
 func (sf *factory) ReadContractStorage(ctx context.Context, contract address.Address, key []byte) ([]byte, error) {
 	sf.mutex.Lock()
@@ -412,6 +515,45 @@ func (sf *factory) ReadContractStorage(ctx context.Context, contract address.Add
 	return evm.ReadContractStorage(ctx, ws, contract, key)
 }
 
+// SimulateExecutionAtHeight simulates a running of smart contract operation on the state at height
+func (sf *factory) SimulateExecutionAtHeight(ctx context.Context, height uint64, caller address.Address, ex *action.Execution) ([]byte, *action.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "factory.SimulateExecutionAtHeight")
+	defer span.End()
+
+	ws, err := sf.workingSetAtHeight(ctx, height)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to obtain working set from state factory")
+	}
+	defer ws.store.Stop(ctx)
+	return evm.SimulateExecution(ctx, ws, caller, ex)
+}
+
+// ReadContractStorageAtHeight reads contract's storage at height
+func (sf *factory) ReadContractStorageAtHeight(ctx context.Context, height uint64, contract address.Address, key []byte) ([]byte, error) {
+	ws, err := sf.workingSetAtHeight(ctx, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate working set from state factory")
+	}
+	defer ws.store.Stop(ctx)
+	return evm.ReadContractStorage(ctx, ws, contract, key)
+}
+
+// workingSetAtHeight creates a working set on top of the state at height, archive mode is required unless it's the
+// current height
+func (sf *factory) workingSetAtHeight(ctx context.Context, height uint64) (*workingSet, error) {
+	sf.mutex.Lock()
+	currentHeight := sf.currentChainHeight
+	if height == currentHeight {
+		defer sf.mutex.Unlock()
+		return sf.newWorkingSet(ctx, height+1)
+	}
+	sf.mutex.Unlock()
+	if height > currentHeight {
+		return nil, errors.Errorf("query height %d is higher than tip height %d", height, currentHeight)
+	}
+	return sf.newWorkingSetAtHeight(ctx, height+1)
+}
+
 // PutBlock persists all changes in RunActions() into the DB
 func (sf *factory) PutBlock(ctx context.Context, blk *block.Block) error {
 	sf.mutex.Lock()
@@ -430,6 +572,7 @@ func (sf *factory) PutBlock(ctx context.Context, blk *block.Block) error {
 			BlockTimeStamp: blk.Timestamp(),
 			GasLimit:       g.BlockGasLimitByHeight(blk.Height()),
 			Producer:       producer,
+			BaseFee:        blk.BaseFee(),
 		},
 	)
 	ctx = protocol.WithFeatureCtx(ctx)
@@ -502,6 +645,47 @@ func (sf *factory) StateAtHeight(height uint64, s interface{}, opts ...protocol.
 	return sf.stateAtHeight(height, cfg.Namespace, cfg.Key, s)
 }
 
+// ProofAtHeight returns the state root and the merkle proof of a state at height
+func (sf *factory) ProofAtHeight(height uint64, opts ...protocol.StateOption) ([]byte, [][]byte, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+	cfg, err := processOptions(opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg.Keys != nil {
+		return nil, nil, errors.Wrap(ErrNotSupported, "Read proof with keys option has not been implemented yet")
+	}
+	if height > sf.currentChainHeight {
+		return nil, nil, errors.Errorf("query height %d is higher than tip height %d", height, sf.currentChainHeight)
+	}
+	rootKey := ArchiveTrieRootKey
+	if height != sf.currentChainHeight {
+		if !sf.saveHistory {
+			return nil, nil, ErrNoArchiveData
+		}
+		rootKey = fmt.Sprintf("%s-%d", ArchiveTrieRootKey, height)
+	}
+	tlt, err := newTwoLayerTrie(ArchiveTrieNamespace, sf.dao, rootKey, false)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to generate trie for %d", height)
+	}
+	if err := tlt.Start(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	defer tlt.Stop(context.Background())
+
+	rootHash, err := tlt.RootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := tlt.Proof(namespaceKey(cfg.Namespace), toLegacyKey(cfg.Key))
+	if err != nil {
+		return nil, nil, err
+	}
+	return rootHash, proof, nil
+}
+
 // StatesAtHeight returns a set states in the state factory at height -- archive mode
 func (sf *factory) StatesAtHeight(height uint64, opts ...protocol.StateOption) (state.Iterator, error) {
 	sf.mutex.RLock()
@@ -25,6 +25,7 @@ import (
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/iotexproject/iotex-election/test/mock/mock_committee"
 	"github.com/iotexproject/iotex-election/types"
+	"github.com/iotexproject/iotex-proto/golang/iotextypes"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
@@ -39,6 +40,7 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/util/fileutil"
 	"github.com/iotexproject/iotex-core/state"
@@ -510,6 +512,8 @@ func testHistoryState(sf Factory, t *testing.T, statetx, archive bool) {
 	priKeyA := identityset.PrivateKey(28)
 	acc := account.NewProtocol(rewarding.DepositGas)
 	require.NoError(t, sf.Register(acc))
+	preStates := &testPreStatesProtocol{}
+	require.NoError(t, sf.Register(preStates))
 	ge := genesis.Default
 	ge.InitBalanceMap[a.String()] = "100"
 	gasLimit := uint64(1000000)
@@ -589,6 +593,87 @@ func testHistoryState(sf Factory, t *testing.T, statetx, archive bool) {
 			require.Equal(t, big.NewInt(0), accountB.Balance)
 		}
 	}
+
+	// check merkle proof
+	for _, e := range []struct {
+		height  uint64
+		balance *big.Int
+	}{
+		{1, big.NewInt(90)},
+		{0, big.NewInt(100)},
+	} {
+		root, proof, err := sf.ProofAtHeight(e.height, protocol.LegacyKeyOption(hash.BytesToHash160(a.Bytes())))
+		switch {
+		case statetx:
+			require.Equal(t, ErrNotSupported, errors.Cause(err))
+		case !archive && e.height == 0:
+			require.Equal(t, ErrNoArchiveData, errors.Cause(err))
+		default:
+			require.NoError(t, err)
+			value, err := mptrie.VerifyTwoLayerProof(root, namespaceKey(AccountKVNamespace), toLegacyKey(a.Bytes()), proof)
+			require.NoError(t, err)
+			acct := &state.Account{}
+			require.NoError(t, acct.Deserialize(value))
+			require.Equal(t, e.balance, acct.Balance)
+		}
+	}
+
+	// replay the block on the state of its parent
+	var replayed []int
+	receipts, err := sf.ReplayBlock(ctx, &blk, func(ctx context.Context, i int, _ *action.SealedEnvelope) context.Context {
+		replayed = append(replayed, i)
+		return ctx
+	})
+	switch {
+	case statetx:
+		require.Equal(t, ErrNotSupported, errors.Cause(err))
+	case !archive:
+		require.Equal(t, ErrNoArchiveData, errors.Cause(err))
+	default:
+		require.NoError(t, err)
+		require.Equal(t, []int{0}, replayed)
+		require.Len(t, receipts, 1)
+		require.Equal(t, uint64(iotextypes.ReceiptStatus_Success), receipts[0].Status)
+		require.Equal(t, blk.Receipts[0].Hash(), receipts[0].Hash())
+		// the pre-states are created as the block is committed
+		require.Equal(t, []uint64{1, 1}, preStates.heights)
+		// replay doesn't change the committed states
+		accountA, err = accountutil.AccountState(ctx, sf, a)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(90), accountA.Balance)
+		_, err = sf.ReplayBlock(ctx, &blk, nil)
+		require.NoError(t, err)
+	}
+}
+
+// testPreStatesProtocol records the heights at which the pre-states are created
+type testPreStatesProtocol struct {
+	heights []uint64
+}
+
+func (p *testPreStatesProtocol) CreatePreStates(ctx context.Context, _ protocol.StateManager) error {
+	p.heights = append(p.heights, protocol.MustGetBlockCtx(ctx).BlockHeight)
+	return nil
+}
+
+func (p *testPreStatesProtocol) Handle(context.Context, action.Action, protocol.StateManager) (*action.Receipt, error) {
+	return nil, nil
+}
+
+func (p *testPreStatesProtocol) ReadState(context.Context, protocol.StateReader, []byte, ...[]byte) ([]byte, uint64, error) {
+	return nil, 0, protocol.ErrUnimplemented
+}
+
+func (p *testPreStatesProtocol) Register(r *protocol.Registry) error {
+	return r.Register(p.Name(), p)
+}
+
+func (p *testPreStatesProtocol) ForceRegister(r *protocol.Registry) error {
+	return r.ForceRegister(p.Name(), p)
+}
+
+func (p *testPreStatesProtocol) Name() string {
+	return "test_pre_states"
 }
 
 func testFactoryStates(sf Factory, t *testing.T) {
@@ -294,6 +294,36 @@ func (sdb *stateDB) ReadContractStorage(ctx context.Context, contract address.Ad
 	return evm.ReadContractStorage(ctx, ws, contract, key)
 }
 
+// SimulateExecutionAtHeight simulates a running of smart contract operation on the state at height, which must be
+// the current height
+func (sdb *stateDB) SimulateExecutionAtHeight(ctx context.Context, height uint64, caller address.Address, ex *action.Execution) ([]byte, *action.Receipt, error) {
+	if err := sdb.checkCurrentHeight(height); err != nil {
+		return nil, nil, err
+	}
+	return sdb.SimulateExecution(ctx, caller, ex)
+}
+
+// ReadContractStorageAtHeight reads contract's storage at height, which must be the current height
+func (sdb *stateDB) ReadContractStorageAtHeight(ctx context.Context, height uint64, contract address.Address, key []byte) ([]byte, error) {
+	if err := sdb.checkCurrentHeight(height); err != nil {
+		return nil, err
+	}
+	return sdb.ReadContractStorage(ctx, contract, key)
+}
+
+func (sdb *stateDB) checkCurrentHeight(height uint64) error {
+	sdb.mutex.RLock()
+	currHeight := sdb.currentChainHeight
+	sdb.mutex.RUnlock()
+	if height > currHeight {
+		return errors.Errorf("query height %d is higher than tip height %d", height, currHeight)
+	}
+	if height < currHeight {
+		return errors.Wrap(ErrNotSupported, "state db does not support archive mode")
+	}
+	return nil
+}
+
 // PutBlock persists all changes in RunActions() into the DB
 func (sdb *stateDB) PutBlock(ctx context.Context, blk *block.Block) error {
 	sdb.mutex.Lock()
@@ -312,6 +342,7 @@ func (sdb *stateDB) PutBlock(ctx context.Context, blk *block.Block) error {
 			BlockTimeStamp: blk.Timestamp(),
 			GasLimit:       g.BlockGasLimitByHeight(blk.Height()),
 			Producer:       producer,
+			BaseFee:        blk.BaseFee(),
 		},
 	)
 	ctx = protocol.WithFeatureCtx(ctx)
@@ -401,6 +432,31 @@ func (sdb *stateDB) StatesAtHeight(height uint64, opts ...protocol.StateOption)
 	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
 }
 
+// ProofAtHeight returns the state root and the merkle proof of a state at height
+func (sdb *stateDB) ProofAtHeight(uint64, ...protocol.StateOption) ([]byte, [][]byte, error) {
+	return nil, nil, errors.Wrap(ErrNotSupported, "state db does not support merkle proof")
+}
+
+// ReplayBlock replays the actions of the block on the state of its parent block -- archive mode
+func (sdb *stateDB) ReplayBlock(context.Context, *block.Block, func(context.Context, int, *action.SealedEnvelope) context.Context) ([]*action.Receipt, error) {
+	return nil, errors.Wrap(ErrNotSupported, "state db does not support archive mode")
+}
+
+// SimulateActions runs the actions in order on the state at height, which must be the current height
+func (sdb *stateDB) SimulateActions(ctx context.Context, height uint64, acts []*action.SimulatedAction, hook func(context.Context, int, *action.SimulatedAction) context.Context) ([]*action.Receipt, []error, error) {
+	ctx, span := tracer.NewSpan(ctx, "stateDB.SimulateActions")
+	defer span.End()
+
+	if err := sdb.checkCurrentHeight(height); err != nil {
+		return nil, nil, err
+	}
+	ws, err := sdb.newWorkingSet(ctx, height+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ws.simulateActions(protocol.WithRegistry(ctx, sdb.registry), acts, hook)
+}
+
 // ReadView reads the view
 func (sdb *stateDB) ReadView(name string) (interface{}, error) {
 	return sdb.protocolView.Read(name)
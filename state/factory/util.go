@@ -56,6 +56,14 @@ func calculateReceiptRoot(receipts []*action.Receipt) hash.Hash256 {
 	return res
 }
 
+func calculateGasUsed(receipts []*action.Receipt) uint64 {
+	var gas uint64
+	for _, r := range receipts {
+		gas += r.GasConsumed
+	}
+	return gas
+}
+
 func calculateLogsBloom(ctx context.Context, receipts []*action.Receipt) bloom.BloomFilter {
 	blkCtx := protocol.MustGetBlockCtx(ctx)
 	g := genesis.MustExtractGenesisContext(ctx)
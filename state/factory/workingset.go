@@ -99,14 +99,18 @@ func (ws *workingSet) validate(ctx context.Context) error {
 func (ws *workingSet) runActions(
 	ctx context.Context,
 	elps []*action.SealedEnvelope,
+	hook func(context.Context, int, *action.SealedEnvelope) context.Context,
 ) ([]*action.Receipt, error) {
 	// Handle actions
 	receipts := make([]*action.Receipt, 0)
-	for _, elp := range elps {
+	for i, elp := range elps {
 		ctxWithActionContext, err := withActionCtx(ctx, elp)
 		if err != nil {
 			return nil, err
 		}
+		if hook != nil {
+			ctxWithActionContext = hook(ctxWithActionContext, i, elp)
+		}
 		receipt, err := ws.runAction(ctxWithActionContext, elp)
 		if err != nil {
 			return nil, errors.Wrap(err, "error when run action")
@@ -120,24 +124,32 @@ func (ws *workingSet) runActions(
 }
 
 func withActionCtx(ctx context.Context, selp *action.SealedEnvelope) (context.Context, error) {
-	var actionCtx protocol.ActionCtx
-	var err error
 	caller := selp.SenderAddress()
 	if caller == nil {
 		return nil, errors.New("failed to get address")
 	}
-	actionCtx.Caller = caller
-	actionCtx.ActionHash, err = selp.Hash()
+	actHash, err := selp.Hash()
 	if err != nil {
 		return nil, err
 	}
-	actionCtx.GasPrice = selp.GasPrice()
-	intrinsicGas, err := selp.IntrinsicGas()
+	return withEnvelopeActionCtx(ctx, caller, actHash, selp.Envelope)
+}
+
+func withEnvelopeActionCtx(ctx context.Context, caller address.Address, actHash hash.Hash256, elp action.Envelope) (context.Context, error) {
+	var actionCtx protocol.ActionCtx
+	actionCtx.Caller = caller
+	actionCtx.ActionHash = actHash
+	if blkCtx, ok := protocol.GetBlockCtx(ctx); ok {
+		actionCtx.GasPrice = elp.EffectiveGasPrice(blkCtx.BaseFee)
+	} else {
+		actionCtx.GasPrice = elp.GasPrice()
+	}
+	intrinsicGas, err := elp.IntrinsicGas()
 	if err != nil {
 		return nil, err
 	}
 	actionCtx.IntrinsicGas = intrinsicGas
-	actionCtx.Nonce = selp.Nonce()
+	actionCtx.Nonce = elp.Nonce()
 
 	return protocol.WithActionCtx(ctx, actionCtx), nil
 }
@@ -145,42 +157,51 @@ func withActionCtx(ctx context.Context, selp *action.SealedEnvelope) (context.Co
 func (ws *workingSet) runAction(
 	ctx context.Context,
 	selp *action.SealedEnvelope,
+) (*action.Receipt, error) {
+	// for replay tx, check against deployer whitelist
+	g := genesis.MustExtractGenesisContext(ctx)
+	if selp.Encoding() == uint32(iotextypes.Encoding_ETHEREUM_UNPROTECTED) && !g.IsDeployerWhitelisted(selp.SenderAddress()) {
+		return nil, errors.Errorf("replay deployer %v not whitelisted", selp.SenderAddress().String())
+	}
+	return ws.handleAction(ctx, selp.Envelope, action.IsSystemAction(selp))
+}
+
+// handleAction handles the action by the protocols in the registry
+func (ws *workingSet) handleAction(
+	ctx context.Context,
+	elp action.Envelope,
+	isSystemAction bool,
 ) (*action.Receipt, error) {
 	actCtx := protocol.MustGetActionCtx(ctx)
 	if protocol.MustGetBlockCtx(ctx).GasLimit < actCtx.IntrinsicGas {
 		return nil, action.ErrGasLimit
 	}
 	// Reject execution of chainID not equal the node's chainID
-	if !action.IsSystemAction(selp) {
-		if err := validateChainID(ctx, selp.ChainID()); err != nil {
+	if !isSystemAction {
+		if err := validateChainID(ctx, elp.ChainID()); err != nil {
 			return nil, err
 		}
-	}
-	// for replay tx, check against deployer whitelist
-	g := genesis.MustExtractGenesisContext(ctx)
-	if selp.Encoding() == uint32(iotextypes.Encoding_ETHEREUM_UNPROTECTED) && !g.IsDeployerWhitelisted(selp.SenderAddress()) {
-		return nil, errors.Errorf("replay deployer %v not whitelisted", selp.SenderAddress().String())
+		// Reject execution of gas fee cap lower than the block's base fee
+		if baseFee := protocol.MustGetBlockCtx(ctx).BaseFee; baseFee != nil && elp.GasFeeCap().Cmp(baseFee) < 0 {
+			return nil, errors.Wrapf(action.ErrUnderpriced, "gas fee cap %s lower than base fee %s", elp.GasFeeCap(), baseFee)
+		}
 	}
 	// Handle action
 	reg, ok := protocol.GetRegistry(ctx)
 	if !ok {
 		return nil, errors.New("protocol is empty")
 	}
-	selpHash, err := selp.Hash()
-	if err != nil {
-		return nil, errors.Wrapf(err, "Failed to get hash")
-	}
 	defer ws.ResetSnapshots()
 	if err := ws.freshAccountConversion(ctx, &actCtx); err != nil {
 		return nil, err
 	}
 	for _, actionHandler := range reg.All() {
-		receipt, err := actionHandler.Handle(ctx, selp.Action(), ws)
+		receipt, err := actionHandler.Handle(ctx, elp.Action(), ws)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
 				"error when action %x mutates states",
-				selpHash,
+				actCtx.ActionHash,
 			)
 		}
 		if receipt != nil {
@@ -202,6 +223,130 @@ func validateChainID(ctx context.Context, chainID uint32) error {
 	return nil
 }
 
+// simulateActions runs the actions in order in the block following the tip of the blockchain context, the base fee
+// is not charged so that calls with zero gas price can be simulated. A signed action which fails the validation is not
+// run, its error is returned in place of the receipt
+func (ws *workingSet) simulateActions(
+	ctx context.Context,
+	acts []*action.SimulatedAction,
+	hook func(context.Context, int, *action.SimulatedAction) context.Context,
+) ([]*action.Receipt, []error, error) {
+	var (
+		bcCtx  = protocol.MustGetBlockchainCtx(ctx)
+		g      = genesis.MustExtractGenesisContext(ctx)
+		height = bcCtx.Tip.Height + 1
+	)
+	zeroAddr, err := address.FromString(address.ZeroAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx = protocol.WithFeatureCtx(protocol.WithBlockCtx(ctx, protocol.BlockCtx{
+		BlockHeight:    height,
+		BlockTimeStamp: bcCtx.Tip.Timestamp.Add(g.BlockInterval),
+		GasLimit:       g.BlockGasLimitByHeight(height),
+		Producer:       zeroAddr,
+	}))
+	fCtx := protocol.MustGetFeatureCtx(ctx)
+	var (
+		receipts = make([]*action.Receipt, len(acts))
+		errs     = make([]error, len(acts))
+	)
+	for i, act := range acts {
+		var actCtx context.Context
+		if selp := act.SealedEnvelope(); selp != nil {
+			if actCtx, err = withActionCtx(ctx, selp); err != nil {
+				return nil, nil, err
+			}
+			if errs[i] = ws.validateSimulatedAction(actCtx, selp); errs[i] != nil {
+				continue
+			}
+		} else {
+			// an unsigned call always uses the pending nonce of its caller
+			sender, err := accountutil.AccountState(ctx, ws, act.Caller())
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to get the account of caller %s", act.Caller().String())
+			}
+			// the envelope is reloaded so that the payload also carries the nonce
+			pb := act.Envelope().Proto()
+			if fCtx.RefactorFreshAccountConversion {
+				pb.Nonce = sender.PendingNonceConsideringFreshAccount()
+			} else {
+				pb.Nonce = sender.PendingNonce()
+			}
+			if err := act.Envelope().LoadProto(pb); err != nil {
+				return nil, nil, err
+			}
+			actHash, err := act.Hash()
+			if err != nil {
+				return nil, nil, err
+			}
+			if actCtx, err = withEnvelopeActionCtx(ctx, act.Caller(), actHash, act.Envelope()); err != nil {
+				return nil, nil, err
+			}
+		}
+		if hook != nil {
+			actCtx = hook(actCtx, i, act)
+		}
+		if selp := act.SealedEnvelope(); selp != nil {
+			receipts[i], err = ws.runAction(actCtx, selp)
+		} else {
+			receipts[i], err = ws.handleAction(actCtx, act.Envelope(), false)
+		}
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to simulate action %d", i)
+		}
+	}
+	if fCtx.CorrectTxLogIndex {
+		updateReceiptIndex(simulatedReceipts(receipts))
+	}
+	return receipts, errs, nil
+}
+
+// validateSimulatedAction validates the signed action as the action pool does, the nonce must be the pending nonce of
+// the sender, whose balance covers the cost of the action
+func (ws *workingSet) validateSimulatedAction(ctx context.Context, selp *action.SealedEnvelope) error {
+	if err := protocol.NewGenericValidator(ws, accountutil.AccountState).Validate(ctx, selp); err != nil {
+		return err
+	}
+	for _, p := range protocol.MustGetRegistry(ctx).All() {
+		if validator, ok := p.(protocol.ActionValidator); ok {
+			if err := validator.Validate(ctx, selp.Action(), ws); err != nil {
+				return err
+			}
+		}
+	}
+	sender, err := accountutil.AccountState(ctx, ws, selp.SenderAddress())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the account of sender %s", selp.SenderAddress().String())
+	}
+	nonce := sender.PendingNonce()
+	if protocol.MustGetFeatureCtx(ctx).UseZeroNonceForFreshAccount {
+		nonce = sender.PendingNonceConsideringFreshAccount()
+	}
+	if selp.Nonce() > nonce {
+		return errors.Wrapf(action.ErrNonceTooHigh, "nonce %d, expecting %d", selp.Nonce(), nonce)
+	}
+	cost, err := selp.Cost()
+	if err != nil {
+		return err
+	}
+	if sender.Balance.Cmp(cost) < 0 {
+		return errors.Wrapf(action.ErrInsufficientFunds, "balance %s, cost %s", sender.Balance, cost)
+	}
+	return nil
+}
+
+// simulatedReceipts returns the receipts of the simulated actions which are run
+func simulatedReceipts(receipts []*action.Receipt) []*action.Receipt {
+	ret := make([]*action.Receipt, 0, len(receipts))
+	for _, r := range receipts {
+		if r != nil {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
 func (ws *workingSet) finalize() error {
 	if ws.finalized {
 		return errors.New("Cannot finalize a working set twice")
@@ -422,10 +567,15 @@ func (ws *workingSet) checkNonceContinuity(ctx context.Context, accountNonceMap
 }
 
 func (ws *workingSet) Process(ctx context.Context, actions []*action.SealedEnvelope) error {
-	return ws.process(ctx, actions)
+	return ws.process(ctx, actions, nil)
 }
 
-func (ws *workingSet) process(ctx context.Context, actions []*action.SealedEnvelope) error {
+// process runs the actions of a block, the hook if not nil amends the context of each action before it is run
+func (ws *workingSet) process(
+	ctx context.Context,
+	actions []*action.SealedEnvelope,
+	hook func(context.Context, int, *action.SealedEnvelope) context.Context,
+) error {
 	if err := ws.validate(ctx); err != nil {
 		return err
 	}
@@ -452,7 +602,7 @@ func (ws *workingSet) process(ctx context.Context, actions []*action.SealedEnvel
 		}
 	}
 
-	receipts, err := ws.runActions(ctx, actions)
+	receipts, err := ws.runActions(ctx, actions, hook)
 	if err != nil {
 		return err
 	}
@@ -562,7 +712,7 @@ func (ws *workingSet) pickAndRunActions(
 				// do nothing
 			case action.ErrChainID:
 				continue
-			case action.ErrGasLimit:
+			case action.ErrGasLimit, action.ErrUnderpriced:
 				actionIterator.PopAccount()
 				continue
 			default:
@@ -614,6 +764,9 @@ func updateReceiptIndex(receipts []*action.Receipt) {
 }
 
 func (ws *workingSet) ValidateBlock(ctx context.Context, blk *block.Block) error {
+	if err := validateBaseFee(ctx, blk); err != nil {
+		return err
+	}
 	if protocol.MustGetFeatureCtx(ctx).SkipSystemActionNonce {
 		if err := ws.validateNonceSkipSystemAction(ctx, blk); err != nil {
 			return errors.Wrap(err, "failed to validate nonce")
@@ -629,7 +782,7 @@ func (ws *workingSet) ValidateBlock(ctx context.Context, blk *block.Block) error
 		}
 	}
 
-	if err := ws.process(ctx, blk.RunnableActions().Actions()); err != nil {
+	if err := ws.process(ctx, blk.RunnableActions().Actions(), nil); err != nil {
 		log.L().Error("Failed to update state.", zap.Uint64("height", ws.height), zap.Error(err))
 		return err
 	}
@@ -645,10 +798,28 @@ func (ws *workingSet) ValidateBlock(ctx context.Context, blk *block.Block) error
 	if !blk.VerifyReceiptRoot(receiptRoot) {
 		return errors.Wrapf(block.ErrReceiptRootMismatch, "receipt root in block '%x' vs receipt root in workingset '%x'", blk.ReceiptRoot(), receiptRoot)
 	}
+	if blk.BaseFee() != nil {
+		if gasUsed := calculateGasUsed(ws.receipts); blk.GasUsed() != gasUsed {
+			return errors.Wrapf(block.ErrGasUsedMismatch, "gas used in block %d vs gas used in workingset %d", blk.GasUsed(), gasUsed)
+		}
+	}
 
 	return nil
 }
 
+func validateBaseFee(ctx context.Context, blk *block.Block) error {
+	bcCtx, ok := protocol.GetBlockchainCtx(ctx)
+	if !ok {
+		return nil
+	}
+	g := genesis.MustExtractGenesisContext(ctx)
+	expected, baseFee := protocol.CalcBaseFee(g.Blockchain, &bcCtx.Tip), blk.BaseFee()
+	if (expected == nil) != (baseFee == nil) || (expected != nil && expected.Cmp(baseFee) != 0) {
+		return errors.Wrapf(block.ErrBaseFeeMismatch, "base fee in block %v vs expected base fee %v", baseFee, expected)
+	}
+	return nil
+}
+
 func (ws *workingSet) CreateBuilder(
 	ctx context.Context,
 	ap actpool.ActPool,
@@ -680,5 +851,9 @@ func (ws *workingSet) CreateBuilder(
 		SetReceipts(ws.receipts).
 		SetReceiptRoot(calculateReceiptRoot(ws.receipts)).
 		SetLogsBloom(calculateLogsBloom(ctx, ws.receipts))
+	if blkCtx.BaseFee != nil {
+		blkBuilder.SetGasUsed(calculateGasUsed(ws.receipts)).
+			SetBaseFee(blkCtx.BaseFee)
+	}
 	return blkBuilder, nil
 }
@@ -38,6 +38,21 @@ func (m *MockActPool) EXPECT() *MockActPoolMockRecorder {
 	return m.recorder
 }
 
+// ActionsBySender mocks base method.
+func (m *MockActPool) ActionsBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionsBySender")
+	ret0, _ := ret[0].(map[string][]*action.SealedEnvelope)
+	ret1, _ := ret[1].(map[string][]*action.SealedEnvelope)
+	return ret0, ret1
+}
+
+// ActionsBySender indicates an expected call of ActionsBySender.
+func (mr *MockActPoolMockRecorder) ActionsBySender() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionsBySender", reflect.TypeOf((*MockActPool)(nil).ActionsBySender))
+}
+
 // Add mocks base method.
 func (m *MockActPool) Add(ctx context.Context, act *action.SealedEnvelope) error {
 	m.ctrl.T.Helper()
@@ -220,6 +235,34 @@ func (mr *MockActPoolMockRecorder) Reset() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockActPool)(nil).Reset))
 }
 
+// Start mocks base method.
+func (m *MockActPool) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockActPoolMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockActPool)(nil).Start), arg0)
+}
+
+// Stop mocks base method.
+func (m *MockActPool) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockActPoolMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockActPool)(nil).Stop), arg0)
+}
+
 // Validate mocks base method.
 func (m *MockActPool) Validate(arg0 context.Context, arg1 *action.SealedEnvelope) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	types "github.com/ethereum/go-ethereum/core/types"
 	tracers "github.com/ethereum/go-ethereum/eth/tracers"
 	gomock "github.com/golang/mock/gomock"
 	hash "github.com/iotexproject/go-pkgs/hash"
@@ -19,6 +20,7 @@ import (
 	apitypes "github.com/iotexproject/iotex-core/api/types"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
 	genesis "github.com/iotexproject/iotex-core/blockchain/genesis"
+	blockindex "github.com/iotexproject/iotex-core/blockindex"
 	iotexapi "github.com/iotexproject/iotex-proto/golang/iotexapi"
 	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
 )
@@ -62,6 +64,21 @@ func (mr *MockCoreServiceMockRecorder) Account(addr interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Account", reflect.TypeOf((*MockCoreService)(nil).Account), addr)
 }
 
+// AccountAtHeight mocks base method.
+func (m *MockCoreService) AccountAtHeight(ctx context.Context, addr address.Address, height uint64) (*iotextypes.AccountMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccountAtHeight", ctx, addr, height)
+	ret0, _ := ret[0].(*iotextypes.AccountMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountAtHeight indicates an expected call of AccountAtHeight.
+func (mr *MockCoreServiceMockRecorder) AccountAtHeight(ctx, addr, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountAtHeight", reflect.TypeOf((*MockCoreService)(nil).AccountAtHeight), ctx, addr, height)
+}
+
 // Action mocks base method.
 func (m *MockCoreService) Action(actionHash string, checkPending bool) (*iotexapi.ActionInfo, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +156,21 @@ func (mr *MockCoreServiceMockRecorder) ActionsInActPool(actHashes interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionsInActPool", reflect.TypeOf((*MockCoreService)(nil).ActionsInActPool), actHashes)
 }
 
+// ActionsInActPoolBySender mocks base method.
+func (m *MockCoreService) ActionsInActPoolBySender() (map[string][]*action.SealedEnvelope, map[string][]*action.SealedEnvelope) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionsInActPoolBySender")
+	ret0, _ := ret[0].(map[string][]*action.SealedEnvelope)
+	ret1, _ := ret[1].(map[string][]*action.SealedEnvelope)
+	return ret0, ret1
+}
+
+// ActionsInActPoolBySender indicates an expected call of ActionsInActPoolBySender.
+func (mr *MockCoreServiceMockRecorder) ActionsInActPoolBySender() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionsInActPoolBySender", reflect.TypeOf((*MockCoreService)(nil).ActionsInActPoolBySender))
+}
+
 // BlockByHash mocks base method.
 func (m *MockCoreService) BlockByHash(arg0 string) (*apitypes.BlockWithReceipts, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +275,22 @@ func (mr *MockCoreServiceMockRecorder) ChainMeta() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainMeta", reflect.TypeOf((*MockCoreService)(nil).ChainMeta))
 }
 
+// CreateAccessList mocks base method.
+func (m *MockCoreService) CreateAccessList(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (types.AccessList, *action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessList", ctx, callerAddr, sc, height)
+	ret0, _ := ret[0].(types.AccessList)
+	ret1, _ := ret[1].(*action.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAccessList indicates an expected call of CreateAccessList.
+func (mr *MockCoreServiceMockRecorder) CreateAccessList(ctx, callerAddr, sc, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessList", reflect.TypeOf((*MockCoreService)(nil).CreateAccessList), ctx, callerAddr, sc, height)
+}
+
 // EVMNetworkID mocks base method.
 func (m *MockCoreService) EVMNetworkID() uint32 {
 	m.ctrl.T.Helper()
@@ -334,6 +382,24 @@ func (mr *MockCoreServiceMockRecorder) EstimateGasForNonExecution(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasForNonExecution", reflect.TypeOf((*MockCoreService)(nil).EstimateGasForNonExecution), arg0)
 }
 
+// FeeHistory mocks base method.
+func (m *MockCoreService) FeeHistory(ctx context.Context, blocks, lastBlock uint64, rewardPercentiles []float64) (uint64, [][]*big.Int, []*big.Int, []float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blocks, lastBlock, rewardPercentiles)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].([][]*big.Int)
+	ret2, _ := ret[2].([]*big.Int)
+	ret3, _ := ret[3].([]float64)
+	ret4, _ := ret[4].(error)
+	return ret0, ret1, ret2, ret3, ret4
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockCoreServiceMockRecorder) FeeHistory(ctx, blocks, lastBlock, rewardPercentiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockCoreService)(nil).FeeHistory), ctx, blocks, lastBlock, rewardPercentiles)
+}
+
 // Genesis mocks base method.
 func (m *MockCoreService) Genesis() genesis.Genesis {
 	m.ctrl.T.Helper()
@@ -348,6 +414,36 @@ func (mr *MockCoreServiceMockRecorder) Genesis() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Genesis", reflect.TypeOf((*MockCoreService)(nil).Genesis))
 }
 
+// IndexerStatus mocks base method.
+func (m *MockCoreService) IndexerStatus() []*apitypes.IndexerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexerStatus")
+	ret0, _ := ret[0].([]*apitypes.IndexerStatus)
+	return ret0
+}
+
+// IndexerStatus indicates an expected call of IndexerStatus.
+func (mr *MockCoreServiceMockRecorder) IndexerStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexerStatus", reflect.TypeOf((*MockCoreService)(nil).IndexerStatus))
+}
+
+// InternalTransfersByAddress mocks base method.
+func (m *MockCoreService) InternalTransfersByAddress(addr address.Address, start, count uint64) ([]*blockindex.InternalTransfer, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InternalTransfersByAddress", addr, start, count)
+	ret0, _ := ret[0].([]*blockindex.InternalTransfer)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InternalTransfersByAddress indicates an expected call of InternalTransfersByAddress.
+func (mr *MockCoreServiceMockRecorder) InternalTransfersByAddress(addr, start, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InternalTransfersByAddress", reflect.TypeOf((*MockCoreService)(nil).InternalTransfersByAddress), addr, start, count)
+}
+
 // LogsInBlockByHash mocks base method.
 func (m *MockCoreService) LogsInBlockByHash(filter *logfilter.LogFilter, blockHash hash.Hash256) ([]*action.Log, error) {
 	m.ctrl.T.Helper()
@@ -379,6 +475,21 @@ func (mr *MockCoreServiceMockRecorder) LogsInRange(filter, start, end, paginatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogsInRange", reflect.TypeOf((*MockCoreService)(nil).LogsInRange), filter, start, end, paginationSize)
 }
 
+// LowestBlockHeight mocks base method.
+func (m *MockCoreService) LowestBlockHeight() (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LowestBlockHeight")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LowestBlockHeight indicates an expected call of LowestBlockHeight.
+func (mr *MockCoreServiceMockRecorder) LowestBlockHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LowestBlockHeight", reflect.TypeOf((*MockCoreService)(nil).LowestBlockHeight))
+}
+
 // PendingActionByActionHash mocks base method.
 func (m *MockCoreService) PendingActionByActionHash(h hash.Hash256) (*action.SealedEnvelope, error) {
 	m.ctrl.T.Helper()
@@ -440,6 +551,22 @@ func (mr *MockCoreServiceMockRecorder) ReadContract(ctx, callerAddr, sc interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContract", reflect.TypeOf((*MockCoreService)(nil).ReadContract), ctx, callerAddr, sc)
 }
 
+// ReadContractAtHeight mocks base method.
+func (m *MockCoreService) ReadContractAtHeight(ctx context.Context, callerAddr address.Address, sc *action.Execution, height uint64) (string, *iotextypes.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadContractAtHeight", ctx, callerAddr, sc, height)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*iotextypes.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadContractAtHeight indicates an expected call of ReadContractAtHeight.
+func (mr *MockCoreServiceMockRecorder) ReadContractAtHeight(ctx, callerAddr, sc, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractAtHeight", reflect.TypeOf((*MockCoreService)(nil).ReadContractAtHeight), ctx, callerAddr, sc, height)
+}
+
 // ReadContractStorage mocks base method.
 func (m *MockCoreService) ReadContractStorage(ctx context.Context, addr address.Address, key []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -455,6 +582,21 @@ func (mr *MockCoreServiceMockRecorder) ReadContractStorage(ctx, addr, key interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorage", reflect.TypeOf((*MockCoreService)(nil).ReadContractStorage), ctx, addr, key)
 }
 
+// ReadContractStorageAtHeight mocks base method.
+func (m *MockCoreService) ReadContractStorageAtHeight(ctx context.Context, addr address.Address, key []byte, height uint64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadContractStorageAtHeight", ctx, addr, key, height)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadContractStorageAtHeight indicates an expected call of ReadContractStorageAtHeight.
+func (mr *MockCoreServiceMockRecorder) ReadContractStorageAtHeight(ctx, addr, key, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorageAtHeight", reflect.TypeOf((*MockCoreService)(nil).ReadContractStorageAtHeight), ctx, addr, key, height)
+}
+
 // ReadState mocks base method.
 func (m *MockCoreService) ReadState(protocolID, height string, methodName []byte, arguments [][]byte) (*iotexapi.ReadStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -532,6 +674,23 @@ func (mr *MockCoreServiceMockRecorder) ServerMeta() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerMeta", reflect.TypeOf((*MockCoreService)(nil).ServerMeta))
 }
 
+// SimulateActions mocks base method.
+func (m *MockCoreService) SimulateActions(ctx context.Context, height uint64, acts []*action.SimulatedAction) ([][]byte, []*action.Receipt, []error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateActions", ctx, height, acts)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].([]*action.Receipt)
+	ret2, _ := ret[2].([]error)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SimulateActions indicates an expected call of SimulateActions.
+func (mr *MockCoreServiceMockRecorder) SimulateActions(ctx, height, acts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateActions", reflect.TypeOf((*MockCoreService)(nil).SimulateActions), ctx, height, acts)
+}
+
 // SimulateExecution mocks base method.
 func (m *MockCoreService) SimulateExecution(arg0 context.Context, arg1 address.Address, arg2 *action.Execution) ([]byte, *action.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -562,6 +721,21 @@ func (mr *MockCoreServiceMockRecorder) Start(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockCoreService)(nil).Start), ctx)
 }
 
+// StateProof mocks base method.
+func (m *MockCoreService) StateProof(ctx context.Context, addr address.Address, storageKeys []hash.Hash256, height uint64) (*apitypes.AccountProof, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateProof", ctx, addr, storageKeys, height)
+	ret0, _ := ret[0].(*apitypes.AccountProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateProof indicates an expected call of StateProof.
+func (mr *MockCoreServiceMockRecorder) StateProof(ctx, addr, storageKeys, height interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateProof", reflect.TypeOf((*MockCoreService)(nil).StateProof), ctx, addr, storageKeys, height)
+}
+
 // Stop mocks base method.
 func (m *MockCoreService) Stop(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -591,6 +765,21 @@ func (mr *MockCoreServiceMockRecorder) SuggestGasPrice() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockCoreService)(nil).SuggestGasPrice))
 }
 
+// SuggestGasTipCap mocks base method.
+func (m *MockCoreService) SuggestGasTipCap() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasTipCap")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasTipCap indicates an expected call of SuggestGasTipCap.
+func (mr *MockCoreServiceMockRecorder) SuggestGasTipCap() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasTipCap", reflect.TypeOf((*MockCoreService)(nil).SuggestGasTipCap))
+}
+
 // SyncingProgress mocks base method.
 func (m *MockCoreService) SyncingProgress() (uint64, uint64, uint64) {
 	m.ctrl.T.Helper()
@@ -621,10 +810,58 @@ func (mr *MockCoreServiceMockRecorder) TipHeight() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TipHeight", reflect.TypeOf((*MockCoreService)(nil).TipHeight))
 }
 
+// TokenTransfersByAddress mocks base method.
+func (m *MockCoreService) TokenTransfersByAddress(addr, token address.Address, start, count uint64) ([]*blockindex.TokenTransfer, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenTransfersByAddress", addr, token, start, count)
+	ret0, _ := ret[0].([]*blockindex.TokenTransfer)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TokenTransfersByAddress indicates an expected call of TokenTransfersByAddress.
+func (mr *MockCoreServiceMockRecorder) TokenTransfersByAddress(addr, token, start, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenTransfersByAddress", reflect.TypeOf((*MockCoreService)(nil).TokenTransfersByAddress), addr, token, start, count)
+}
+
+// TokenTransfersByToken mocks base method.
+func (m *MockCoreService) TokenTransfersByToken(token address.Address, start, count uint64) ([]*blockindex.TokenTransfer, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenTransfersByToken", token, start, count)
+	ret0, _ := ret[0].([]*blockindex.TokenTransfer)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TokenTransfersByToken indicates an expected call of TokenTransfersByToken.
+func (mr *MockCoreServiceMockRecorder) TokenTransfersByToken(token, start, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenTransfersByToken", reflect.TypeOf((*MockCoreService)(nil).TokenTransfersByToken), token, start, count)
+}
+
+// TraceBlock mocks base method.
+func (m *MockCoreService) TraceBlock(ctx context.Context, blk *block.Block, config *tracers.TraceConfig) ([]*action.Receipt, []any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceBlock", ctx, blk, config)
+	ret0, _ := ret[0].([]*action.Receipt)
+	ret1, _ := ret[1].([]any)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TraceBlock indicates an expected call of TraceBlock.
+func (mr *MockCoreServiceMockRecorder) TraceBlock(ctx, blk, config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceBlock", reflect.TypeOf((*MockCoreService)(nil).TraceBlock), ctx, blk, config)
+}
+
 // TraceCall mocks base method.
-func (m *MockCoreService) TraceCall(ctx context.Context, callerAddr address.Address, blkNumOrHash any, contractAddress string, nonce uint64, amount *big.Int, gasLimit uint64, data []byte, config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error) {
+func (m *MockCoreService) TraceCall(ctx context.Context, callerAddr address.Address, height uint64, contractAddress string, amount *big.Int, gasLimit uint64, data []byte, config *tracers.TraceConfig) ([]byte, *action.Receipt, any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TraceCall", ctx, callerAddr, blkNumOrHash, contractAddress, nonce, amount, gasLimit, data, config)
+	ret := m.ctrl.Call(m, "TraceCall", ctx, callerAddr, height, contractAddress, amount, gasLimit, data, config)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(*action.Receipt)
 	ret2, _ := ret[2].(any)
@@ -633,9 +870,9 @@ func (m *MockCoreService) TraceCall(ctx context.Context, callerAddr address.Addr
 }
 
 // TraceCall indicates an expected call of TraceCall.
-func (mr *MockCoreServiceMockRecorder) TraceCall(ctx, callerAddr, blkNumOrHash, contractAddress, nonce, amount, gasLimit, data, config interface{}) *gomock.Call {
+func (mr *MockCoreServiceMockRecorder) TraceCall(ctx, callerAddr, height, contractAddress, amount, gasLimit, data, config interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockCoreService)(nil).TraceCall), ctx, callerAddr, blkNumOrHash, contractAddress, nonce, amount, gasLimit, data, config)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCall", reflect.TypeOf((*MockCoreService)(nil).TraceCall), ctx, callerAddr, height, contractAddress, amount, gasLimit, data, config)
 }
 
 // TraceTransaction mocks base method.
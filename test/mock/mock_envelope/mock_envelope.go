@@ -94,6 +94,20 @@ func (mr *MockEnvelopeMockRecorder) Destination() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destination", reflect.TypeOf((*MockEnvelope)(nil).Destination))
 }
 
+// EffectiveGasPrice mocks base method.
+func (m *MockEnvelope) EffectiveGasPrice(arg0 *big.Int) *big.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EffectiveGasPrice", arg0)
+	ret0, _ := ret[0].(*big.Int)
+	return ret0
+}
+
+// EffectiveGasPrice indicates an expected call of EffectiveGasPrice.
+func (mr *MockEnvelopeMockRecorder) EffectiveGasPrice(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EffectiveGasPrice", reflect.TypeOf((*MockEnvelope)(nil).EffectiveGasPrice), arg0)
+}
+
 // GasFeeCap mocks base method.
 func (m *MockEnvelope) GasFeeCap() *big.Int {
 	m.ctrl.T.Helper()
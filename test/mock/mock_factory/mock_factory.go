@@ -84,6 +84,27 @@ func (mr *MockFactoryMockRecorder) NewBlockBuilder(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewBlockBuilder", reflect.TypeOf((*MockFactory)(nil).NewBlockBuilder), arg0, arg1, arg2)
 }
 
+// ProofAtHeight mocks base method.
+func (m *MockFactory) ProofAtHeight(arg0 uint64, arg1 ...protocol.StateOption) ([]byte, [][]byte, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProofAtHeight", varargs...)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ProofAtHeight indicates an expected call of ProofAtHeight.
+func (mr *MockFactoryMockRecorder) ProofAtHeight(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProofAtHeight", reflect.TypeOf((*MockFactory)(nil).ProofAtHeight), varargs...)
+}
+
 // PutBlock mocks base method.
 func (m *MockFactory) PutBlock(arg0 context.Context, arg1 *block.Block) error {
 	m.ctrl.T.Helper()
@@ -113,6 +134,21 @@ func (mr *MockFactoryMockRecorder) ReadContractStorage(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorage", reflect.TypeOf((*MockFactory)(nil).ReadContractStorage), arg0, arg1, arg2)
 }
 
+// ReadContractStorageAtHeight mocks base method.
+func (m *MockFactory) ReadContractStorageAtHeight(arg0 context.Context, arg1 uint64, arg2 address.Address, arg3 []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadContractStorageAtHeight", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadContractStorageAtHeight indicates an expected call of ReadContractStorageAtHeight.
+func (mr *MockFactoryMockRecorder) ReadContractStorageAtHeight(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadContractStorageAtHeight", reflect.TypeOf((*MockFactory)(nil).ReadContractStorageAtHeight), arg0, arg1, arg2, arg3)
+}
+
 // ReadView mocks base method.
 func (m *MockFactory) ReadView(arg0 string) (interface{}, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +178,37 @@ func (mr *MockFactoryMockRecorder) Register(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockFactory)(nil).Register), arg0)
 }
 
+// ReplayBlock mocks base method.
+func (m *MockFactory) ReplayBlock(arg0 context.Context, arg1 *block.Block, arg2 func(context.Context, int, *action.SealedEnvelope) context.Context) ([]*action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayBlock", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*action.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayBlock indicates an expected call of ReplayBlock.
+func (mr *MockFactoryMockRecorder) ReplayBlock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayBlock", reflect.TypeOf((*MockFactory)(nil).ReplayBlock), arg0, arg1, arg2)
+}
+
+// SimulateActions mocks base method.
+func (m *MockFactory) SimulateActions(arg0 context.Context, arg1 uint64, arg2 []*action.SimulatedAction, arg3 func(context.Context, int, *action.SimulatedAction) context.Context) ([]*action.Receipt, []error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateActions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*action.Receipt)
+	ret1, _ := ret[1].([]error)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SimulateActions indicates an expected call of SimulateActions.
+func (mr *MockFactoryMockRecorder) SimulateActions(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateActions", reflect.TypeOf((*MockFactory)(nil).SimulateActions), arg0, arg1, arg2, arg3)
+}
+
 // SimulateExecution mocks base method.
 func (m *MockFactory) SimulateExecution(arg0 context.Context, arg1 address.Address, arg2 *action.Execution) ([]byte, *action.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +225,22 @@ func (mr *MockFactoryMockRecorder) SimulateExecution(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecution", reflect.TypeOf((*MockFactory)(nil).SimulateExecution), arg0, arg1, arg2)
 }
 
+// SimulateExecutionAtHeight mocks base method.
+func (m *MockFactory) SimulateExecutionAtHeight(arg0 context.Context, arg1 uint64, arg2 address.Address, arg3 *action.Execution) ([]byte, *action.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateExecutionAtHeight", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(*action.Receipt)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SimulateExecutionAtHeight indicates an expected call of SimulateExecutionAtHeight.
+func (mr *MockFactoryMockRecorder) SimulateExecutionAtHeight(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateExecutionAtHeight", reflect.TypeOf((*MockFactory)(nil).SimulateExecutionAtHeight), arg0, arg1, arg2, arg3)
+}
+
 // Start mocks base method.
 func (m *MockFactory) Start(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,19 @@
+module github.com/iotexproject/iotex-proto
+
+require (
+	github.com/golang/mock v1.5.0
+	google.golang.org/grpc v1.51.0
+	google.golang.org/protobuf v1.28.1
+)
+
+require golang.org/x/net v0.7.0 // indirect
+
+require (
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/google/go-cmp v0.5.9 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/text v0.7.0 // indirect
+	google.golang.org/genproto v0.0.0-20230127162408-596548ed4efa // indirect
+)
+
+go 1.17
@@ -0,0 +1,6232 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// To compile the proto, run:
+//      protoc -I. -I ./../types --go_out=plugins=grpc:$GOPATH/src *.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v4.23.3
+// source: proto/api/api.proto
+
+package iotexapi
+
+import (
+	iotextypes "github.com/iotexproject/iotex-proto/golang/iotextypes"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Bucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hex string
+	Voter         string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Votes         string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	WeightedVotes string `protobuf:"bytes,3,opt,name=weightedVotes,proto3" json:"weightedVotes,omitempty"`
+	// human readable duration
+	RemainingDuration string `protobuf:"bytes,4,opt,name=remainingDuration,proto3" json:"remainingDuration,omitempty"`
+}
+
+func (x *Bucket) Reset() {
+	*x = Bucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bucket) ProtoMessage() {}
+
+func (x *Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
+func (*Bucket) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bucket) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *Bucket) GetVotes() string {
+	if x != nil {
+		return x.Votes
+	}
+	return ""
+}
+
+func (x *Bucket) GetWeightedVotes() string {
+	if x != nil {
+		return x.WeightedVotes
+	}
+	return ""
+}
+
+func (x *Bucket) GetRemainingDuration() string {
+	if x != nil {
+		return x.RemainingDuration
+	}
+	return ""
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAccountRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountMeta     *iotextypes.AccountMeta     `protobuf:"bytes,1,opt,name=accountMeta,proto3" json:"accountMeta,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAccountResponse) GetAccountMeta() *iotextypes.AccountMeta {
+	if x != nil {
+		return x.AccountMeta
+	}
+	return nil
+}
+
+func (x *GetAccountResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+type GetActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Lookup:
+	//
+	//	*GetActionsRequest_ByIndex
+	//	*GetActionsRequest_ByHash
+	//	*GetActionsRequest_ByAddr
+	//	*GetActionsRequest_UnconfirmedByAddr
+	//	*GetActionsRequest_ByBlk
+	Lookup isGetActionsRequest_Lookup `protobuf_oneof:"lookup"`
+}
+
+func (x *GetActionsRequest) Reset() {
+	*x = GetActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsRequest) ProtoMessage() {}
+
+func (x *GetActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *GetActionsRequest) GetLookup() isGetActionsRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByIndex() *GetActionsByIndexRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByIndex); ok {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByHash() *GetActionByHashRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByHash); ok {
+		return x.ByHash
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByAddr() *GetActionsByAddressRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByAddr); ok {
+		return x.ByAddr
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetUnconfirmedByAddr() *GetUnconfirmedActionsByAddressRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_UnconfirmedByAddr); ok {
+		return x.UnconfirmedByAddr
+	}
+	return nil
+}
+
+func (x *GetActionsRequest) GetByBlk() *GetActionsByBlockRequest {
+	if x, ok := x.GetLookup().(*GetActionsRequest_ByBlk); ok {
+		return x.ByBlk
+	}
+	return nil
+}
+
+type isGetActionsRequest_Lookup interface {
+	isGetActionsRequest_Lookup()
+}
+
+type GetActionsRequest_ByIndex struct {
+	ByIndex *GetActionsByIndexRequest `protobuf:"bytes,1,opt,name=byIndex,proto3,oneof"`
+}
+
+type GetActionsRequest_ByHash struct {
+	ByHash *GetActionByHashRequest `protobuf:"bytes,2,opt,name=byHash,proto3,oneof"`
+}
+
+type GetActionsRequest_ByAddr struct {
+	ByAddr *GetActionsByAddressRequest `protobuf:"bytes,3,opt,name=byAddr,proto3,oneof"`
+}
+
+type GetActionsRequest_UnconfirmedByAddr struct {
+	UnconfirmedByAddr *GetUnconfirmedActionsByAddressRequest `protobuf:"bytes,4,opt,name=unconfirmedByAddr,proto3,oneof"`
+}
+
+type GetActionsRequest_ByBlk struct {
+	ByBlk *GetActionsByBlockRequest `protobuf:"bytes,5,opt,name=byBlk,proto3,oneof"`
+}
+
+func (*GetActionsRequest_ByIndex) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByHash) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByAddr) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_UnconfirmedByAddr) isGetActionsRequest_Lookup() {}
+
+func (*GetActionsRequest_ByBlk) isGetActionsRequest_Lookup() {}
+
+type GetActionsByIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActionsByIndexRequest) Reset() {
+	*x = GetActionsByIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsByIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsByIndexRequest) ProtoMessage() {}
+
+func (x *GetActionsByIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsByIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsByIndexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetActionsByIndexRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActionsByIndexRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetActionByHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash   string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	CheckPending bool   `protobuf:"varint,2,opt,name=checkPending,proto3" json:"checkPending,omitempty"`
+}
+
+func (x *GetActionByHashRequest) Reset() {
+	*x = GetActionByHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionByHashRequest) ProtoMessage() {}
+
+func (x *GetActionByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetActionByHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetActionByHashRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+func (x *GetActionByHashRequest) GetCheckPending() bool {
+	if x != nil {
+		return x.CheckPending
+	}
+	return false
+}
+
+type GetActionsByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActionsByAddressRequest) Reset() {
+	*x = GetActionsByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsByAddressRequest) ProtoMessage() {}
+
+func (x *GetActionsByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetActionsByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetActionsByAddressRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActionsByAddressRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUnconfirmedActionsByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) Reset() {
+	*x = GetUnconfirmedActionsByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnconfirmedActionsByAddressRequest) ProtoMessage() {}
+
+func (x *GetUnconfirmedActionsByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnconfirmedActionsByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetUnconfirmedActionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetUnconfirmedActionsByAddressRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetActionsByBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlkHash string `protobuf:"bytes,1,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActionsByBlockRequest) Reset() {
+	*x = GetActionsByBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsByBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsByBlockRequest) ProtoMessage() {}
+
+func (x *GetActionsByBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsByBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetActionsByBlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetActionsByBlockRequest) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+func (x *GetActionsByBlockRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActionsByBlockRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ActionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    *iotextypes.Action     `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActHash   string                 `protobuf:"bytes,2,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	BlkHash   string                 `protobuf:"bytes,3,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BlkHeight uint64                 `protobuf:"varint,5,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	Sender    string                 `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	GasFee    string                 `protobuf:"bytes,7,opt,name=gasFee,proto3" json:"gasFee,omitempty"`
+	Index     uint32                 `protobuf:"varint,8,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *ActionInfo) Reset() {
+	*x = ActionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionInfo) ProtoMessage() {}
+
+func (x *ActionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionInfo.ProtoReflect.Descriptor instead.
+func (*ActionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ActionInfo) GetAction() *iotextypes.Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ActionInfo) GetActHash() string {
+	if x != nil {
+		return x.ActHash
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ActionInfo) GetBlkHeight() uint64 {
+	if x != nil {
+		return x.BlkHeight
+	}
+	return 0
+}
+
+func (x *ActionInfo) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetGasFee() string {
+	if x != nil {
+		return x.GasFee
+	}
+	return ""
+}
+
+func (x *ActionInfo) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type ReceiptInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *iotextypes.Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	BlkHash string              `protobuf:"bytes,2,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+}
+
+func (x *ReceiptInfo) Reset() {
+	*x = ReceiptInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptInfo) ProtoMessage() {}
+
+func (x *ReceiptInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptInfo.ProtoReflect.Descriptor instead.
+func (*ReceiptInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReceiptInfo) GetReceipt() *iotextypes.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *ReceiptInfo) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+type BlockProducerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Votes      string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	Active     bool   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Production uint64 `protobuf:"varint,4,opt,name=production,proto3" json:"production,omitempty"`
+}
+
+func (x *BlockProducerInfo) Reset() {
+	*x = BlockProducerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockProducerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProducerInfo) ProtoMessage() {}
+
+func (x *BlockProducerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProducerInfo.ProtoReflect.Descriptor instead.
+func (*BlockProducerInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BlockProducerInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BlockProducerInfo) GetVotes() string {
+	if x != nil {
+		return x.Votes
+	}
+	return ""
+}
+
+func (x *BlockProducerInfo) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *BlockProducerInfo) GetProduction() uint64 {
+	if x != nil {
+		return x.Production
+	}
+	return 0
+}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block           *iotextypes.Block           `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Receipts        []*iotextypes.Receipt       `protobuf:"bytes,2,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	TransactionLogs *iotextypes.TransactionLogs `protobuf:"bytes,3,opt,name=transactionLogs,proto3" json:"transactionLogs,omitempty"`
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockInfo) GetBlock() *iotextypes.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetReceipts() []*iotextypes.Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *BlockInfo) GetTransactionLogs() *iotextypes.TransactionLogs {
+	if x != nil {
+		return x.TransactionLogs
+	}
+	return nil
+}
+
+type GetActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      uint64        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ActionInfo []*ActionInfo `protobuf:"bytes,1,rep,name=actionInfo,proto3" json:"actionInfo,omitempty"`
+}
+
+func (x *GetActionsResponse) Reset() {
+	*x = GetActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionsResponse) ProtoMessage() {}
+
+func (x *GetActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetActionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetActionsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetActionsResponse) GetActionInfo() []*ActionInfo {
+	if x != nil {
+		return x.ActionInfo
+	}
+	return nil
+}
+
+type GetBlockMetasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Lookup:
+	//
+	//	*GetBlockMetasRequest_ByIndex
+	//	*GetBlockMetasRequest_ByHash
+	Lookup isGetBlockMetasRequest_Lookup `protobuf_oneof:"lookup"`
+}
+
+func (x *GetBlockMetasRequest) Reset() {
+	*x = GetBlockMetasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetasRequest) ProtoMessage() {}
+
+func (x *GetBlockMetasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetasRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *GetBlockMetasRequest) GetLookup() isGetBlockMetasRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (x *GetBlockMetasRequest) GetByIndex() *GetBlockMetasByIndexRequest {
+	if x, ok := x.GetLookup().(*GetBlockMetasRequest_ByIndex); ok {
+		return x.ByIndex
+	}
+	return nil
+}
+
+func (x *GetBlockMetasRequest) GetByHash() *GetBlockMetaByHashRequest {
+	if x, ok := x.GetLookup().(*GetBlockMetasRequest_ByHash); ok {
+		return x.ByHash
+	}
+	return nil
+}
+
+type isGetBlockMetasRequest_Lookup interface {
+	isGetBlockMetasRequest_Lookup()
+}
+
+type GetBlockMetasRequest_ByIndex struct {
+	ByIndex *GetBlockMetasByIndexRequest `protobuf:"bytes,1,opt,name=byIndex,proto3,oneof"`
+}
+
+type GetBlockMetasRequest_ByHash struct {
+	ByHash *GetBlockMetaByHashRequest `protobuf:"bytes,2,opt,name=byHash,proto3,oneof"`
+}
+
+func (*GetBlockMetasRequest_ByIndex) isGetBlockMetasRequest_Lookup() {}
+
+func (*GetBlockMetasRequest_ByHash) isGetBlockMetasRequest_Lookup() {}
+
+type GetBlockMetasByIndexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetBlockMetasByIndexRequest) Reset() {
+	*x = GetBlockMetasByIndexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetasByIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetasByIndexRequest) ProtoMessage() {}
+
+func (x *GetBlockMetasByIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetasByIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetasByIndexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBlockMetasByIndexRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetBlockMetasByIndexRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetBlockMetaByHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlkHash string `protobuf:"bytes,1,opt,name=blkHash,proto3" json:"blkHash,omitempty"`
+}
+
+func (x *GetBlockMetaByHashRequest) Reset() {
+	*x = GetBlockMetaByHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetaByHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetaByHashRequest) ProtoMessage() {}
+
+func (x *GetBlockMetaByHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetaByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetaByHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBlockMetaByHashRequest) GetBlkHash() string {
+	if x != nil {
+		return x.BlkHash
+	}
+	return ""
+}
+
+type GetBlockMetasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    uint64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	BlkMetas []*iotextypes.BlockMeta `protobuf:"bytes,1,rep,name=blkMetas,proto3" json:"blkMetas,omitempty"`
+}
+
+func (x *GetBlockMetasResponse) Reset() {
+	*x = GetBlockMetasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetasResponse) ProtoMessage() {}
+
+func (x *GetBlockMetasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetasResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockMetasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBlockMetasResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetBlockMetasResponse) GetBlkMetas() []*iotextypes.BlockMeta {
+	if x != nil {
+		return x.BlkMetas
+	}
+	return nil
+}
+
+type GetChainMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetChainMetaRequest) Reset() {
+	*x = GetChainMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainMetaRequest) ProtoMessage() {}
+
+func (x *GetChainMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetChainMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{18}
+}
+
+type GetChainMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainMeta *iotextypes.ChainMeta `protobuf:"bytes,1,opt,name=chainMeta,proto3" json:"chainMeta,omitempty"`
+	SyncStage string                `protobuf:"bytes,2,opt,name=syncStage,proto3" json:"syncStage,omitempty"` // sync stage
+}
+
+func (x *GetChainMetaResponse) Reset() {
+	*x = GetChainMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainMetaResponse) ProtoMessage() {}
+
+func (x *GetChainMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetChainMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetChainMetaResponse) GetChainMeta() *iotextypes.ChainMeta {
+	if x != nil {
+		return x.ChainMeta
+	}
+	return nil
+}
+
+func (x *GetChainMetaResponse) GetSyncStage() string {
+	if x != nil {
+		return x.SyncStage
+	}
+	return ""
+}
+
+type GetServerMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetServerMetaRequest) Reset() {
+	*x = GetServerMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerMetaRequest) ProtoMessage() {}
+
+func (x *GetServerMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetServerMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{20}
+}
+
+type GetServerMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerMeta *iotextypes.ServerMeta `protobuf:"bytes,1,opt,name=serverMeta,proto3" json:"serverMeta,omitempty"`
+}
+
+func (x *GetServerMetaResponse) Reset() {
+	*x = GetServerMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerMetaResponse) ProtoMessage() {}
+
+func (x *GetServerMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetServerMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetServerMetaResponse) GetServerMeta() *iotextypes.ServerMeta {
+	if x != nil {
+		return x.ServerMeta
+	}
+	return nil
+}
+
+type SendActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *SendActionRequest) Reset() {
+	*x = SendActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActionRequest) ProtoMessage() {}
+
+func (x *SendActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActionRequest.ProtoReflect.Descriptor instead.
+func (*SendActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendActionRequest) GetAction() *iotextypes.Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type SendSignedActionBytesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedActionBytes string `protobuf:"bytes,1,opt,name=signedActionBytes,proto3" json:"signedActionBytes,omitempty"`
+}
+
+func (x *SendSignedActionBytesRequest) Reset() {
+	*x = SendSignedActionBytesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendSignedActionBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSignedActionBytesRequest) ProtoMessage() {}
+
+func (x *SendSignedActionBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSignedActionBytesRequest.ProtoReflect.Descriptor instead.
+func (*SendSignedActionBytesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SendSignedActionBytesRequest) GetSignedActionBytes() string {
+	if x != nil {
+		return x.SignedActionBytes
+	}
+	return ""
+}
+
+type SendActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *SendActionResponse) Reset() {
+	*x = SendActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendActionResponse) ProtoMessage() {}
+
+func (x *SendActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendActionResponse.ProtoReflect.Descriptor instead.
+func (*SendActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendActionResponse) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetReceiptByActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetReceiptByActionRequest) Reset() {
+	*x = GetReceiptByActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptByActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptByActionRequest) ProtoMessage() {}
+
+func (x *GetReceiptByActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptByActionRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptByActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReceiptByActionRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetReceiptByActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiptInfo *ReceiptInfo `protobuf:"bytes,1,opt,name=receiptInfo,proto3" json:"receiptInfo,omitempty"`
+}
+
+func (x *GetReceiptByActionResponse) Reset() {
+	*x = GetReceiptByActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptByActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptByActionResponse) ProtoMessage() {}
+
+func (x *GetReceiptByActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptByActionResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptByActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetReceiptByActionResponse) GetReceiptInfo() *ReceiptInfo {
+	if x != nil {
+		return x.ReceiptInfo
+	}
+	return nil
+}
+
+type ReadContractRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Execution     *iotextypes.Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	CallerAddress string                `protobuf:"bytes,2,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	GasLimit      uint64                `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasPrice      string                `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+}
+
+func (x *ReadContractRequest) Reset() {
+	*x = ReadContractRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadContractRequest) ProtoMessage() {}
+
+func (x *ReadContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadContractRequest.ProtoReflect.Descriptor instead.
+func (*ReadContractRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReadContractRequest) GetExecution() *iotextypes.Execution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *ReadContractRequest) GetCallerAddress() string {
+	if x != nil {
+		return x.CallerAddress
+	}
+	return ""
+}
+
+func (x *ReadContractRequest) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *ReadContractRequest) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+type ReadContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data    string              `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Receipt *iotextypes.Receipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *ReadContractResponse) Reset() {
+	*x = ReadContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadContractResponse) ProtoMessage() {}
+
+func (x *ReadContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadContractResponse.ProtoReflect.Descriptor instead.
+func (*ReadContractResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadContractResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ReadContractResponse) GetReceipt() *iotextypes.Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type SuggestGasPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SuggestGasPriceRequest) Reset() {
+	*x = SuggestGasPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestGasPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGasPriceRequest) ProtoMessage() {}
+
+func (x *SuggestGasPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGasPriceRequest.ProtoReflect.Descriptor instead.
+func (*SuggestGasPriceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{29}
+}
+
+type SuggestGasPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GasPrice uint64 `protobuf:"varint,1,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+}
+
+func (x *SuggestGasPriceResponse) Reset() {
+	*x = SuggestGasPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestGasPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestGasPriceResponse) ProtoMessage() {}
+
+func (x *SuggestGasPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestGasPriceResponse.ProtoReflect.Descriptor instead.
+func (*SuggestGasPriceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SuggestGasPriceResponse) GetGasPrice() uint64 {
+	if x != nil {
+		return x.GasPrice
+	}
+	return 0
+}
+
+// To be deprecated
+type EstimateGasForActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *EstimateGasForActionRequest) Reset() {
+	*x = EstimateGasForActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasForActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasForActionRequest) ProtoMessage() {}
+
+func (x *EstimateGasForActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateGasForActionRequest.ProtoReflect.Descriptor instead.
+func (*EstimateGasForActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EstimateGasForActionRequest) GetAction() *iotextypes.Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type EstimateActionGasConsumptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//
+	//	*EstimateActionGasConsumptionRequest_Transfer
+	//	*EstimateActionGasConsumptionRequest_Execution
+	//	*EstimateActionGasConsumptionRequest_StakeCreate
+	//	*EstimateActionGasConsumptionRequest_StakeUnstake
+	//	*EstimateActionGasConsumptionRequest_StakeWithdraw
+	//	*EstimateActionGasConsumptionRequest_StakeAddDeposit
+	//	*EstimateActionGasConsumptionRequest_StakeRestake
+	//	*EstimateActionGasConsumptionRequest_StakeChangeCandidate
+	//	*EstimateActionGasConsumptionRequest_StakeTransferOwnership
+	//	*EstimateActionGasConsumptionRequest_CandidateRegister
+	//	*EstimateActionGasConsumptionRequest_CandidateUpdate
+	//	*EstimateActionGasConsumptionRequest_CandidateActivate
+	//	*EstimateActionGasConsumptionRequest_CandidateEndorsement
+	Action        isEstimateActionGasConsumptionRequest_Action `protobuf_oneof:"action"`
+	CallerAddress string                                       `protobuf:"bytes,100,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	GasPrice      string                                       `protobuf:"bytes,101,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+}
+
+func (x *EstimateActionGasConsumptionRequest) Reset() {
+	*x = EstimateActionGasConsumptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateActionGasConsumptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateActionGasConsumptionRequest) ProtoMessage() {}
+
+func (x *EstimateActionGasConsumptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateActionGasConsumptionRequest.ProtoReflect.Descriptor instead.
+func (*EstimateActionGasConsumptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (m *EstimateActionGasConsumptionRequest) GetAction() isEstimateActionGasConsumptionRequest_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetTransfer() *iotextypes.Transfer {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetExecution() *iotextypes.Execution {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_Execution); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeCreate() *iotextypes.StakeCreate {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeCreate); ok {
+		return x.StakeCreate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeUnstake() *iotextypes.StakeReclaim {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeUnstake); ok {
+		return x.StakeUnstake
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeWithdraw() *iotextypes.StakeReclaim {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeWithdraw); ok {
+		return x.StakeWithdraw
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeAddDeposit() *iotextypes.StakeAddDeposit {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeAddDeposit); ok {
+		return x.StakeAddDeposit
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeRestake() *iotextypes.StakeRestake {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeRestake); ok {
+		return x.StakeRestake
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeChangeCandidate() *iotextypes.StakeChangeCandidate {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeChangeCandidate); ok {
+		return x.StakeChangeCandidate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetStakeTransferOwnership() *iotextypes.StakeTransferOwnership {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_StakeTransferOwnership); ok {
+		return x.StakeTransferOwnership
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCandidateRegister() *iotextypes.CandidateRegister {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_CandidateRegister); ok {
+		return x.CandidateRegister
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCandidateUpdate() *iotextypes.CandidateBasicInfo {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_CandidateUpdate); ok {
+		return x.CandidateUpdate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCandidateActivate() *iotextypes.CandidateActivate {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_CandidateActivate); ok {
+		return x.CandidateActivate
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCandidateEndorsement() *iotextypes.CandidateEndorsement {
+	if x, ok := x.GetAction().(*EstimateActionGasConsumptionRequest_CandidateEndorsement); ok {
+		return x.CandidateEndorsement
+	}
+	return nil
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetCallerAddress() string {
+	if x != nil {
+		return x.CallerAddress
+	}
+	return ""
+}
+
+func (x *EstimateActionGasConsumptionRequest) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+type isEstimateActionGasConsumptionRequest_Action interface {
+	isEstimateActionGasConsumptionRequest_Action()
+}
+
+type EstimateActionGasConsumptionRequest_Transfer struct {
+	Transfer *iotextypes.Transfer `protobuf:"bytes,1,opt,name=transfer,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_Execution struct {
+	Execution *iotextypes.Execution `protobuf:"bytes,2,opt,name=execution,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeCreate struct {
+	// Native staking
+	StakeCreate *iotextypes.StakeCreate `protobuf:"bytes,40,opt,name=stakeCreate,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeUnstake struct {
+	StakeUnstake *iotextypes.StakeReclaim `protobuf:"bytes,41,opt,name=stakeUnstake,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeWithdraw struct {
+	StakeWithdraw *iotextypes.StakeReclaim `protobuf:"bytes,42,opt,name=stakeWithdraw,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeAddDeposit struct {
+	StakeAddDeposit *iotextypes.StakeAddDeposit `protobuf:"bytes,43,opt,name=stakeAddDeposit,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeRestake struct {
+	StakeRestake *iotextypes.StakeRestake `protobuf:"bytes,44,opt,name=stakeRestake,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeChangeCandidate struct {
+	StakeChangeCandidate *iotextypes.StakeChangeCandidate `protobuf:"bytes,45,opt,name=stakeChangeCandidate,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_StakeTransferOwnership struct {
+	StakeTransferOwnership *iotextypes.StakeTransferOwnership `protobuf:"bytes,46,opt,name=stakeTransferOwnership,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_CandidateRegister struct {
+	CandidateRegister *iotextypes.CandidateRegister `protobuf:"bytes,47,opt,name=candidateRegister,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_CandidateUpdate struct {
+	CandidateUpdate *iotextypes.CandidateBasicInfo `protobuf:"bytes,48,opt,name=candidateUpdate,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_CandidateActivate struct {
+	CandidateActivate *iotextypes.CandidateActivate `protobuf:"bytes,49,opt,name=candidateActivate,proto3,oneof"`
+}
+
+type EstimateActionGasConsumptionRequest_CandidateEndorsement struct {
+	CandidateEndorsement *iotextypes.CandidateEndorsement `protobuf:"bytes,51,opt,name=candidateEndorsement,proto3,oneof"`
+}
+
+func (*EstimateActionGasConsumptionRequest_Transfer) isEstimateActionGasConsumptionRequest_Action() {}
+
+func (*EstimateActionGasConsumptionRequest_Execution) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeCreate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeUnstake) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeWithdraw) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeAddDeposit) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeRestake) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeChangeCandidate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_StakeTransferOwnership) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_CandidateRegister) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_CandidateUpdate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_CandidateActivate) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+func (*EstimateActionGasConsumptionRequest_CandidateEndorsement) isEstimateActionGasConsumptionRequest_Action() {
+}
+
+type EstimateActionGasConsumptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (x *EstimateActionGasConsumptionResponse) Reset() {
+	*x = EstimateActionGasConsumptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateActionGasConsumptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateActionGasConsumptionResponse) ProtoMessage() {}
+
+func (x *EstimateActionGasConsumptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateActionGasConsumptionResponse.ProtoReflect.Descriptor instead.
+func (*EstimateActionGasConsumptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EstimateActionGasConsumptionResponse) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+type EstimateGasForActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (x *EstimateGasForActionResponse) Reset() {
+	*x = EstimateGasForActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasForActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasForActionResponse) ProtoMessage() {}
+
+func (x *EstimateGasForActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateGasForActionResponse.ProtoReflect.Descriptor instead.
+func (*EstimateGasForActionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EstimateGasForActionResponse) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+type ReadStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolID []byte   `protobuf:"bytes,1,opt,name=protocolID,proto3" json:"protocolID,omitempty"`
+	MethodName []byte   `protobuf:"bytes,2,opt,name=methodName,proto3" json:"methodName,omitempty"`
+	Arguments  [][]byte `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Height     string   `protobuf:"bytes,4,opt,name=height,proto3" json:"height,omitempty"` // optional, if not present, read from tip height
+}
+
+func (x *ReadStateRequest) Reset() {
+	*x = ReadStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStateRequest) ProtoMessage() {}
+
+func (x *ReadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStateRequest.ProtoReflect.Descriptor instead.
+func (*ReadStateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReadStateRequest) GetProtocolID() []byte {
+	if x != nil {
+		return x.ProtocolID
+	}
+	return nil
+}
+
+func (x *ReadStateRequest) GetMethodName() []byte {
+	if x != nil {
+		return x.MethodName
+	}
+	return nil
+}
+
+func (x *ReadStateRequest) GetArguments() [][]byte {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ReadStateRequest) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+type ReadStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data            []byte                      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *ReadStateResponse) Reset() {
+	*x = ReadStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadStateResponse) ProtoMessage() {}
+
+func (x *ReadStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadStateResponse.ProtoReflect.Descriptor instead.
+func (*ReadStateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReadStateResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReadStateResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+type GetEpochMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+}
+
+func (x *GetEpochMetaRequest) Reset() {
+	*x = GetEpochMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochMetaRequest) ProtoMessage() {}
+
+func (x *GetEpochMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetEpochMetaRequest) GetEpochNumber() uint64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+type GetEpochMetaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochData          *iotextypes.EpochData `protobuf:"bytes,1,opt,name=epochData,proto3" json:"epochData,omitempty"`
+	TotalBlocks        uint64                `protobuf:"varint,2,opt,name=totalBlocks,proto3" json:"totalBlocks,omitempty"`
+	BlockProducersInfo []*BlockProducerInfo  `protobuf:"bytes,3,rep,name=blockProducersInfo,proto3" json:"blockProducersInfo,omitempty"`
+}
+
+func (x *GetEpochMetaResponse) Reset() {
+	*x = GetEpochMetaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochMetaResponse) ProtoMessage() {}
+
+func (x *GetEpochMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetEpochMetaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEpochMetaResponse) GetEpochData() *iotextypes.EpochData {
+	if x != nil {
+		return x.EpochData
+	}
+	return nil
+}
+
+func (x *GetEpochMetaResponse) GetTotalBlocks() uint64 {
+	if x != nil {
+		return x.TotalBlocks
+	}
+	return 0
+}
+
+func (x *GetEpochMetaResponse) GetBlockProducersInfo() []*BlockProducerInfo {
+	if x != nil {
+		return x.BlockProducersInfo
+	}
+	return nil
+}
+
+type GetRawBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight         uint64 `protobuf:"varint,1,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	Count               uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	WithReceipts        bool   `protobuf:"varint,3,opt,name=withReceipts,proto3" json:"withReceipts,omitempty"`
+	WithTransactionLogs bool   `protobuf:"varint,4,opt,name=withTransactionLogs,proto3" json:"withTransactionLogs,omitempty"`
+}
+
+func (x *GetRawBlocksRequest) Reset() {
+	*x = GetRawBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRawBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawBlocksRequest) ProtoMessage() {}
+
+func (x *GetRawBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetRawBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetRawBlocksRequest) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetRawBlocksRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetRawBlocksRequest) GetWithReceipts() bool {
+	if x != nil {
+		return x.WithReceipts
+	}
+	return false
+}
+
+func (x *GetRawBlocksRequest) GetWithTransactionLogs() bool {
+	if x != nil {
+		return x.WithTransactionLogs
+	}
+	return false
+}
+
+type GetRawBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BlockInfo `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *GetRawBlocksResponse) Reset() {
+	*x = GetRawBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRawBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRawBlocksResponse) ProtoMessage() {}
+
+func (x *GetRawBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRawBlocksResponse.ProtoReflect.Descriptor instead.
+func (*GetRawBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetRawBlocksResponse) GetBlocks() []*BlockInfo {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type GetLogsByBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash []byte `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+}
+
+func (x *GetLogsByBlock) Reset() {
+	*x = GetLogsByBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsByBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsByBlock) ProtoMessage() {}
+
+func (x *GetLogsByBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsByBlock.ProtoReflect.Descriptor instead.
+func (*GetLogsByBlock) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetLogsByBlock) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+type GetLogsByRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromBlock      uint64 `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	ToBlock        uint64 `protobuf:"varint,2,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	PaginationSize uint64 `protobuf:"varint,3,opt,name=paginationSize,proto3" json:"paginationSize,omitempty"`
+}
+
+func (x *GetLogsByRange) Reset() {
+	*x = GetLogsByRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsByRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsByRange) ProtoMessage() {}
+
+func (x *GetLogsByRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsByRange.ProtoReflect.Descriptor instead.
+func (*GetLogsByRange) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetLogsByRange) GetFromBlock() uint64 {
+	if x != nil {
+		return x.FromBlock
+	}
+	return 0
+}
+
+func (x *GetLogsByRange) GetToBlock() uint64 {
+	if x != nil {
+		return x.ToBlock
+	}
+	return 0
+}
+
+func (x *GetLogsByRange) GetPaginationSize() uint64 {
+	if x != nil {
+		return x.PaginationSize
+	}
+	return 0
+}
+
+type Topics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic [][]byte `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+}
+
+func (x *Topics) Reset() {
+	*x = Topics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Topics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topics) ProtoMessage() {}
+
+func (x *Topics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topics.ProtoReflect.Descriptor instead.
+func (*Topics) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Topics) GetTopic() [][]byte {
+	if x != nil {
+		return x.Topic
+	}
+	return nil
+}
+
+type LogsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []string  `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	Topics  []*Topics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *LogsFilter) Reset() {
+	*x = LogsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsFilter) ProtoMessage() {}
+
+func (x *LogsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsFilter.ProtoReflect.Descriptor instead.
+func (*LogsFilter) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LogsFilter) GetAddress() []string {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *LogsFilter) GetTopics() []*Topics {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type GetLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Types that are assignable to Lookup:
+	//
+	//	*GetLogsRequest_ByBlock
+	//	*GetLogsRequest_ByRange
+	Lookup isGetLogsRequest_Lookup `protobuf_oneof:"lookup"`
+}
+
+func (x *GetLogsRequest) Reset() {
+	*x = GetLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsRequest) ProtoMessage() {}
+
+func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetLogsRequest) GetFilter() *LogsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetLookup() isGetLogsRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (x *GetLogsRequest) GetByBlock() *GetLogsByBlock {
+	if x, ok := x.GetLookup().(*GetLogsRequest_ByBlock); ok {
+		return x.ByBlock
+	}
+	return nil
+}
+
+func (x *GetLogsRequest) GetByRange() *GetLogsByRange {
+	if x, ok := x.GetLookup().(*GetLogsRequest_ByRange); ok {
+		return x.ByRange
+	}
+	return nil
+}
+
+type isGetLogsRequest_Lookup interface {
+	isGetLogsRequest_Lookup()
+}
+
+type GetLogsRequest_ByBlock struct {
+	ByBlock *GetLogsByBlock `protobuf:"bytes,2,opt,name=byBlock,proto3,oneof"`
+}
+
+type GetLogsRequest_ByRange struct {
+	ByRange *GetLogsByRange `protobuf:"bytes,3,opt,name=byRange,proto3,oneof"`
+}
+
+func (*GetLogsRequest_ByBlock) isGetLogsRequest_Lookup() {}
+
+func (*GetLogsRequest_ByRange) isGetLogsRequest_Lookup() {}
+
+type GetLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*iotextypes.Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *GetLogsResponse) Reset() {
+	*x = GetLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsResponse) ProtoMessage() {}
+
+func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetLogsResponse) GetLogs() []*iotextypes.Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type GetTransactionLogByActionHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetTransactionLogByActionHashRequest) Reset() {
+	*x = GetTransactionLogByActionHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByActionHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByActionHashRequest) ProtoMessage() {}
+
+func (x *GetTransactionLogByActionHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByActionHashRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByActionHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTransactionLogByActionHashRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type GetTransactionLogByActionHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionLog *iotextypes.TransactionLog `protobuf:"bytes,1,opt,name=transactionLog,proto3" json:"transactionLog,omitempty"`
+}
+
+func (x *GetTransactionLogByActionHashResponse) Reset() {
+	*x = GetTransactionLogByActionHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByActionHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByActionHashResponse) ProtoMessage() {}
+
+func (x *GetTransactionLogByActionHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByActionHashResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByActionHashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetTransactionLogByActionHashResponse) GetTransactionLog() *iotextypes.TransactionLog {
+	if x != nil {
+		return x.TransactionLog
+	}
+	return nil
+}
+
+type GetTransactionLogByBlockHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+}
+
+func (x *GetTransactionLogByBlockHeightRequest) Reset() {
+	*x = GetTransactionLogByBlockHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByBlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByBlockHeightRequest) ProtoMessage() {}
+
+func (x *GetTransactionLogByBlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByBlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetTransactionLogByBlockHeightRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type GetTransactionLogByBlockHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionLogs *iotextypes.TransactionLogs `protobuf:"bytes,1,opt,name=transactionLogs,proto3" json:"transactionLogs,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) Reset() {
+	*x = GetTransactionLogByBlockHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionLogByBlockHeightResponse) ProtoMessage() {}
+
+func (x *GetTransactionLogByBlockHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionLogByBlockHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionLogByBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) GetTransactionLogs() *iotextypes.TransactionLogs {
+	if x != nil {
+		return x.TransactionLogs
+	}
+	return nil
+}
+
+func (x *GetTransactionLogByBlockHeightResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+// below are streaming APIs
+type StreamBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StreamBlocksRequest) Reset() {
+	*x = StreamBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBlocksRequest) ProtoMessage() {}
+
+func (x *StreamBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBlocksRequest.ProtoReflect.Descriptor instead.
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{51}
+}
+
+type StreamBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block           *BlockInfo                  `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockIdentifier *iotextypes.BlockIdentifier `protobuf:"bytes,2,opt,name=blockIdentifier,proto3" json:"blockIdentifier,omitempty"`
+}
+
+func (x *StreamBlocksResponse) Reset() {
+	*x = StreamBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBlocksResponse) ProtoMessage() {}
+
+func (x *StreamBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBlocksResponse.ProtoReflect.Descriptor instead.
+func (*StreamBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StreamBlocksResponse) GetBlock() *BlockInfo {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *StreamBlocksResponse) GetBlockIdentifier() *iotextypes.BlockIdentifier {
+	if x != nil {
+		return x.BlockIdentifier
+	}
+	return nil
+}
+
+type StreamLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StreamLogsRequest) GetFilter() *LogsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type StreamLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log *iotextypes.Log `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *StreamLogsResponse) Reset() {
+	*x = StreamLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsResponse) ProtoMessage() {}
+
+func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StreamLogsResponse) GetLog() *iotextypes.Log {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+type GetActPoolActionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHashes []string `protobuf:"bytes,1,rep,name=actionHashes,proto3" json:"actionHashes,omitempty"` // if this field is absent, get all actions from actpool
+}
+
+func (x *GetActPoolActionsRequest) Reset() {
+	*x = GetActPoolActionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActPoolActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActPoolActionsRequest) ProtoMessage() {}
+
+func (x *GetActPoolActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActPoolActionsRequest.ProtoReflect.Descriptor instead.
+func (*GetActPoolActionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetActPoolActionsRequest) GetActionHashes() []string {
+	if x != nil {
+		return x.ActionHashes
+	}
+	return nil
+}
+
+type GetActPoolActionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*iotextypes.Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *GetActPoolActionsResponse) Reset() {
+	*x = GetActPoolActionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActPoolActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActPoolActionsResponse) ProtoMessage() {}
+
+func (x *GetActPoolActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActPoolActionsResponse.ProtoReflect.Descriptor instead.
+func (*GetActPoolActionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetActPoolActionsResponse) GetActions() []*iotextypes.Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// election APIs
+type GetElectionBucketsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epochNum,proto3" json:"epochNum,omitempty"`
+}
+
+func (x *GetElectionBucketsRequest) Reset() {
+	*x = GetElectionBucketsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElectionBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElectionBucketsRequest) ProtoMessage() {}
+
+func (x *GetElectionBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElectionBucketsRequest.ProtoReflect.Descriptor instead.
+func (*GetElectionBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetElectionBucketsRequest) GetEpochNum() uint64 {
+	if x != nil {
+		return x.EpochNum
+	}
+	return 0
+}
+
+type GetElectionBucketsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets []*iotextypes.ElectionBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *GetElectionBucketsResponse) Reset() {
+	*x = GetElectionBucketsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetElectionBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetElectionBucketsResponse) ProtoMessage() {}
+
+func (x *GetElectionBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetElectionBucketsResponse.ProtoReflect.Descriptor instead.
+func (*GetElectionBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetElectionBucketsResponse) GetBuckets() []*iotextypes.ElectionBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+// Deprecated
+type GetEvmTransfersByActionHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *GetEvmTransfersByActionHashRequest) Reset() {
+	*x = GetEvmTransfersByActionHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByActionHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByActionHashRequest) ProtoMessage() {}
+
+func (x *GetEvmTransfersByActionHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByActionHashRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByActionHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetEvmTransfersByActionHashRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+// Deprecated
+type GetEvmTransfersByActionHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionEvmTransfers *iotextypes.ActionEvmTransfer `protobuf:"bytes,1,opt,name=actionEvmTransfers,proto3" json:"actionEvmTransfers,omitempty"`
+}
+
+func (x *GetEvmTransfersByActionHashResponse) Reset() {
+	*x = GetEvmTransfersByActionHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByActionHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByActionHashResponse) ProtoMessage() {}
+
+func (x *GetEvmTransfersByActionHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByActionHashResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByActionHashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetEvmTransfersByActionHashResponse) GetActionEvmTransfers() *iotextypes.ActionEvmTransfer {
+	if x != nil {
+		return x.ActionEvmTransfers
+	}
+	return nil
+}
+
+// Deprecated
+type GetEvmTransfersByBlockHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+}
+
+func (x *GetEvmTransfersByBlockHeightRequest) Reset() {
+	*x = GetEvmTransfersByBlockHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByBlockHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByBlockHeightRequest) ProtoMessage() {}
+
+func (x *GetEvmTransfersByBlockHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByBlockHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetEvmTransfersByBlockHeightRequest) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// Deprecated
+type GetEvmTransfersByBlockHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockEvmTransfers *iotextypes.BlockEvmTransfer `protobuf:"bytes,1,opt,name=blockEvmTransfers,proto3" json:"blockEvmTransfers,omitempty"`
+}
+
+func (x *GetEvmTransfersByBlockHeightResponse) Reset() {
+	*x = GetEvmTransfersByBlockHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEvmTransfersByBlockHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEvmTransfersByBlockHeightResponse) ProtoMessage() {}
+
+func (x *GetEvmTransfersByBlockHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEvmTransfersByBlockHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetEvmTransfersByBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetEvmTransfersByBlockHeightResponse) GetBlockEvmTransfers() *iotextypes.BlockEvmTransfer {
+	if x != nil {
+		return x.BlockEvmTransfers
+	}
+	return nil
+}
+
+type ReadContractStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key      []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ReadContractStorageRequest) Reset() {
+	*x = ReadContractStorageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadContractStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadContractStorageRequest) ProtoMessage() {}
+
+func (x *ReadContractStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadContractStorageRequest.ProtoReflect.Descriptor instead.
+func (*ReadContractStorageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReadContractStorageRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *ReadContractStorageRequest) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type ReadContractStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReadContractStorageResponse) Reset() {
+	*x = ReadContractStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadContractStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadContractStorageResponse) ProtoMessage() {}
+
+func (x *ReadContractStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadContractStorageResponse.ProtoReflect.Descriptor instead.
+func (*ReadContractStorageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReadContractStorageResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type TraceTransactionStructLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+}
+
+func (x *TraceTransactionStructLogsRequest) Reset() {
+	*x = TraceTransactionStructLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceTransactionStructLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceTransactionStructLogsRequest) ProtoMessage() {}
+
+func (x *TraceTransactionStructLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceTransactionStructLogsRequest.ProtoReflect.Descriptor instead.
+func (*TraceTransactionStructLogsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TraceTransactionStructLogsRequest) GetActionHash() string {
+	if x != nil {
+		return x.ActionHash
+	}
+	return ""
+}
+
+type TraceTransactionStructLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StructLogs []*iotextypes.TransactionStructLog `protobuf:"bytes,1,rep,name=structLogs,proto3" json:"structLogs,omitempty"`
+}
+
+func (x *TraceTransactionStructLogsResponse) Reset() {
+	*x = TraceTransactionStructLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceTransactionStructLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceTransactionStructLogsResponse) ProtoMessage() {}
+
+func (x *TraceTransactionStructLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceTransactionStructLogsResponse.ProtoReflect.Descriptor instead.
+func (*TraceTransactionStructLogsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *TraceTransactionStructLogsResponse) GetStructLogs() []*iotextypes.TransactionStructLog {
+	if x != nil {
+		return x.StructLogs
+	}
+	return nil
+}
+
+type GetInternalTransfersByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start   uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetInternalTransfersByAddressRequest) Reset() {
+	*x = GetInternalTransfersByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInternalTransfersByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInternalTransfersByAddressRequest) ProtoMessage() {}
+
+func (x *GetInternalTransfersByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInternalTransfersByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetInternalTransfersByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetInternalTransfersByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetInternalTransfersByAddressRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetInternalTransfersByAddressRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type InternalTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlkHeight   uint64                                 `protobuf:"varint,1,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	ActHash     []byte                                 `protobuf:"bytes,2,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	Transaction *iotextypes.TransactionLog_Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *InternalTransfer) Reset() {
+	*x = InternalTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InternalTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalTransfer) ProtoMessage() {}
+
+func (x *InternalTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalTransfer.ProtoReflect.Descriptor instead.
+func (*InternalTransfer) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *InternalTransfer) GetBlkHeight() uint64 {
+	if x != nil {
+		return x.BlkHeight
+	}
+	return 0
+}
+
+func (x *InternalTransfer) GetActHash() []byte {
+	if x != nil {
+		return x.ActHash
+	}
+	return nil
+}
+
+func (x *InternalTransfer) GetTransaction() *iotextypes.TransactionLog_Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GetInternalTransfersByAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*InternalTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Total     uint64              `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetInternalTransfersByAddressResponse) Reset() {
+	*x = GetInternalTransfersByAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInternalTransfersByAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInternalTransfersByAddressResponse) ProtoMessage() {}
+
+func (x *GetInternalTransfersByAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInternalTransfersByAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetInternalTransfersByAddressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetInternalTransfersByAddressResponse) GetTransfers() []*InternalTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *GetInternalTransfersByAddressResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// the transfers from or to the address, of the token if it's not empty, or all transfers of the token if the address
+// is empty
+type GetTokenTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Token   string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Start   uint64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Count   uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetTokenTransfersRequest) Reset() {
+	*x = GetTokenTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenTransfersRequest) ProtoMessage() {}
+
+func (x *GetTokenTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenTransfersRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetTokenTransfersRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetTokenTransfersRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetTokenTransfersRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetTokenTransfersRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type TokenTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Standard  string `protobuf:"bytes,1,opt,name=standard,proto3" json:"standard,omitempty"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Sender    string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// empty for XRC20 token
+	TokenId   string `protobuf:"bytes,5,opt,name=tokenId,proto3" json:"tokenId,omitempty"`
+	Amount    string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlkHeight uint64 `protobuf:"varint,7,opt,name=blkHeight,proto3" json:"blkHeight,omitempty"`
+	ActHash   []byte `protobuf:"bytes,8,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	LogIndex  uint32 `protobuf:"varint,9,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+}
+
+func (x *TokenTransfer) Reset() {
+	*x = TokenTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransfer) ProtoMessage() {}
+
+func (x *TokenTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransfer.ProtoReflect.Descriptor instead.
+func (*TokenTransfer) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TokenTransfer) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TokenTransfer) GetBlkHeight() uint64 {
+	if x != nil {
+		return x.BlkHeight
+	}
+	return 0
+}
+
+func (x *TokenTransfer) GetActHash() []byte {
+	if x != nil {
+		return x.ActHash
+	}
+	return nil
+}
+
+func (x *TokenTransfer) GetLogIndex() uint32 {
+	if x != nil {
+		return x.LogIndex
+	}
+	return 0
+}
+
+type GetTokenTransfersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*TokenTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Total     uint64           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetTokenTransfersResponse) Reset() {
+	*x = GetTokenTransfersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_api_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenTransfersResponse) ProtoMessage() {}
+
+func (x *GetTokenTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_api_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenTransfersResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_api_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetTokenTransfersResponse) GetTransfers() []*TokenTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *GetTokenTransfersResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_proto_api_api_proto protoreflect.FileDescriptor
+
+var file_proto_api_api_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x1a,
+	0x18, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x88, 0x01, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0xf6, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x62, 0x79, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x62, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x06, 0x62, 0x79, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x79, 0x41, 0x64, 0x64, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x5f, 0x0a, 0x11, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x11, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3a, 0x0a, 0x05, 0x62, 0x79, 0x42, 0x6c, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x42, 0x6c,
+	0x6b, 0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x22, 0x46, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x62, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x56, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22, 0x7b, 0x0a, 0x11, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2f, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x12, 0x45, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x42, 0x79, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x62, 0x79,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3d, 0x0a, 0x06, 0x62, 0x79, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79,
+	0x48, 0x61, 0x73, 0x68, 0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x22, 0x49,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x42,
+	0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x60, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x31, 0x0a, 0x08, 0x62, 0x6c, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x62, 0x6c, 0x6b, 0x4d, 0x65, 0x74,
+	0x61, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x69, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x3f, 0x0a,
+	0x11, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4c,
+	0x0a, 0x1c, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x12,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x55, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x59, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a,
+	0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x18, 0x0a, 0x16,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x49, 0x0a,
+	0x1b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x08, 0x0a, 0x23, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x47, 0x0a, 0x0f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x48, 0x00, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x2d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x16, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x48,
+	0x00, 0x52, 0x16, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x2f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x4a, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x66,
+	0x6f, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x24, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61,
+	0x73, 0x22, 0x30, 0x0a, 0x1c, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x67, 0x61, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6e,
+	0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x37,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x33, 0x0a, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x69, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x69, 0x74, 0x68,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
+	0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x1e, 0x0a, 0x06, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x22, 0x50, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x00, 0x52, 0x07, 0x62, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x07, 0x62, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x22, 0x36, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f,
+	0x67, 0x73, 0x22, 0x46, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6b, 0x0a, 0x25, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42,
+	0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x22, 0x49, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x45, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x41, 0x0a,
+	0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x22, 0x37, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x3e, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x22, 0x52, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x22, 0x44, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x74, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x47, 0x0a,
+	0x23, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x72, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x4a, 0x0a, 0x1a, 0x52, 0x65,
+	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x31, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x43, 0x0a, 0x21, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x22, 0x66,
+	0x0a, 0x22, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x6c, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x25, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61,
+	0x70, 0x69, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x76, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfd, 0x01, 0x0a,
+	0x0d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x63, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x68, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0xbf, 0x14, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x12, 0x1e, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4d, 0x65, 0x74, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4d, 0x65, 0x74, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x12, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x79, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a,
+	0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x1c, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x12,
+	0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x18, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x51, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1d, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x1b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x7c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x2c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7f,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d,
+	0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x6d, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x69, 0x6f, 0x74, 0x65,
+	0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2b, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e,
+	0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa4, 0x02, 0x0a, 0x15, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x42, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x85, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x2e, 0x69, 0x6f, 0x74,
+	0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x42, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x59, 0x0a, 0x20, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x69, 0x6f,
+	0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x61, 0x70, 0x69, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x69,
+	0x6f, 0x74, 0x65, 0x78, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x2f, 0x69, 0x6f, 0x74, 0x65, 0x78, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_api_api_proto_rawDescOnce sync.Once
+	file_proto_api_api_proto_rawDescData = file_proto_api_api_proto_rawDesc
+)
+
+func file_proto_api_api_proto_rawDescGZIP() []byte {
+	file_proto_api_api_proto_rawDescOnce.Do(func() {
+		file_proto_api_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_api_api_proto_rawDescData)
+	})
+	return file_proto_api_api_proto_rawDescData
+}
+
+var file_proto_api_api_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_proto_api_api_proto_goTypes = []interface{}{
+	(*Bucket)(nil),                                 // 0: iotexapi.Bucket
+	(*GetAccountRequest)(nil),                      // 1: iotexapi.GetAccountRequest
+	(*GetAccountResponse)(nil),                     // 2: iotexapi.GetAccountResponse
+	(*GetActionsRequest)(nil),                      // 3: iotexapi.GetActionsRequest
+	(*GetActionsByIndexRequest)(nil),               // 4: iotexapi.GetActionsByIndexRequest
+	(*GetActionByHashRequest)(nil),                 // 5: iotexapi.GetActionByHashRequest
+	(*GetActionsByAddressRequest)(nil),             // 6: iotexapi.GetActionsByAddressRequest
+	(*GetUnconfirmedActionsByAddressRequest)(nil),  // 7: iotexapi.GetUnconfirmedActionsByAddressRequest
+	(*GetActionsByBlockRequest)(nil),               // 8: iotexapi.GetActionsByBlockRequest
+	(*ActionInfo)(nil),                             // 9: iotexapi.ActionInfo
+	(*ReceiptInfo)(nil),                            // 10: iotexapi.ReceiptInfo
+	(*BlockProducerInfo)(nil),                      // 11: iotexapi.BlockProducerInfo
+	(*BlockInfo)(nil),                              // 12: iotexapi.BlockInfo
+	(*GetActionsResponse)(nil),                     // 13: iotexapi.GetActionsResponse
+	(*GetBlockMetasRequest)(nil),                   // 14: iotexapi.GetBlockMetasRequest
+	(*GetBlockMetasByIndexRequest)(nil),            // 15: iotexapi.GetBlockMetasByIndexRequest
+	(*GetBlockMetaByHashRequest)(nil),              // 16: iotexapi.GetBlockMetaByHashRequest
+	(*GetBlockMetasResponse)(nil),                  // 17: iotexapi.GetBlockMetasResponse
+	(*GetChainMetaRequest)(nil),                    // 18: iotexapi.GetChainMetaRequest
+	(*GetChainMetaResponse)(nil),                   // 19: iotexapi.GetChainMetaResponse
+	(*GetServerMetaRequest)(nil),                   // 20: iotexapi.GetServerMetaRequest
+	(*GetServerMetaResponse)(nil),                  // 21: iotexapi.GetServerMetaResponse
+	(*SendActionRequest)(nil),                      // 22: iotexapi.SendActionRequest
+	(*SendSignedActionBytesRequest)(nil),           // 23: iotexapi.SendSignedActionBytesRequest
+	(*SendActionResponse)(nil),                     // 24: iotexapi.SendActionResponse
+	(*GetReceiptByActionRequest)(nil),              // 25: iotexapi.GetReceiptByActionRequest
+	(*GetReceiptByActionResponse)(nil),             // 26: iotexapi.GetReceiptByActionResponse
+	(*ReadContractRequest)(nil),                    // 27: iotexapi.ReadContractRequest
+	(*ReadContractResponse)(nil),                   // 28: iotexapi.ReadContractResponse
+	(*SuggestGasPriceRequest)(nil),                 // 29: iotexapi.SuggestGasPriceRequest
+	(*SuggestGasPriceResponse)(nil),                // 30: iotexapi.SuggestGasPriceResponse
+	(*EstimateGasForActionRequest)(nil),            // 31: iotexapi.EstimateGasForActionRequest
+	(*EstimateActionGasConsumptionRequest)(nil),    // 32: iotexapi.EstimateActionGasConsumptionRequest
+	(*EstimateActionGasConsumptionResponse)(nil),   // 33: iotexapi.EstimateActionGasConsumptionResponse
+	(*EstimateGasForActionResponse)(nil),           // 34: iotexapi.EstimateGasForActionResponse
+	(*ReadStateRequest)(nil),                       // 35: iotexapi.ReadStateRequest
+	(*ReadStateResponse)(nil),                      // 36: iotexapi.ReadStateResponse
+	(*GetEpochMetaRequest)(nil),                    // 37: iotexapi.GetEpochMetaRequest
+	(*GetEpochMetaResponse)(nil),                   // 38: iotexapi.GetEpochMetaResponse
+	(*GetRawBlocksRequest)(nil),                    // 39: iotexapi.GetRawBlocksRequest
+	(*GetRawBlocksResponse)(nil),                   // 40: iotexapi.GetRawBlocksResponse
+	(*GetLogsByBlock)(nil),                         // 41: iotexapi.GetLogsByBlock
+	(*GetLogsByRange)(nil),                         // 42: iotexapi.GetLogsByRange
+	(*Topics)(nil),                                 // 43: iotexapi.Topics
+	(*LogsFilter)(nil),                             // 44: iotexapi.LogsFilter
+	(*GetLogsRequest)(nil),                         // 45: iotexapi.GetLogsRequest
+	(*GetLogsResponse)(nil),                        // 46: iotexapi.GetLogsResponse
+	(*GetTransactionLogByActionHashRequest)(nil),   // 47: iotexapi.GetTransactionLogByActionHashRequest
+	(*GetTransactionLogByActionHashResponse)(nil),  // 48: iotexapi.GetTransactionLogByActionHashResponse
+	(*GetTransactionLogByBlockHeightRequest)(nil),  // 49: iotexapi.GetTransactionLogByBlockHeightRequest
+	(*GetTransactionLogByBlockHeightResponse)(nil), // 50: iotexapi.GetTransactionLogByBlockHeightResponse
+	(*StreamBlocksRequest)(nil),                    // 51: iotexapi.StreamBlocksRequest
+	(*StreamBlocksResponse)(nil),                   // 52: iotexapi.StreamBlocksResponse
+	(*StreamLogsRequest)(nil),                      // 53: iotexapi.StreamLogsRequest
+	(*StreamLogsResponse)(nil),                     // 54: iotexapi.StreamLogsResponse
+	(*GetActPoolActionsRequest)(nil),               // 55: iotexapi.GetActPoolActionsRequest
+	(*GetActPoolActionsResponse)(nil),              // 56: iotexapi.GetActPoolActionsResponse
+	(*GetElectionBucketsRequest)(nil),              // 57: iotexapi.GetElectionBucketsRequest
+	(*GetElectionBucketsResponse)(nil),             // 58: iotexapi.GetElectionBucketsResponse
+	(*GetEvmTransfersByActionHashRequest)(nil),     // 59: iotexapi.GetEvmTransfersByActionHashRequest
+	(*GetEvmTransfersByActionHashResponse)(nil),    // 60: iotexapi.GetEvmTransfersByActionHashResponse
+	(*GetEvmTransfersByBlockHeightRequest)(nil),    // 61: iotexapi.GetEvmTransfersByBlockHeightRequest
+	(*GetEvmTransfersByBlockHeightResponse)(nil),   // 62: iotexapi.GetEvmTransfersByBlockHeightResponse
+	(*ReadContractStorageRequest)(nil),             // 63: iotexapi.ReadContractStorageRequest
+	(*ReadContractStorageResponse)(nil),            // 64: iotexapi.ReadContractStorageResponse
+	(*TraceTransactionStructLogsRequest)(nil),      // 65: iotexapi.TraceTransactionStructLogsRequest
+	(*TraceTransactionStructLogsResponse)(nil),     // 66: iotexapi.TraceTransactionStructLogsResponse
+	(*GetInternalTransfersByAddressRequest)(nil),   // 67: iotexapi.GetInternalTransfersByAddressRequest
+	(*InternalTransfer)(nil),                       // 68: iotexapi.InternalTransfer
+	(*GetInternalTransfersByAddressResponse)(nil),  // 69: iotexapi.GetInternalTransfersByAddressResponse
+	(*GetTokenTransfersRequest)(nil),               // 70: iotexapi.GetTokenTransfersRequest
+	(*TokenTransfer)(nil),                          // 71: iotexapi.TokenTransfer
+	(*GetTokenTransfersResponse)(nil),              // 72: iotexapi.GetTokenTransfersResponse
+	(*iotextypes.AccountMeta)(nil),                 // 73: iotextypes.AccountMeta
+	(*iotextypes.BlockIdentifier)(nil),             // 74: iotextypes.BlockIdentifier
+	(*iotextypes.Action)(nil),                      // 75: iotextypes.Action
+	(*timestamppb.Timestamp)(nil),                  // 76: google.protobuf.Timestamp
+	(*iotextypes.Receipt)(nil),                     // 77: iotextypes.Receipt
+	(*iotextypes.Block)(nil),                       // 78: iotextypes.Block
+	(*iotextypes.TransactionLogs)(nil),             // 79: iotextypes.TransactionLogs
+	(*iotextypes.BlockMeta)(nil),                   // 80: iotextypes.BlockMeta
+	(*iotextypes.ChainMeta)(nil),                   // 81: iotextypes.ChainMeta
+	(*iotextypes.ServerMeta)(nil),                  // 82: iotextypes.ServerMeta
+	(*iotextypes.Execution)(nil),                   // 83: iotextypes.Execution
+	(*iotextypes.Transfer)(nil),                    // 84: iotextypes.Transfer
+	(*iotextypes.StakeCreate)(nil),                 // 85: iotextypes.StakeCreate
+	(*iotextypes.StakeReclaim)(nil),                // 86: iotextypes.StakeReclaim
+	(*iotextypes.StakeAddDeposit)(nil),             // 87: iotextypes.StakeAddDeposit
+	(*iotextypes.StakeRestake)(nil),                // 88: iotextypes.StakeRestake
+	(*iotextypes.StakeChangeCandidate)(nil),        // 89: iotextypes.StakeChangeCandidate
+	(*iotextypes.StakeTransferOwnership)(nil),      // 90: iotextypes.StakeTransferOwnership
+	(*iotextypes.CandidateRegister)(nil),           // 91: iotextypes.CandidateRegister
+	(*iotextypes.CandidateBasicInfo)(nil),          // 92: iotextypes.CandidateBasicInfo
+	(*iotextypes.CandidateActivate)(nil),           // 93: iotextypes.CandidateActivate
+	(*iotextypes.CandidateEndorsement)(nil),        // 94: iotextypes.CandidateEndorsement
+	(*iotextypes.EpochData)(nil),                   // 95: iotextypes.EpochData
+	(*iotextypes.Log)(nil),                         // 96: iotextypes.Log
+	(*iotextypes.TransactionLog)(nil),              // 97: iotextypes.TransactionLog
+	(*iotextypes.ElectionBucket)(nil),              // 98: iotextypes.ElectionBucket
+	(*iotextypes.ActionEvmTransfer)(nil),           // 99: iotextypes.ActionEvmTransfer
+	(*iotextypes.BlockEvmTransfer)(nil),            // 100: iotextypes.BlockEvmTransfer
+	(*iotextypes.TransactionStructLog)(nil),        // 101: iotextypes.TransactionStructLog
+	(*iotextypes.TransactionLog_Transaction)(nil),  // 102: iotextypes.TransactionLog.Transaction
+}
+var file_proto_api_api_proto_depIdxs = []int32{
+	73,  // 0: iotexapi.GetAccountResponse.accountMeta:type_name -> iotextypes.AccountMeta
+	74,  // 1: iotexapi.GetAccountResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	4,   // 2: iotexapi.GetActionsRequest.byIndex:type_name -> iotexapi.GetActionsByIndexRequest
+	5,   // 3: iotexapi.GetActionsRequest.byHash:type_name -> iotexapi.GetActionByHashRequest
+	6,   // 4: iotexapi.GetActionsRequest.byAddr:type_name -> iotexapi.GetActionsByAddressRequest
+	7,   // 5: iotexapi.GetActionsRequest.unconfirmedByAddr:type_name -> iotexapi.GetUnconfirmedActionsByAddressRequest
+	8,   // 6: iotexapi.GetActionsRequest.byBlk:type_name -> iotexapi.GetActionsByBlockRequest
+	75,  // 7: iotexapi.ActionInfo.action:type_name -> iotextypes.Action
+	76,  // 8: iotexapi.ActionInfo.timestamp:type_name -> google.protobuf.Timestamp
+	77,  // 9: iotexapi.ReceiptInfo.receipt:type_name -> iotextypes.Receipt
+	78,  // 10: iotexapi.BlockInfo.block:type_name -> iotextypes.Block
+	77,  // 11: iotexapi.BlockInfo.receipts:type_name -> iotextypes.Receipt
+	79,  // 12: iotexapi.BlockInfo.transactionLogs:type_name -> iotextypes.TransactionLogs
+	9,   // 13: iotexapi.GetActionsResponse.actionInfo:type_name -> iotexapi.ActionInfo
+	15,  // 14: iotexapi.GetBlockMetasRequest.byIndex:type_name -> iotexapi.GetBlockMetasByIndexRequest
+	16,  // 15: iotexapi.GetBlockMetasRequest.byHash:type_name -> iotexapi.GetBlockMetaByHashRequest
+	80,  // 16: iotexapi.GetBlockMetasResponse.blkMetas:type_name -> iotextypes.BlockMeta
+	81,  // 17: iotexapi.GetChainMetaResponse.chainMeta:type_name -> iotextypes.ChainMeta
+	82,  // 18: iotexapi.GetServerMetaResponse.serverMeta:type_name -> iotextypes.ServerMeta
+	75,  // 19: iotexapi.SendActionRequest.action:type_name -> iotextypes.Action
+	10,  // 20: iotexapi.GetReceiptByActionResponse.receiptInfo:type_name -> iotexapi.ReceiptInfo
+	83,  // 21: iotexapi.ReadContractRequest.execution:type_name -> iotextypes.Execution
+	77,  // 22: iotexapi.ReadContractResponse.receipt:type_name -> iotextypes.Receipt
+	75,  // 23: iotexapi.EstimateGasForActionRequest.action:type_name -> iotextypes.Action
+	84,  // 24: iotexapi.EstimateActionGasConsumptionRequest.transfer:type_name -> iotextypes.Transfer
+	83,  // 25: iotexapi.EstimateActionGasConsumptionRequest.execution:type_name -> iotextypes.Execution
+	85,  // 26: iotexapi.EstimateActionGasConsumptionRequest.stakeCreate:type_name -> iotextypes.StakeCreate
+	86,  // 27: iotexapi.EstimateActionGasConsumptionRequest.stakeUnstake:type_name -> iotextypes.StakeReclaim
+	86,  // 28: iotexapi.EstimateActionGasConsumptionRequest.stakeWithdraw:type_name -> iotextypes.StakeReclaim
+	87,  // 29: iotexapi.EstimateActionGasConsumptionRequest.stakeAddDeposit:type_name -> iotextypes.StakeAddDeposit
+	88,  // 30: iotexapi.EstimateActionGasConsumptionRequest.stakeRestake:type_name -> iotextypes.StakeRestake
+	89,  // 31: iotexapi.EstimateActionGasConsumptionRequest.stakeChangeCandidate:type_name -> iotextypes.StakeChangeCandidate
+	90,  // 32: iotexapi.EstimateActionGasConsumptionRequest.stakeTransferOwnership:type_name -> iotextypes.StakeTransferOwnership
+	91,  // 33: iotexapi.EstimateActionGasConsumptionRequest.candidateRegister:type_name -> iotextypes.CandidateRegister
+	92,  // 34: iotexapi.EstimateActionGasConsumptionRequest.candidateUpdate:type_name -> iotextypes.CandidateBasicInfo
+	93,  // 35: iotexapi.EstimateActionGasConsumptionRequest.candidateActivate:type_name -> iotextypes.CandidateActivate
+	94,  // 36: iotexapi.EstimateActionGasConsumptionRequest.candidateEndorsement:type_name -> iotextypes.CandidateEndorsement
+	74,  // 37: iotexapi.ReadStateResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	95,  // 38: iotexapi.GetEpochMetaResponse.epochData:type_name -> iotextypes.EpochData
+	11,  // 39: iotexapi.GetEpochMetaResponse.blockProducersInfo:type_name -> iotexapi.BlockProducerInfo
+	12,  // 40: iotexapi.GetRawBlocksResponse.blocks:type_name -> iotexapi.BlockInfo
+	43,  // 41: iotexapi.LogsFilter.topics:type_name -> iotexapi.Topics
+	44,  // 42: iotexapi.GetLogsRequest.filter:type_name -> iotexapi.LogsFilter
+	41,  // 43: iotexapi.GetLogsRequest.byBlock:type_name -> iotexapi.GetLogsByBlock
+	42,  // 44: iotexapi.GetLogsRequest.byRange:type_name -> iotexapi.GetLogsByRange
+	96,  // 45: iotexapi.GetLogsResponse.logs:type_name -> iotextypes.Log
+	97,  // 46: iotexapi.GetTransactionLogByActionHashResponse.transactionLog:type_name -> iotextypes.TransactionLog
+	79,  // 47: iotexapi.GetTransactionLogByBlockHeightResponse.transactionLogs:type_name -> iotextypes.TransactionLogs
+	74,  // 48: iotexapi.GetTransactionLogByBlockHeightResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	12,  // 49: iotexapi.StreamBlocksResponse.block:type_name -> iotexapi.BlockInfo
+	74,  // 50: iotexapi.StreamBlocksResponse.blockIdentifier:type_name -> iotextypes.BlockIdentifier
+	44,  // 51: iotexapi.StreamLogsRequest.filter:type_name -> iotexapi.LogsFilter
+	96,  // 52: iotexapi.StreamLogsResponse.log:type_name -> iotextypes.Log
+	75,  // 53: iotexapi.GetActPoolActionsResponse.actions:type_name -> iotextypes.Action
+	98,  // 54: iotexapi.GetElectionBucketsResponse.buckets:type_name -> iotextypes.ElectionBucket
+	99,  // 55: iotexapi.GetEvmTransfersByActionHashResponse.actionEvmTransfers:type_name -> iotextypes.ActionEvmTransfer
+	100, // 56: iotexapi.GetEvmTransfersByBlockHeightResponse.blockEvmTransfers:type_name -> iotextypes.BlockEvmTransfer
+	101, // 57: iotexapi.TraceTransactionStructLogsResponse.structLogs:type_name -> iotextypes.TransactionStructLog
+	102, // 58: iotexapi.InternalTransfer.transaction:type_name -> iotextypes.TransactionLog.Transaction
+	68,  // 59: iotexapi.GetInternalTransfersByAddressResponse.transfers:type_name -> iotexapi.InternalTransfer
+	71,  // 60: iotexapi.GetTokenTransfersResponse.transfers:type_name -> iotexapi.TokenTransfer
+	1,   // 61: iotexapi.APIService.GetAccount:input_type -> iotexapi.GetAccountRequest
+	3,   // 62: iotexapi.APIService.GetActions:input_type -> iotexapi.GetActionsRequest
+	14,  // 63: iotexapi.APIService.GetBlockMetas:input_type -> iotexapi.GetBlockMetasRequest
+	18,  // 64: iotexapi.APIService.GetChainMeta:input_type -> iotexapi.GetChainMetaRequest
+	20,  // 65: iotexapi.APIService.GetServerMeta:input_type -> iotexapi.GetServerMetaRequest
+	22,  // 66: iotexapi.APIService.SendAction:input_type -> iotexapi.SendActionRequest
+	25,  // 67: iotexapi.APIService.GetReceiptByAction:input_type -> iotexapi.GetReceiptByActionRequest
+	27,  // 68: iotexapi.APIService.ReadContract:input_type -> iotexapi.ReadContractRequest
+	29,  // 69: iotexapi.APIService.SuggestGasPrice:input_type -> iotexapi.SuggestGasPriceRequest
+	31,  // 70: iotexapi.APIService.EstimateGasForAction:input_type -> iotexapi.EstimateGasForActionRequest
+	32,  // 71: iotexapi.APIService.EstimateActionGasConsumption:input_type -> iotexapi.EstimateActionGasConsumptionRequest
+	35,  // 72: iotexapi.APIService.ReadState:input_type -> iotexapi.ReadStateRequest
+	37,  // 73: iotexapi.APIService.GetEpochMeta:input_type -> iotexapi.GetEpochMetaRequest
+	39,  // 74: iotexapi.APIService.GetRawBlocks:input_type -> iotexapi.GetRawBlocksRequest
+	45,  // 75: iotexapi.APIService.GetLogs:input_type -> iotexapi.GetLogsRequest
+	47,  // 76: iotexapi.APIService.GetTransactionLogByActionHash:input_type -> iotexapi.GetTransactionLogByActionHashRequest
+	49,  // 77: iotexapi.APIService.GetTransactionLogByBlockHeight:input_type -> iotexapi.GetTransactionLogByBlockHeightRequest
+	51,  // 78: iotexapi.APIService.StreamBlocks:input_type -> iotexapi.StreamBlocksRequest
+	53,  // 79: iotexapi.APIService.StreamLogs:input_type -> iotexapi.StreamLogsRequest
+	55,  // 80: iotexapi.APIService.GetActPoolActions:input_type -> iotexapi.GetActPoolActionsRequest
+	59,  // 81: iotexapi.APIService.GetEvmTransfersByActionHash:input_type -> iotexapi.GetEvmTransfersByActionHashRequest
+	61,  // 82: iotexapi.APIService.GetEvmTransfersByBlockHeight:input_type -> iotexapi.GetEvmTransfersByBlockHeightRequest
+	57,  // 83: iotexapi.APIService.GetElectionBuckets:input_type -> iotexapi.GetElectionBucketsRequest
+	63,  // 84: iotexapi.APIService.ReadContractStorage:input_type -> iotexapi.ReadContractStorageRequest
+	65,  // 85: iotexapi.APIService.TraceTransactionStructLogs:input_type -> iotexapi.TraceTransactionStructLogsRequest
+	67,  // 86: iotexapi.APIService.GetInternalTransfersByAddress:input_type -> iotexapi.GetInternalTransfersByAddressRequest
+	70,  // 87: iotexapi.APIService.GetTokenTransfers:input_type -> iotexapi.GetTokenTransfersRequest
+	47,  // 88: iotexapi.TransactionLogService.GetTransactionLogByActionHash:input_type -> iotexapi.GetTransactionLogByActionHashRequest
+	49,  // 89: iotexapi.TransactionLogService.GetTransactionLogByBlockHeight:input_type -> iotexapi.GetTransactionLogByBlockHeightRequest
+	2,   // 90: iotexapi.APIService.GetAccount:output_type -> iotexapi.GetAccountResponse
+	13,  // 91: iotexapi.APIService.GetActions:output_type -> iotexapi.GetActionsResponse
+	17,  // 92: iotexapi.APIService.GetBlockMetas:output_type -> iotexapi.GetBlockMetasResponse
+	19,  // 93: iotexapi.APIService.GetChainMeta:output_type -> iotexapi.GetChainMetaResponse
+	21,  // 94: iotexapi.APIService.GetServerMeta:output_type -> iotexapi.GetServerMetaResponse
+	24,  // 95: iotexapi.APIService.SendAction:output_type -> iotexapi.SendActionResponse
+	26,  // 96: iotexapi.APIService.GetReceiptByAction:output_type -> iotexapi.GetReceiptByActionResponse
+	28,  // 97: iotexapi.APIService.ReadContract:output_type -> iotexapi.ReadContractResponse
+	30,  // 98: iotexapi.APIService.SuggestGasPrice:output_type -> iotexapi.SuggestGasPriceResponse
+	34,  // 99: iotexapi.APIService.EstimateGasForAction:output_type -> iotexapi.EstimateGasForActionResponse
+	33,  // 100: iotexapi.APIService.EstimateActionGasConsumption:output_type -> iotexapi.EstimateActionGasConsumptionResponse
+	36,  // 101: iotexapi.APIService.ReadState:output_type -> iotexapi.ReadStateResponse
+	38,  // 102: iotexapi.APIService.GetEpochMeta:output_type -> iotexapi.GetEpochMetaResponse
+	40,  // 103: iotexapi.APIService.GetRawBlocks:output_type -> iotexapi.GetRawBlocksResponse
+	46,  // 104: iotexapi.APIService.GetLogs:output_type -> iotexapi.GetLogsResponse
+	48,  // 105: iotexapi.APIService.GetTransactionLogByActionHash:output_type -> iotexapi.GetTransactionLogByActionHashResponse
+	50,  // 106: iotexapi.APIService.GetTransactionLogByBlockHeight:output_type -> iotexapi.GetTransactionLogByBlockHeightResponse
+	52,  // 107: iotexapi.APIService.StreamBlocks:output_type -> iotexapi.StreamBlocksResponse
+	54,  // 108: iotexapi.APIService.StreamLogs:output_type -> iotexapi.StreamLogsResponse
+	56,  // 109: iotexapi.APIService.GetActPoolActions:output_type -> iotexapi.GetActPoolActionsResponse
+	60,  // 110: iotexapi.APIService.GetEvmTransfersByActionHash:output_type -> iotexapi.GetEvmTransfersByActionHashResponse
+	62,  // 111: iotexapi.APIService.GetEvmTransfersByBlockHeight:output_type -> iotexapi.GetEvmTransfersByBlockHeightResponse
+	58,  // 112: iotexapi.APIService.GetElectionBuckets:output_type -> iotexapi.GetElectionBucketsResponse
+	64,  // 113: iotexapi.APIService.ReadContractStorage:output_type -> iotexapi.ReadContractStorageResponse
+	66,  // 114: iotexapi.APIService.TraceTransactionStructLogs:output_type -> iotexapi.TraceTransactionStructLogsResponse
+	69,  // 115: iotexapi.APIService.GetInternalTransfersByAddress:output_type -> iotexapi.GetInternalTransfersByAddressResponse
+	72,  // 116: iotexapi.APIService.GetTokenTransfers:output_type -> iotexapi.GetTokenTransfersResponse
+	48,  // 117: iotexapi.TransactionLogService.GetTransactionLogByActionHash:output_type -> iotexapi.GetTransactionLogByActionHashResponse
+	50,  // 118: iotexapi.TransactionLogService.GetTransactionLogByBlockHeight:output_type -> iotexapi.GetTransactionLogByBlockHeightResponse
+	90,  // [90:119] is the sub-list for method output_type
+	61,  // [61:90] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_proto_api_api_proto_init() }
+func file_proto_api_api_proto_init() {
+	if File_proto_api_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_api_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsByIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnconfirmedActionsByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsByBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockProducerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetasByIndexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetaByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServerMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendSignedActionBytesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReceiptByActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReceiptByActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadContractRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadContractResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestGasPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestGasPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasForActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateActionGasConsumptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateActionGasConsumptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasForActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochMetaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRawBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsByBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsByRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Topics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByActionHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByActionHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByBlockHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionLogByBlockHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActPoolActionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActPoolActionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElectionBucketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetElectionBucketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByActionHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByActionHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByBlockHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEvmTransfersByBlockHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadContractStorageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadContractStorageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceTransactionStructLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceTransactionStructLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInternalTransfersByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InternalTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInternalTransfersByAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenTransfersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_api_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenTransfersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_api_api_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*GetActionsRequest_ByIndex)(nil),
+		(*GetActionsRequest_ByHash)(nil),
+		(*GetActionsRequest_ByAddr)(nil),
+		(*GetActionsRequest_UnconfirmedByAddr)(nil),
+		(*GetActionsRequest_ByBlk)(nil),
+	}
+	file_proto_api_api_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*GetBlockMetasRequest_ByIndex)(nil),
+		(*GetBlockMetasRequest_ByHash)(nil),
+	}
+	file_proto_api_api_proto_msgTypes[32].OneofWrappers = []interface{}{
+		(*EstimateActionGasConsumptionRequest_Transfer)(nil),
+		(*EstimateActionGasConsumptionRequest_Execution)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeCreate)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeUnstake)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeWithdraw)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeAddDeposit)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeRestake)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeChangeCandidate)(nil),
+		(*EstimateActionGasConsumptionRequest_StakeTransferOwnership)(nil),
+		(*EstimateActionGasConsumptionRequest_CandidateRegister)(nil),
+		(*EstimateActionGasConsumptionRequest_CandidateUpdate)(nil),
+		(*EstimateActionGasConsumptionRequest_CandidateActivate)(nil),
+		(*EstimateActionGasConsumptionRequest_CandidateEndorsement)(nil),
+	}
+	file_proto_api_api_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*GetLogsRequest_ByBlock)(nil),
+		(*GetLogsRequest_ByRange)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_api_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   73,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_proto_api_api_proto_goTypes,
+		DependencyIndexes: file_proto_api_api_proto_depIdxs,
+		MessageInfos:      file_proto_api_api_proto_msgTypes,
+	}.Build()
+	File_proto_api_api_proto = out.File
+	file_proto_api_api_proto_rawDesc = nil
+	file_proto_api_api_proto_goTypes = nil
+	file_proto_api_api_proto_depIdxs = nil
+}
@@ -0,0 +1,12 @@
+package protocol
+
+const (
+	RewardingProtocolID            = "rewarding"
+	ReadAvailableBalanceMethodName = "AvailableBalance"
+	ReadTotalBalanceMethodName     = "TotalBalance"
+	ReadUnclaimedBalanceMethodName = "UnclaimedBalance"
+)
+
+const (
+	StakingProtcolID = "staking"
+)
@@ -0,0 +1,489 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/pkg/errors"
+	"github.com/schollz/progressbar/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/batch"
+	"github.com/iotexproject/iotex-core/db/trie"
+	"github.com/iotexproject/iotex-core/db/trie/mptrie"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/tools/iomigrater/common"
+)
+
+const (
+	_dbTypeState = "state"
+	_dbTypeIndex = "index"
+	_dbTypeBlock = "block"
+
+	// _checkpointNS is the bucket in the destination db to store the progress of conversion
+	_checkpointNS = "iomigraterCheckpoint"
+)
+
+var (
+	_checkpointBucketKey = []byte("bucket")
+	_checkpointKeyKey    = []byte("key")
+)
+
+// Multi-language support
+var (
+	convertDbCmdShorts = map[string]string{
+		"english": "Sub-Command for converting IoTeX db file between storage backends.",
+		"chinese": "在存储后端之间转换IoTeX db 文件的子命令",
+	}
+	convertDbCmdLongs = map[string]string{
+		"english": "Sub-Command for converting IoTeX trie.db, index.db or chain.db between storage backends, " +
+			"the split files of chain.db are converted along with it, " +
+			"and the conversion resumes from the last checkpoint if it is interrupted.",
+		"chinese": "在存储后端之间转换IoTeX trie.db、index.db 或 chain.db 的子命令，chain.db 的分割文件会一并转换，" +
+			"中断后从上一个检查点继续转换。",
+	}
+	convertDbCmdUse = map[string]string{
+		"english": "convert",
+		"chinese": "convert",
+	}
+	convertDbFlagSourceUse = map[string]string{
+		"english": "The db you want to convert.",
+		"chinese": "您要转换的 db。",
+	}
+	convertDbFlagSourceBackendUse = map[string]string{
+		"english": "The storage backend of the source db, bolt or pebble.",
+		"chinese": "源 db 的存储后端，bolt 或 pebble。",
+	}
+	convertDbFlagDestUse = map[string]string{
+		"english": "The path you want to convert to.",
+		"chinese": "您要转换到的路径。",
+	}
+	convertDbFlagDestBackendUse = map[string]string{
+		"english": "The storage backend of the destination db, bolt or pebble.",
+		"chinese": "目标 db 的存储后端，bolt 或 pebble。",
+	}
+	convertDbFlagTypeUse = map[string]string{
+		"english": "The type of the db, state, index or block, which decides how the destination db is verified.",
+		"chinese": "db 的类型，state、index 或 block，决定如何校验目标 db。",
+	}
+	convertDbFlagBatchSizeUse = map[string]string{
+		"english": "The number of records written to the destination db in a batch.",
+		"chinese": "每批写入目标 db 的记录数。",
+	}
+)
+
+var (
+	// ConvertDb Used to Sub command.
+	ConvertDb = &cobra.Command{
+		Use:   common.TranslateInLang(convertDbCmdUse),
+		Short: common.TranslateInLang(convertDbCmdShorts),
+		Long:  common.TranslateInLang(convertDbCmdLongs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return convertDb()
+		},
+	}
+)
+
+var (
+	sourceDb      = ""
+	sourceBackend = db.BackendBolt
+	destDb        = ""
+	destBackend   = db.BackendPebble
+	dbType        = _dbTypeState
+	batchSize     = 10000
+)
+
+func init() {
+	ConvertDb.PersistentFlags().StringVarP(&sourceDb, "source", "s", "", common.TranslateInLang(convertDbFlagSourceUse))
+	ConvertDb.PersistentFlags().StringVar(&sourceBackend, "source-backend", db.BackendBolt, common.TranslateInLang(convertDbFlagSourceBackendUse))
+	ConvertDb.PersistentFlags().StringVarP(&destDb, "dest", "d", "", common.TranslateInLang(convertDbFlagDestUse))
+	ConvertDb.PersistentFlags().StringVar(&destBackend, "dest-backend", db.BackendPebble, common.TranslateInLang(convertDbFlagDestBackendUse))
+	ConvertDb.PersistentFlags().StringVarP(&dbType, "type", "t", _dbTypeState, common.TranslateInLang(convertDbFlagTypeUse))
+	ConvertDb.PersistentFlags().IntVar(&batchSize, "batch-size", 10000, common.TranslateInLang(convertDbFlagBatchSizeUse))
+}
+
+func convertDb() error {
+	// Check flags
+	if sourceDb == "" {
+		return fmt.Errorf("--source is empty")
+	}
+	if destDb == "" {
+		return fmt.Errorf("--dest is empty")
+	}
+	if sourceDb == destDb {
+		return fmt.Errorf("the values of --source --dest flags cannot be the same")
+	}
+	if batchSize <= 0 {
+		return fmt.Errorf("--batch-size should be positive")
+	}
+	switch dbType {
+	case _dbTypeState, _dbTypeIndex, _dbTypeBlock:
+	default:
+		return fmt.Errorf("unknown db type %s", dbType)
+	}
+
+	cfg, err := config.New([]string{}, []string{})
+	if err != nil {
+		return fmt.Errorf("failed to new config: %v", err)
+	}
+	srcCfg := cfg.DB
+	srcCfg.Backend = sourceBackend
+	srcCfg.ReadOnly = true
+	destCfg := cfg.DB
+	destCfg.Backend = destBackend
+	if dbType == _dbTypeBlock {
+		srcCfg.DbPath, destCfg.DbPath = sourceDb, destDb
+		return convertChainDB(srcCfg, destCfg, batchSize, block.NewDeserializer(cfg.Chain.EVMNetworkID))
+	}
+	if err := convertKVStore(srcCfg, sourceDb, destCfg, destDb, batchSize); err != nil {
+		return err
+	}
+
+	fmt.Println("Verifying the destination db.")
+	return verifyKVStore(srcCfg, sourceDb, destCfg, destDb)
+}
+
+// convertChainDB converts the chain db file and all its split files, the k-th split file is converted into the
+// k-th split file of the destination
+func convertChainDB(srcCfg, destCfg db.Config, batchSize int, deser *block.Deserializer) error {
+	files, err := filedao.ChainDBFiles(srcCfg)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read chain db files of %s", srcCfg.DbPath)
+	}
+	indices := make([]uint64, 0, len(files))
+	for k := range files {
+		indices = append(indices, k)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	for _, k := range indices {
+		dest := destCfg.DbPath
+		if k > 0 {
+			dest = filedao.AuxFileName(destCfg.DbPath, k)
+		}
+		fmt.Printf("Converting %s to %s.\n", files[k], dest)
+		if err := convertKVStore(srcCfg, files[k], destCfg, dest, batchSize); err != nil {
+			return err
+		}
+		if err := verifyKVStore(srcCfg, files[k], destCfg, dest); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("Verifying the destination db.")
+	return verifyBlockHashChain(srcCfg, destCfg, deser)
+}
+
+func openKVStoreWithIterator(ctx context.Context, cfg db.Config, path string) (db.KVStoreWithIterator, error) {
+	kv, err := db.CreateKVStore(cfg, path)
+	if err != nil {
+		return nil, err
+	}
+	kvi, ok := kv.(db.KVStoreWithIterator)
+	if !ok {
+		return nil, errors.Errorf("backend %s doesn't support iteration", cfg.Backend)
+	}
+	if err := kvi.Start(ctx); err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s", path)
+	}
+	return kvi, nil
+}
+
+// convertKVStore copies all buckets of the source db into the destination db, the progress is saved in the
+// destination db along with every batch, so that an interrupted conversion resumes from where it stops
+func convertKVStore(srcCfg db.Config, srcPath string, destCfg db.Config, destPath string, batchSize int) (err error) {
+	ctx := context.Background()
+	src, err := openKVStoreWithIterator(ctx, srcCfg, srcPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := src.Stop(ctx); err == nil {
+			err = e
+		}
+	}()
+	dest, err := openKVStoreWithIterator(ctx, destCfg, destPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := dest.Stop(ctx); err == nil {
+			err = e
+		}
+	}()
+
+	var cpBucket string
+	cpKey, err := dest.Get(_checkpointNS, _checkpointKeyKey)
+	switch errors.Cause(err) {
+	case nil:
+		v, err := dest.Get(_checkpointNS, _checkpointBucketKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to read checkpoint")
+		}
+		cpBucket = string(v)
+		fmt.Printf("Resume conversion from bucket %s key %x.\n", cpBucket, cpKey)
+	case db.ErrNotExist, db.ErrBucketNotExist:
+		cpKey = nil
+	default:
+		return errors.Wrap(err, "failed to read checkpoint")
+	}
+
+	buckets, err := src.Buckets()
+	if err != nil {
+		return errors.Wrap(err, "failed to read buckets")
+	}
+	bar := progressbar.New(len(buckets))
+	for _, ns := range buckets {
+		if ns == _checkpointNS || ns < cpBucket {
+			if err := bar.Add(1); err != nil {
+				return err
+			}
+			continue
+		}
+		var (
+			minKey []byte
+			count  uint64
+			b      = batch.NewBatch()
+		)
+		if ns == cpBucket {
+			minKey = cpKey
+		}
+		flush := func(lastKey []byte) error {
+			b.Put(_checkpointNS, _checkpointBucketKey, []byte(ns), "failed to put checkpoint")
+			b.Put(_checkpointNS, _checkpointKeyKey, lastKey, "failed to put checkpoint")
+			if err := dest.WriteBatch(b); err != nil {
+				return errors.Wrapf(err, "failed to write bucket %s", ns)
+			}
+			b.Clear()
+			return nil
+		}
+		var (
+			lastKey  []byte
+			flushErr error
+		)
+		if err := src.Iterate(ns, minKey, func(k, v []byte) bool {
+			if minKey != nil && bytes.Equal(k, minKey) {
+				// the record at checkpoint has been written
+				return true
+			}
+			lastKey = append(k[:0:0], k...)
+			b.Put(ns, lastKey, append(v[:0:0], v...), "failed to put record")
+			count++
+			if b.Size() >= batchSize {
+				flushErr = flush(lastKey)
+			}
+			return flushErr == nil
+		}); err != nil {
+			return errors.Wrapf(err, "failed to read bucket %s", ns)
+		}
+		if flushErr != nil {
+			return flushErr
+		}
+		if b.Size() > 0 {
+			if err := flush(lastKey); err != nil {
+				return err
+			}
+		}
+		if err := bar.Add(1); err != nil {
+			return err
+		}
+		fmt.Printf("\nConverted %d records in bucket %s.\n", count, ns)
+	}
+	return dest.Delete(_checkpointNS, nil)
+}
+
+// verifyKVStore checks the destination db has the same records as the source db, and the state trie in the
+// destination db is complete
+func verifyKVStore(srcCfg db.Config, srcPath string, destCfg db.Config, destPath string) (err error) {
+	ctx := context.Background()
+	src, err := openKVStoreWithIterator(ctx, srcCfg, srcPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := src.Stop(ctx); err == nil {
+			err = e
+		}
+	}()
+	destCfg.ReadOnly = true
+	dest, err := openKVStoreWithIterator(ctx, destCfg, destPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := dest.Stop(ctx); err == nil {
+			err = e
+		}
+	}()
+
+	buckets, err := src.Buckets()
+	if err != nil {
+		return errors.Wrap(err, "failed to read buckets")
+	}
+	for _, ns := range buckets {
+		var (
+			count     uint64
+			verifyErr error
+		)
+		if err := src.Iterate(ns, nil, func(k, v []byte) bool {
+			value, err := dest.Get(ns, k)
+			if err != nil {
+				verifyErr = errors.Wrapf(err, "failed to read key %x in bucket %s", k, ns)
+				return false
+			}
+			if !bytes.Equal(v, value) {
+				verifyErr = errors.Errorf("value of key %x in bucket %s mismatches", k, ns)
+				return false
+			}
+			count++
+			return true
+		}); err != nil {
+			return err
+		}
+		if verifyErr != nil {
+			return verifyErr
+		}
+		var destCount uint64
+		switch err := dest.Iterate(ns, nil, func(k, v []byte) bool {
+			destCount++
+			return true
+		}); errors.Cause(err) {
+		case nil, db.ErrBucketNotExist:
+		default:
+			return err
+		}
+		if count != destCount {
+			return errors.Errorf("bucket %s has %d records in destination, expecting %d", ns, destCount, count)
+		}
+	}
+	if dbType == _dbTypeState {
+		return verifyStateRoot(dest)
+	}
+	return nil
+}
+
+// verifyStateRoot walks through every account and contract in the state trie of the db, which fails if any node
+// of the trie is missing
+func verifyStateRoot(kv db.KVStore) error {
+	dbForTrie, err := trie.NewKVStore(factory.ArchiveTrieNamespace, kv)
+	if err != nil {
+		return err
+	}
+	root, err := dbForTrie.Get([]byte(factory.ArchiveTrieRootKey))
+	if errors.Cause(err) == trie.ErrNotExist {
+		fmt.Println("No state trie found in the db.")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var accounts, states uint64
+	if err := walkTrie(dbForTrie, root, func(_, layerTwoRoot []byte) error {
+		accounts++
+		return walkTrie(dbForTrie, layerTwoRoot, func(_, _ []byte) error {
+			states++
+			return nil
+		})
+	}); err != nil {
+		return errors.Wrapf(err, "failed to verify state root %x", root)
+	}
+	fmt.Printf("Verified state root %x with %d namespaces and %d states.\n", root, accounts, states)
+	return nil
+}
+
+func walkTrie(kv trie.KVStore, root []byte, fn func(k, v []byte) error) error {
+	tr, err := mptrie.New(mptrie.KVStoreOption(kv), mptrie.RootHashOption(root))
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	if err := tr.Start(ctx); err != nil {
+		return err
+	}
+	defer tr.Stop(ctx)
+	iter, err := mptrie.NewLeafIterator(tr)
+	if err != nil {
+		return err
+	}
+	for {
+		k, v, err := iter.Next()
+		if err == trie.ErrEndOfIterator {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+}
+
+// verifyBlockHashChain checks every block in the destination db has the same hash as the source db and links to
+// its previous block
+func verifyBlockHashChain(srcCfg, destCfg db.Config, deser *block.Deserializer) (err error) {
+	ctx := context.Background()
+	srcDAO, err := filedao.NewFileDAO(srcCfg, deser)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create dao from %s", srcCfg.DbPath)
+	}
+	destDAO, err := filedao.NewFileDAO(destCfg, deser)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create dao from %s", destCfg.DbPath)
+	}
+	if err := srcDAO.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start the source db")
+	}
+	defer func() {
+		if e := srcDAO.Stop(ctx); err == nil {
+			err = e
+		}
+	}()
+	if err := destDAO.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to start the destination db")
+	}
+	defer func() {
+		if e := destDAO.Stop(ctx); err == nil {
+			err = e
+		}
+	}()
+
+	tip, err := srcDAO.Height()
+	if err != nil {
+		return err
+	}
+	destTip, err := destDAO.Height()
+	if err != nil {
+		return err
+	}
+	if tip != destTip {
+		return errors.Errorf("tip height of destination is %d, expecting %d", destTip, tip)
+	}
+	var prevHash hash.Hash256
+	for h := tip; h > 0; h-- {
+		blkHash, err := srcDAO.GetBlockHash(h)
+		if err != nil {
+			// reached the bottom of the source db
+			break
+		}
+		if h < tip && blkHash != prevHash {
+			return errors.Errorf("hash of block %d mismatches the previous hash of block %d", h, h+1)
+		}
+		blk, err := destDAO.GetBlockByHeight(h)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get block %d", h)
+		}
+		if blk.HashBlock() != blkHash {
+			return errors.Errorf("hash of block %d is %x, expecting %x", h, blk.HashBlock(), blkHash)
+		}
+		prevHash = blk.PrevHash()
+	}
+	fmt.Printf("Verified block hash chain to height %d.\n", tip)
+	return nil
+}
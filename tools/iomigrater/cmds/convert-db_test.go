@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/filedao"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestConvertKVStore(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	srcPath, destPath := filepath.Join(dir, "index.db"), filepath.Join(dir, "index.pebble")
+	srcCfg, destCfg := db.DefaultConfig, db.DefaultConfig
+	srcCfg.Backend, destCfg.Backend = db.BackendBolt, db.BackendPebble
+
+	src, err := db.CreateKVStore(srcCfg, srcPath)
+	r.NoError(err)
+	r.NoError(src.Start(ctx))
+	for _, ns := range []string{"ns1", "ns2"} {
+		for i := 0; i < 10; i++ {
+			r.NoError(src.Put(ns, []byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("%s-value%d", ns, i))))
+		}
+	}
+	r.NoError(src.Stop(ctx))
+
+	// the records are written in batches of 3
+	r.NoError(convertKVStore(srcCfg, srcPath, destCfg, destPath, 3))
+	r.NoError(verifyKVStore(srcCfg, srcPath, destCfg, destPath))
+
+	// the conversion resumes from the checkpoint
+	dest, err := db.CreateKVStore(destCfg, destPath)
+	r.NoError(err)
+	r.NoError(dest.Start(ctx))
+	r.NoError(dest.Put(_checkpointNS, _checkpointBucketKey, []byte("ns2")))
+	r.NoError(dest.Put(_checkpointNS, _checkpointKeyKey, []byte("key4")))
+	r.NoError(dest.Delete("ns2", []byte("key7")))
+	r.NoError(dest.Stop(ctx))
+	r.NoError(convertKVStore(srcCfg, srcPath, destCfg, destPath, 3))
+	r.NoError(verifyKVStore(srcCfg, srcPath, destCfg, destPath))
+
+	// a missing or mismatched record fails the verification
+	r.NoError(dest.Start(ctx))
+	r.NoError(dest.Put("ns1", []byte("key3"), []byte("wrong")))
+	r.NoError(dest.Stop(ctx))
+	r.ErrorContains(verifyKVStore(srcCfg, srcPath, destCfg, destPath), "mismatches")
+	r.NoError(dest.Start(ctx))
+	r.NoError(dest.Delete("ns1", []byte("key3")))
+	r.NoError(dest.Stop(ctx))
+	r.ErrorContains(verifyKVStore(srcCfg, srcPath, destCfg, destPath), "failed to read key")
+}
+
+func TestConvertChainDB(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	deser := block.NewDeserializer(4689)
+	srcCfg, destCfg := db.DefaultConfig, db.DefaultConfig
+	srcCfg.Backend, destCfg.Backend = db.BackendBolt, db.BackendPebble
+	srcCfg.DbPath, destCfg.DbPath = filepath.Join(dir, "chain.db"), filepath.Join(dir, "pebble", "chain.db")
+	srcCfg.V2BlocksToSplitDB = 10
+
+	// 25 blocks are stored in the chain db and 2 split files
+	fd, err := filedao.NewFileDAO(srcCfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	block.LoadGenesisHash(&genesis.Default)
+	var (
+		builder  = block.NewTestingBuilder()
+		prevHash = hash.ZeroHash256
+		hashes   = make(map[uint64]hash.Hash256)
+	)
+	for i := uint64(1); i <= 25; i++ {
+		blk, err := builder.
+			SetHeight(i).
+			SetPrevBlockHash(prevHash).
+			SetTimeStamp(testutil.TimestampNow().UTC()).
+			SignAndBuild(identityset.PrivateKey(27))
+		r.NoError(err)
+		r.NoError(fd.PutBlock(ctx, &blk))
+		prevHash = blk.HashBlock()
+		hashes[i] = prevHash
+	}
+	r.NoError(fd.Stop(ctx))
+	files, err := filedao.ChainDBFiles(srcCfg)
+	r.NoError(err)
+	r.Len(files, 3)
+
+	srcCfg.V2BlocksToSplitDB = 0
+	srcCfg.ReadOnly = true
+	r.NoError(convertChainDB(srcCfg, destCfg, 4, deser))
+
+	// every split file is converted
+	destFiles, err := filedao.ChainDBFiles(destCfg)
+	r.NoError(err)
+	r.Equal(map[uint64]string{
+		0: destCfg.DbPath,
+		1: filedao.AuxFileName(destCfg.DbPath, 1),
+		2: filedao.AuxFileName(destCfg.DbPath, 2),
+	}, destFiles)
+	fd, err = filedao.NewFileDAO(destCfg, deser)
+	r.NoError(err)
+	r.NoError(fd.Start(ctx))
+	defer fd.Stop(ctx)
+	height, err := fd.Height()
+	r.NoError(err)
+	r.EqualValues(25, height)
+	for i := uint64(1); i <= 25; i++ {
+		blk, err := fd.GetBlockByHeight(i)
+		r.NoError(err)
+		r.Equal(hashes[i], blk.HashBlock())
+	}
+}
@@ -95,6 +95,8 @@ func main() {
 		dbFilePaths = append(dbFilePaths, bloomfilterIndexDBPath)
 		consensusDBPath := fmt.Sprintf("./consensus%d.db", i+1)
 		dbFilePaths = append(dbFilePaths, consensusDBPath)
+		signGuardPath := fmt.Sprintf("./signguard%d.db", i+1)
+		dbFilePaths = append(dbFilePaths, signGuardPath)
 		systemLogDBPath := fmt.Sprintf("./systemlog%d.db", i+1)
 		dbFilePaths = append(dbFilePaths, systemLogDBPath)
 		candidateIndexDBPath := fmt.Sprintf("./candidate.index%d.db", i+1)
@@ -112,6 +114,7 @@ func main() {
 		config.Chain.BloomfilterIndexDBPath = bloomfilterIndexDBPath
 		config.Chain.CandidateIndexDBPath = candidateIndexDBPath
 		config.Consensus.RollDPoS.ConsensusDBPath = consensusDBPath
+		config.Consensus.RollDPoS.SignGuardPath = signGuardPath
 		config.System.SystemLogDBPath = systemLogDBPath
 		if i == 0 {
 			config.Network.BootstrapNodes = []string{}